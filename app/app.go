@@ -98,6 +98,8 @@ var (
 		mint.ModuleName:           {supply.Minter},
 		distribution.ModuleName:   nil,
 		orders.ModuleName:         {supply.Burner},
+		orders.FeeCollectorName:   nil,
+		oracle.ModuleName:         nil,
 		gov.ModuleName:            {supply.Burner},
 	}
 
@@ -416,6 +418,8 @@ func NewDnServiceApp(logger log.Logger, db dbm.DB, config *config.VMConfig, invC
 		keys[oracle.StoreKey],
 		app.paramsKeeper.Subspace(oracle.DefaultParamspace),
 		app.vmKeeper,
+		app.supplyKeeper,
+		orderbook.RequestOraclePerms(),
 		appModulePerms(oracle.AvailablePermissions),
 	)
 
@@ -423,8 +427,10 @@ func NewDnServiceApp(logger log.Logger, db dbm.DB, config *config.VMConfig, invC
 	app.marketKeeper = markets.NewKeeper(
 		cdc,
 		keys[markets.StoreKey],
+		app.paramsKeeper.Subspace(markets.DefaultParamspace),
 		app.ccsKeeper,
 		orders.RequestMarketsPerms(),
+		orderbook.RequestMarketsPerms(),
 		appModulePerms(markets.AvailablePermissions),
 	)
 
@@ -432,6 +438,7 @@ func NewDnServiceApp(logger log.Logger, db dbm.DB, config *config.VMConfig, invC
 	app.orderKeeper = orders.NewKeeper(
 		cdc,
 		keys[orders.StoreKey],
+		app.paramsKeeper.Subspace(orders.DefaultParamspace),
 		app.bankKeeper,
 		app.supplyKeeper,
 		app.marketKeeper,
@@ -443,7 +450,10 @@ func NewDnServiceApp(logger log.Logger, db dbm.DB, config *config.VMConfig, invC
 	app.orderBookKeeper = orderbook.NewKeeper(
 		cdc,
 		keys[orderbook.StoreKey],
+		app.paramsKeeper.Subspace(orderbook.DefaultParamspace),
+		app.marketKeeper,
 		app.orderKeeper,
+		app.oracleKeeper,
 		appModulePerms(orderbook.AvailablePermissions),
 	)
 
@@ -459,6 +469,7 @@ func NewDnServiceApp(logger log.Logger, db dbm.DB, config *config.VMConfig, invC
 	app.govRouter = gov.NewRouter()
 	app.govRouter.AddRoute(vm.GovRouterKey, vm.NewGovHandler(app.vmKeeper))
 	app.govRouter.AddRoute(currencies.GovRouterKey, currencies.NewGovHandler(app.ccKeeper))
+	app.govRouter.AddRoute(markets.GovRouterKey, markets.NewGovHandler(app.marketKeeper))
 	app.govRouter.AddRoute(upgrade.ModuleName, upgrade.NewSoftwareUpgradeProposalHandler(app.upgradeKeeper))
 
 	app.govKeeper = gov.NewKeeper(
@@ -489,7 +500,7 @@ func NewDnServiceApp(logger log.Logger, db dbm.DB, config *config.VMConfig, invC
 		poa.NewAppMsModule(app.poaKeeper),
 		multisig.NewAppModule(app.msKeeper, app.poaKeeper),
 		oracle.NewAppModule(app.oracleKeeper),
-		markets.NewAppModule(app.marketKeeper),
+		markets.NewAppMsModule(app.marketKeeper),
 		orders.NewAppModule(app.orderKeeper),
 		orderbook.NewAppModule(app.orderBookKeeper),
 		crisis.NewAppModule(&app.crisisKeeper),
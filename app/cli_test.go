@@ -259,7 +259,7 @@ func TestOracle_CLI(t *testing.T) {
 
 	// check add asset Tx
 	{
-		ct.TxOracleAddAsset(nomineeAddr, assetCode, assetOracle1).CheckSucceeded()
+		ct.TxOracleAddAsset(nomineeAddr, assetCode, assetOracle1).SetFlag("quorum-min", "1").SetFlag("max-price-age", "60").CheckSucceeded()
 
 		q, assets := ct.QueryOracleAssets()
 		q.CheckSucceeded()
@@ -270,6 +270,8 @@ func TestOracle_CLI(t *testing.T) {
 		require.Len(t, asset.Oracles, 1)
 		require.Equal(t, assetOracle1, asset.Oracles[0].Address.String())
 		require.True(t, asset.Active)
+		require.EqualValues(t, 1, asset.QuorumMin)
+		require.EqualValues(t, 60, asset.MaxPriceAgeInS)
 
 		// check incorrect inputs
 		{
@@ -315,6 +317,19 @@ func TestOracle_CLI(t *testing.T) {
 		require.Equal(t, assetOracle1, asset.Oracles[0].Address.String())
 		require.Equal(t, assetOracle2, asset.Oracles[1].Address.String())
 		require.True(t, asset.Active)
+		// params not set with flags are kept
+		require.EqualValues(t, 1, asset.QuorumMin)
+		require.EqualValues(t, 60, asset.MaxPriceAgeInS)
+
+		// override a single param
+		ct.TxOracleSetAsset(nomineeAddr, assetCode, assetOracle1, assetOracle2).SetFlag("aggregation", oracle.AggregationTWAP.String()).CheckSucceeded()
+
+		q, assets = ct.QueryOracleAssets()
+		q.CheckSucceeded()
+		asset = (*assets)[1]
+		require.Equal(t, oracle.AggregationTWAP, asset.AggregationMode())
+		require.EqualValues(t, 1, asset.QuorumMin)
+		require.EqualValues(t, 60, asset.MaxPriceAgeInS)
 
 		// check incorrect inputs
 		{
@@ -383,7 +383,9 @@ func GetGenesis(app *DnServiceApp, chainID, monikerID string, nodeAccPrivKey sec
 						Active:    true,
 					},
 				},
-				Nominees: []string{},
+				Nominees:    []string{},
+				Aggregation: oracle.DefaultAggregationParams(),
+				Rewards:     oracle.DefaultRewardParams(),
 			},
 		}
 		for i := 0; i < len(accs) && i < 2; i++ {
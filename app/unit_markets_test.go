@@ -0,0 +1,99 @@
+// +build unit
+
+package app
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/dfinance/dnode/x/markets"
+)
+
+// Test market creation via message (restricted by params) and via gov proposal.
+func TestMarkets_CreateMarket(t *testing.T) {
+	t.Parallel()
+
+	app, appStop := NewTestDnAppMockVM()
+	defer appStop()
+
+	genValidators, _, _, genPrivKeys := CreateGenAccounts(3, GenDefCoins(t))
+	CheckSetGenesisMockVM(t, app, genValidators)
+
+	senderAddr, senderPrivKey := genValidators[0].Address, genPrivKeys[0]
+
+	beginBlock := func() {
+		app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{ChainID: chainID, Height: app.LastBlockHeight() + 1}})
+	}
+	endBlock := func() {
+		app.EndBlock(abci.RequestEndBlock{})
+		app.Commit()
+	}
+
+	createMarket := func(baseDenom, quoteDenom string) (*sdk.Result, error) {
+		acc := GetAccountCheckTx(app, senderAddr)
+		msg := markets.NewMsgCreateMarket(senderAddr, baseDenom, quoteDenom)
+		tx := GenTx([]sdk.Msg{msg}, []uint64{acc.GetAccountNumber()}, []uint64{acc.GetSequence()}, senderPrivKey)
+
+		return DeliverTx(app, tx)
+	}
+
+	// message is disabled by default params
+	{
+		require.False(t, app.marketKeeper.GetParams(GetContext(app, true)).CreateMarketMsgEnabled)
+
+		res, err := createMarket("btc", "xfi")
+		CheckResultError(t, markets.ErrCreateMarketDisabled, res, err)
+		require.Empty(t, app.marketKeeper.GetList(GetContext(app, true)))
+	}
+
+	// gov proposal
+	{
+		beginBlock()
+
+		ctx := GetContext(app, false)
+		govHandler := markets.NewGovHandler(app.marketKeeper)
+
+		require.Error(t, govHandler(ctx, markets.NewAddMarketProposal("btc", "foo")))
+		require.NoError(t, govHandler(ctx, markets.NewAddMarketProposal("btc", "xfi")))
+
+		marketsList := app.marketKeeper.GetList(ctx)
+		require.Len(t, marketsList, 1)
+		require.Equal(t, "btc", marketsList[0].BaseAssetDenom)
+		require.Equal(t, "xfi", marketsList[0].QuoteAssetDenom)
+
+		// duplicated market
+		require.Error(t, govHandler(ctx, markets.NewAddMarketProposal("btc", "xfi")))
+
+		// fee rates and order limits
+		p := markets.NewAddMarketProposal("usdt", "xfi")
+		p.MakerFee, p.TakerFee = sdk.NewDecWithPrec(1, 3), sdk.NewDecWithPrec(2, 3)
+		p.TickSize, p.LotSize, p.MinNotional = sdk.NewUint(1000), sdk.NewUint(100), sdk.NewUint(1000000)
+		require.NoError(t, govHandler(ctx, p))
+
+		marketsList = app.marketKeeper.GetList(ctx)
+		require.Len(t, marketsList, 2)
+		market := marketsList[1]
+		require.Equal(t, "usdt", market.BaseAssetDenom)
+		require.True(t, market.GetFeeRate(true).Equal(p.MakerFee))
+		require.True(t, market.GetFeeRate(false).Equal(p.TakerFee))
+		require.True(t, market.GetTickSize().Equal(p.TickSize))
+		require.True(t, market.GetLotSize().Equal(p.LotSize))
+		require.True(t, market.GetMinNotional().Equal(p.MinNotional))
+
+		endBlock()
+	}
+
+	// message is enabled by params
+	{
+		beginBlock()
+		app.marketKeeper.SetParams(GetContext(app, false), markets.NewParams(true))
+		endBlock()
+
+		res, err := createMarket("eth", "xfi")
+		require.NoError(t, err, ResultErrorMsg(res, err))
+		require.Len(t, app.marketKeeper.GetList(GetContext(app, true)), 3)
+	}
+}
@@ -9,10 +9,12 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/crypto/secp256k1"
 
+	dnConfig "github.com/dfinance/dnode/cmd/config"
 	"github.com/dfinance/dnode/x/oracle"
 )
 
@@ -76,11 +78,12 @@ func TestOracle_Queries(t *testing.T) {
 
 	// getCurrentPrice query check (no inputs yet)
 	{
-		response := oracle.CurrentPrice{}
+		response := oracle.QueryPriceResp{}
 		CheckRunQuery(t, app, nil, fmt.Sprintf(queryOracleGetCurrentPricePathFmt, assetCode), &response)
 		require.Empty(t, response.AssetCode)
 		require.True(t, response.Price.IsZero())
 		//require.True(t, response.ReceivedAt.IsZero())
+		require.False(t, response.Quorum.Reached)
 	}
 
 	now := time.Now()
@@ -160,11 +163,14 @@ func TestOracle_Queries(t *testing.T) {
 
 	// getCurrentPrice query check (value should be calculated after BlockEnd)
 	{
-		response := oracle.CurrentPrice{}
+		response := oracle.QueryPriceResp{}
 		CheckRunQuery(t, app, nil, fmt.Sprintf(queryOracleGetCurrentPricePathFmt, assetCode), &response)
 		require.Equal(t, assetCode, response.AssetCode)
 		require.True(t, response.Price.Equal(priceValues[2]))
 		require.True(t, response.ReceivedAt.Equal(priceTimestamps[2]))
+		require.True(t, response.Quorum.Reached)
+		require.EqualValues(t, 3, response.Quorum.Posted)
+		require.EqualValues(t, 1, response.Quorum.Required)
 	}
 }
 
@@ -696,3 +702,85 @@ func TestOracle_PostPrices(t *testing.T) {
 		}
 	}
 }
+
+func TestOracle_FundRewardPool(t *testing.T) {
+	t.Parallel()
+
+	app, appStop := NewTestDnAppMockVM()
+	defer appStop()
+
+	genAccs, genAddrs, _, genPrivKeys := CreateGenAccounts(3, GenDefCoins(t))
+	CheckSetGenesisMockVM(t, app, genAccs)
+
+	assetCode := dnTypes.AssetCode("btc_xfi")
+	epochReward := sdk.NewCoins(sdk.NewCoin(dnConfig.MainDenom, sdk.NewInt(100)))
+	fundAmount := sdk.NewCoins(sdk.NewCoin(dnConfig.MainDenom, sdk.NewInt(150)))
+	oracleAddr, oraclePrivKey := genAddrs[1], genPrivKeys[1]
+
+	getPoolCoins := func() sdk.Coins {
+		return app.supplyKeeper.GetModuleAccount(GetContext(app, true), oracle.ModuleName).GetCoins()
+	}
+
+	// set params (add asset with oracle 1 / rewards paid every block)
+	{
+		app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{ChainID: chainID, Height: app.LastBlockHeight() + 1}})
+
+		ctx := GetContext(app, false)
+		rewards := oracle.DefaultRewardParams()
+		rewards.EpochBlocks = 1
+		rewards.EpochReward = epochReward
+		app.oracleKeeper.SetParams(ctx, oracle.Params{
+			Assets: oracle.Assets{
+				oracle.Asset{AssetCode: assetCode, Oracles: oracle.Oracles{{Address: oracleAddr}}, Active: true},
+			},
+			Nominees: []string{genAddrs[0].String()},
+			Rewards:  rewards,
+		})
+
+		app.EndBlock(abci.RequestEndBlock{})
+		app.Commit()
+	}
+
+	// check the rewards pool can't be funded with a bank transfer
+	{
+		senderAcc, senderPrivKey := GetAccountCheckTx(app, genAddrs[0]), genPrivKeys[0]
+
+		moduleAddr := app.supplyKeeper.GetModuleAddress(oracle.ModuleName)
+		msg := bank.NewMsgSend(senderAcc.GetAddress(), moduleAddr, fundAmount)
+
+		tx := GenTx([]sdk.Msg{msg}, []uint64{senderAcc.GetAccountNumber()}, []uint64{senderAcc.GetSequence()}, senderPrivKey)
+		CheckDeliverErrorTx(t, app, tx)
+		require.True(t, getPoolCoins().IsZero())
+	}
+
+	// fund the rewards pool
+	{
+		senderAcc, senderPrivKey := GetAccountCheckTx(app, genAddrs[0]), genPrivKeys[0]
+
+		msg := oracle.NewMsgFundRewardPool(senderAcc.GetAddress(), fundAmount)
+
+		tx := GenTx([]sdk.Msg{msg}, []uint64{senderAcc.GetAccountNumber()}, []uint64{senderAcc.GetSequence()}, senderPrivKey)
+		CheckDeliverTx(t, app, tx)
+		require.True(t, getPoolCoins().IsEqual(fundAmount))
+	}
+
+	// post price and check the epoch reward is paid from the pool
+	{
+		senderAcc := GetAccountCheckTx(app, oracleAddr)
+
+		msg := oracle.MsgPostPrice{
+			From:       senderAcc.GetAddress(),
+			AssetCode:  assetCode,
+			Price:      sdk.NewInt(100000000),
+			ReceivedAt: time.Now(),
+		}
+
+		tx := GenTx([]sdk.Msg{msg}, []uint64{senderAcc.GetAccountNumber()}, []uint64{senderAcc.GetSequence()}, oraclePrivKey)
+		CheckDeliverTx(t, app, tx)
+
+		stats, found := app.oracleKeeper.GetOracleStats(GetContext(app, true), oracleAddr)
+		require.True(t, found)
+		require.True(t, stats.Rewards.IsEqual(epochReward), "rewards: %s", stats.Rewards)
+		require.True(t, getPoolCoins().IsEqual(fundAmount.Sub(epochReward)), "pool: %s", getPoolCoins())
+	}
+}
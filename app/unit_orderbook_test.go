@@ -10,6 +10,8 @@ import (
 	"github.com/stretchr/testify/require"
 
 	dnTypes "github.com/dfinance/dnode/helpers/types"
+	"github.com/dfinance/dnode/x/markets"
+	"github.com/dfinance/dnode/x/orders"
 )
 
 func TestOB_BasicNoDecimalAssets(t *testing.T) {
@@ -232,3 +234,111 @@ func TestOB_ManyOrders(t *testing.T) {
 
 	t.Logf("Orders %d -> %v", inputOrdersCount, processingDur)
 }
+
+func TestOB_CircuitBreaker(t *testing.T) {
+	t.Parallel()
+
+	app, appStop := NewTestDnAppMockVM()
+	defer appStop()
+
+	genValidators, _, _, _ := CreateGenAccounts(3, GenDefCoins(t))
+	CheckSetGenesisMockVM(t, app, genValidators)
+
+	baseDenom, quoteDenom := "base", "quote"
+	baseDecimals, quoteDecimals := uint8(0), uint8(0)
+	baseSupply, quoteSupply := sdk.NewInt(1000), sdk.NewInt(100000)
+
+	buyerAddr, sellerAddr := genValidators[0].Address, genValidators[1].Address
+	tester := NewOrderBookTester(t, app)
+
+	marketID := dnTypes.ID{}
+	// init currencies and clients
+	{
+		tester.BeginBlock()
+
+		marketID = tester.RegisterMarket(buyerAddr, baseDenom, baseDecimals, quoteDenom, quoteDecimals)
+		tester.AddClient(buyerAddr, baseSupply, quoteSupply)
+		tester.AddClient(sellerAddr, baseSupply, quoteSupply)
+
+		tester.EndBlock()
+	}
+	assetCode := tester.Markets[marketID.String()].GetAssetCode()
+
+	postOrder := func(owner sdk.AccAddress, direction orders.Direction, price, quantity uint64) dnTypes.ID {
+		order, err := app.orderKeeper.PostOrder(GetContext(app, false), owner, assetCode, direction, sdk.NewUint(price), sdk.NewUint(quantity), 60)
+		require.NoError(t, err)
+
+		return order.ID
+	}
+
+	getMarketStatus := func() markets.MarketStatus {
+		market, err := app.marketKeeper.Get(GetContext(app, true), marketID)
+		require.NoError(t, err)
+
+		return market.GetStatus()
+	}
+
+	getOrdersCount := func() int {
+		request := orders.OrdersReq{Page: sdk.NewUint(1), Limit: sdk.NewUint(10)}
+		response := orders.Orders{}
+		CheckRunQuery(t, app, request, queryOrdersListPath, &response)
+
+		return len(response)
+	}
+
+	// reference clearance price: 100
+	{
+		tester.BeginBlock()
+
+		postOrder(sellerAddr, orders.AskDirection, 100, 5)
+		postOrder(buyerAddr, orders.BidDirection, 100, 5)
+
+		_, err := app.marketKeeper.SetCircuitBreaker(GetContext(app, false), marketID, sdk.NewDecWithPrec(1, 1), markets.PriceReferenceClearance, 2)
+		require.NoError(t, err)
+
+		tester.EndBlock()
+
+		require.Equal(t, 0, getOrdersCount())
+	}
+
+	// clearance price out of the 10% band: matching is skipped, market is switched to post-only for 2 blocks
+	// self-trade adjustments are applied anyway (seller crossing bid is canceled)
+	var askID, bidID dnTypes.ID
+	{
+		tester.BeginBlock()
+
+		askID = postOrder(sellerAddr, orders.AskDirection, 200, 5)
+		bidID = postOrder(buyerAddr, orders.BidDirection, 200, 5)
+		_, err := app.orderKeeper.PostOrderWithPolicies(GetContext(app, false), sellerAddr, assetCode, orders.BidDirection, sdk.NewUint(200), sdk.NewUint(1), 60, orders.TimeInForceGTC, 0, orders.SelfTradePreventionCancelNewest)
+		require.NoError(t, err)
+
+		tester.EndBlock()
+
+		require.Equal(t, 2, getOrdersCount())
+		require.Equal(t, markets.MarketStatusPostOnly, getMarketStatus())
+
+		tester.BeginBlock()
+		tester.EndBlock()
+		require.Equal(t, markets.MarketStatusPostOnly, getMarketStatus())
+
+		tester.BeginBlock()
+		tester.EndBlock()
+		require.Equal(t, markets.MarketStatusActive, getMarketStatus())
+		require.Equal(t, 2, getOrdersCount())
+	}
+
+	// clearance price within the band: orders are matched
+	{
+		tester.BeginBlock()
+
+		require.NoError(t, app.orderKeeper.RevokeOrder(GetContext(app, false), askID))
+		require.NoError(t, app.orderKeeper.RevokeOrder(GetContext(app, false), bidID))
+		postOrder(sellerAddr, orders.AskDirection, 105, 5)
+		postOrder(buyerAddr, orders.BidDirection, 105, 5)
+
+		tester.EndBlock()
+
+		require.Equal(t, 0, getOrdersCount())
+		require.Equal(t, markets.MarketStatusActive, getMarketStatus())
+	}
+}
@@ -7,14 +7,19 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/stretchr/testify/require"
 
 	dnTypes "github.com/dfinance/dnode/helpers/types"
+	"github.com/dfinance/dnode/x/markets"
+	"github.com/dfinance/dnode/x/oracle"
 	"github.com/dfinance/dnode/x/orders"
 )
 
 const (
-	queryOrdersListPath = "/custom/orders/list"
+	queryOrdersListPath     = "/custom/orders/list"
+	queryStopOrdersListPath = "/custom/orders/stop_list"
+	queryFillRecordsPath    = "/custom/orders/fills"
 )
 
 func TestOrders_Ttl(t *testing.T) {
@@ -83,3 +88,891 @@ func TestOrders_Ttl(t *testing.T) {
 		require.True(t, response[0].ID.Equal(longTtlOrderID))
 	}
 }
+
+func TestOrders_MarketStatus(t *testing.T) {
+	t.Parallel()
+
+	app, appStop := NewTestDnAppMockVM()
+	defer appStop()
+
+	genValidators, _, _, _ := CreateGenAccounts(3, GenDefCoins(t))
+	CheckSetGenesisMockVM(t, app, genValidators)
+
+	baseDenom, quoteDenom := "base", "quote"
+	baseDecimals, quoteDecimals := uint8(0), uint8(0)
+	baseSupply, quoteSupply := sdk.NewInt(1000), sdk.NewInt(1000)
+
+	clientAddr := genValidators[0].Address
+	tester := NewOrderBookTester(t, app)
+
+	marketID := dnTypes.ID{}
+	// init currencies and clients
+	{
+		tester.BeginBlock()
+
+		marketID = tester.RegisterMarket(clientAddr, baseDenom, baseDecimals, quoteDenom, quoteDecimals)
+		tester.AddClient(clientAddr, baseSupply, quoteSupply)
+
+		tester.EndBlock()
+	}
+
+	checkOrdersCount := func(count int) {
+		request := orders.OrdersReq{Page: sdk.NewUint(1), Limit: sdk.NewUint(10)}
+		response := orders.Orders{}
+		CheckRunQuery(t, app, request, queryOrdersListPath, &response)
+
+		require.Len(t, response, count)
+	}
+
+	setMarketStatus := func(status markets.MarketStatus) {
+		_, err := app.marketKeeper.SetStatus(GetContext(app, false), marketID, status)
+		require.NoError(t, err)
+	}
+
+	// post-only market: orders are accepted, but not matched
+	{
+		tester.BeginBlock()
+
+		setMarketStatus(markets.MarketStatusPostOnly)
+		tester.AddSellOrder(clientAddr, marketID, sdk.NewUint(10), sdk.NewUint(10), 60)
+		tester.AddBuyOrder(clientAddr, marketID, sdk.NewUint(10), sdk.NewUint(10), 60)
+
+		tester.EndBlock()
+
+		checkOrdersCount(2)
+	}
+
+	// halted market: orders are not accepted
+	{
+		tester.BeginBlock()
+
+		setMarketStatus(markets.MarketStatusHalted)
+		market := tester.Markets[marketID.String()]
+		_, err := app.orderKeeper.PostOrder(GetContext(app, false), clientAddr, market.GetAssetCode(), orders.AskDirection, sdk.NewUint(10), sdk.NewUint(10), 60)
+		require.Error(t, err)
+		require.True(t, orders.ErrWrongMarketStatus.Is(err))
+
+		tester.EndBlock()
+
+		checkOrdersCount(2)
+	}
+
+	// delisted market: orders are revoked and refunded
+	{
+		tester.BeginBlock()
+
+		setMarketStatus(markets.MarketStatusDelisted)
+
+		tester.EndBlock()
+
+		checkOrdersCount(0)
+
+		acc := app.accountKeeper.GetAccount(GetContext(app, true), clientAddr)
+		require.True(t, acc.GetCoins().AmountOf(baseDenom).Equal(baseSupply))
+		require.True(t, acc.GetCoins().AmountOf(quoteDenom).Equal(quoteSupply))
+
+		tester.BeginBlock()
+		_, err := app.marketKeeper.SetStatus(GetContext(app, false), marketID, markets.MarketStatusActive)
+		require.Error(t, err)
+		tester.EndBlock()
+	}
+}
+
+func TestOrders_TimeInForce(t *testing.T) {
+	t.Parallel()
+
+	app, appStop := NewTestDnAppMockVM()
+	defer appStop()
+
+	genValidators, _, _, _ := CreateGenAccounts(3, GenDefCoins(t))
+	CheckSetGenesisMockVM(t, app, genValidators)
+
+	baseDenom, quoteDenom := "base", "quote"
+	baseDecimals, quoteDecimals := uint8(0), uint8(0)
+	baseSupply, quoteSupply := sdk.NewInt(1000), sdk.NewInt(1000)
+
+	buyerAddr, sellerAddr := genValidators[0].Address, genValidators[1].Address
+	tester := NewOrderBookTester(t, app)
+
+	marketID := dnTypes.ID{}
+	// init currencies and clients
+	{
+		tester.BeginBlock()
+
+		marketID = tester.RegisterMarket(buyerAddr, baseDenom, baseDecimals, quoteDenom, quoteDecimals)
+		tester.AddClient(buyerAddr, baseSupply, quoteSupply)
+		tester.AddClient(sellerAddr, baseSupply, quoteSupply)
+
+		tester.EndBlock()
+	}
+	assetCode := tester.Markets[marketID.String()].GetAssetCode()
+
+	getOrders := func() orders.Orders {
+		request := orders.OrdersReq{Page: sdk.NewUint(1), Limit: sdk.NewUint(10)}
+		response := orders.Orders{}
+		CheckRunQuery(t, app, request, queryOrdersListPath, &response)
+
+		return response
+	}
+
+	postOrder := func(owner sdk.AccAddress, direction orders.Direction, price, quantity uint64, tif orders.TimeInForce, goodTillBlock int64) dnTypes.ID {
+		order, err := app.orderKeeper.PostOrderWithTimeInForce(GetContext(app, false), owner, assetCode, direction, sdk.NewUint(price), sdk.NewUint(quantity), 60, tif, goodTillBlock)
+		require.NoError(t, err)
+
+		return order.ID
+	}
+
+	checkBalance := func(addr sdk.AccAddress, base, quote int64) {
+		acc := app.accountKeeper.GetAccount(GetContext(app, true), addr)
+		require.True(t, acc.GetCoins().AmountOf(baseDenom).Equal(sdk.NewInt(base)), "%s: base %s", addr, acc.GetCoins().AmountOf(baseDenom))
+		require.True(t, acc.GetCoins().AmountOf(quoteDenom).Equal(sdk.NewInt(quote)), "%s: quote %s", addr, acc.GetCoins().AmountOf(quoteDenom))
+	}
+
+	// IOC order: partially filled, leftover is revoked and refunded
+	{
+		tester.BeginBlock()
+
+		postOrder(sellerAddr, orders.AskDirection, 10, 10, orders.TimeInForceGTC, 0)
+		postOrder(buyerAddr, orders.BidDirection, 10, 15, orders.TimeInForceIOC, 0)
+
+		tester.EndBlock()
+
+		require.Len(t, getOrders(), 0)
+		checkBalance(buyerAddr, 1010, 900)
+		checkBalance(sellerAddr, 990, 1100)
+	}
+
+	// FOK order: can't be fully filled, left out of fills and revoked
+	{
+		tester.BeginBlock()
+
+		postOrder(sellerAddr, orders.AskDirection, 10, 10, orders.TimeInForceFOK, 0)
+		bidID := postOrder(buyerAddr, orders.BidDirection, 10, 5, orders.TimeInForceGTC, 0)
+
+		tester.EndBlock()
+
+		ordersList := getOrders()
+		require.Len(t, ordersList, 1)
+		require.True(t, ordersList[0].ID.Equal(bidID))
+		require.True(t, ordersList[0].Quantity.Equal(sdk.NewUint(5)))
+		checkBalance(buyerAddr, 1010, 850)
+		checkBalance(sellerAddr, 990, 1100)
+	}
+
+	// FOK order: fully filled
+	{
+		tester.BeginBlock()
+
+		postOrder(sellerAddr, orders.AskDirection, 10, 5, orders.TimeInForceFOK, 0)
+
+		tester.EndBlock()
+
+		require.Len(t, getOrders(), 0)
+		checkBalance(buyerAddr, 1015, 850)
+		checkBalance(sellerAddr, 985, 1150)
+	}
+
+	// GTB order: canceled after the specified block height
+	{
+		tester.BeginBlock()
+
+		goodTillBlock := app.LastBlockHeight() + 2
+		gtbID := postOrder(sellerAddr, orders.AskDirection, 10, 10, orders.TimeInForceGTB, goodTillBlock)
+
+		// wrong height
+		_, err := app.orderKeeper.PostOrderWithTimeInForce(GetContext(app, false), sellerAddr, assetCode, orders.AskDirection, sdk.NewUint(10), sdk.NewUint(10), 60, orders.TimeInForceGTB, app.LastBlockHeight())
+		require.Error(t, err)
+		require.True(t, orders.ErrWrongGoodTillBlock.Is(err))
+
+		tester.EndBlock()
+
+		ordersList := getOrders()
+		require.Len(t, ordersList, 1)
+		require.True(t, ordersList[0].ID.Equal(gtbID))
+		require.Equal(t, goodTillBlock, ordersList[0].GoodTillBlock)
+
+		tester.BeginBlock()
+		tester.EndBlock()
+		require.Len(t, getOrders(), 1)
+
+		tester.BeginBlock()
+		tester.EndBlock()
+		require.Len(t, getOrders(), 0)
+		checkBalance(sellerAddr, 985, 1150)
+	}
+
+	// immediate orders are not accepted by post-only market
+	{
+		tester.BeginBlock()
+
+		_, err := app.marketKeeper.SetStatus(GetContext(app, false), marketID, markets.MarketStatusPostOnly)
+		require.NoError(t, err)
+
+		_, err = app.orderKeeper.PostOrderWithTimeInForce(GetContext(app, false), sellerAddr, assetCode, orders.AskDirection, sdk.NewUint(10), sdk.NewUint(10), 60, orders.TimeInForceIOC, 0)
+		require.Error(t, err)
+		require.True(t, orders.ErrWrongMarketStatus.Is(err))
+
+		tester.EndBlock()
+	}
+}
+
+func TestOrders_StopOrder(t *testing.T) {
+	t.Parallel()
+
+	app, appStop := NewTestDnAppMockVM()
+	defer appStop()
+
+	genValidators, _, _, _ := CreateGenAccounts(3, GenDefCoins(t))
+	CheckSetGenesisMockVM(t, app, genValidators)
+
+	baseDenom, quoteDenom := "base", "quote"
+	baseDecimals, quoteDecimals := uint8(0), uint8(0)
+	baseSupply, quoteSupply := sdk.NewInt(1000), sdk.NewInt(1000)
+
+	buyerAddr, sellerAddr, oracleAddr := genValidators[0].Address, genValidators[1].Address, genValidators[2].Address
+	tester := NewOrderBookTester(t, app)
+
+	marketID := dnTypes.ID{}
+	// init currencies, clients and oracle
+	{
+		tester.BeginBlock()
+
+		marketID = tester.RegisterMarket(buyerAddr, baseDenom, baseDecimals, quoteDenom, quoteDecimals)
+		tester.AddClient(buyerAddr, baseSupply, quoteSupply)
+		tester.AddClient(sellerAddr, baseSupply, quoteSupply)
+
+		tester.EndBlock()
+	}
+	assetCode := tester.Markets[marketID.String()].GetAssetCode()
+	{
+		tester.BeginBlock()
+
+		app.oracleKeeper.SetParams(GetContext(app, false), oracle.Params{
+			Assets: oracle.Assets{
+				oracle.Asset{AssetCode: assetCode, Oracles: oracle.Oracles{{Address: oracleAddr}}, Active: true},
+			},
+			Nominees: []string{oracleAddr.String()},
+		})
+
+		tester.EndBlock()
+	}
+
+	getOrders := func() orders.Orders {
+		request := orders.OrdersReq{Page: sdk.NewUint(1), Limit: sdk.NewUint(10)}
+		response := orders.Orders{}
+		CheckRunQuery(t, app, request, queryOrdersListPath, &response)
+
+		return response
+	}
+
+	getStopOrders := func() orders.Orders {
+		request := orders.OrdersReq{Page: sdk.NewUint(1), Limit: sdk.NewUint(10)}
+		response := orders.Orders{}
+		CheckRunQuery(t, app, request, queryStopOrdersListPath, &response)
+
+		return response
+	}
+
+	postStopOrder := func(owner sdk.AccAddress, direction orders.Direction, price, quantity, stopPrice uint64, trigger orders.StopTrigger) dnTypes.ID {
+		order, err := app.orderKeeper.PostStopOrder(GetContext(app, false), owner, assetCode, direction, sdk.NewUint(price), sdk.NewUint(quantity), 60, sdk.NewUint(stopPrice), trigger)
+		require.NoError(t, err)
+
+		return order.ID
+	}
+
+	postOrder := func(owner sdk.AccAddress, direction orders.Direction, price, quantity uint64) {
+		_, err := app.orderKeeper.PostOrder(GetContext(app, false), owner, assetCode, direction, sdk.NewUint(price), sdk.NewUint(quantity), 60)
+		require.NoError(t, err)
+	}
+
+	// clearance trigger: stop bid is activated after the clearance price crosses its stop price
+	{
+		tester.BeginBlock()
+
+		stopID := postStopOrder(buyerAddr, orders.BidDirection, 20, 10, 15, orders.StopTriggerClearance)
+		postOrder(sellerAddr, orders.AskDirection, 10, 5)
+		postOrder(buyerAddr, orders.BidDirection, 10, 5)
+
+		tester.EndBlock()
+
+		require.Len(t, getStopOrders(), 1)
+		require.Len(t, getOrders(), 0)
+
+		tester.BeginBlock()
+
+		postOrder(sellerAddr, orders.AskDirection, 15, 5)
+		postOrder(buyerAddr, orders.BidDirection, 15, 5)
+
+		tester.EndBlock()
+
+		require.Len(t, getStopOrders(), 0)
+		ordersList := getOrders()
+		require.Len(t, ordersList, 1)
+		require.True(t, ordersList[0].ID.Equal(stopID))
+
+		// triggered order is matched as a regular limit order
+		tester.BeginBlock()
+
+		postOrder(sellerAddr, orders.AskDirection, 20, 10)
+
+		tester.EndBlock()
+
+		require.Len(t, getOrders(), 0)
+	}
+
+	// oracle trigger: stop ask is activated by the oracle current price (converted to the quote currency decimals)
+	{
+		tester.BeginBlock()
+
+		stopID := postStopOrder(sellerAddr, orders.AskDirection, 10, 10, 8, orders.StopTriggerOracle)
+
+		ctx := GetContext(app, false)
+		_, err := app.oracleKeeper.SetPrice(ctx, oracleAddr, assetCode, sdk.NewInt(900000000), ctx.BlockTime()) // 9.0
+		require.NoError(t, err)
+
+		tester.EndBlock()
+
+		require.Len(t, getStopOrders(), 1)
+		require.Len(t, getOrders(), 0)
+
+		tester.BeginBlock()
+
+		ctx = GetContext(app, false)
+		_, err = app.oracleKeeper.SetPrice(ctx, oracleAddr, assetCode, sdk.NewInt(700000000), ctx.BlockTime()) // 7.0
+		require.NoError(t, err)
+
+		tester.EndBlock()
+
+		require.Len(t, getStopOrders(), 0)
+		ordersList := getOrders()
+		require.Len(t, ordersList, 1)
+		require.True(t, ordersList[0].ID.Equal(stopID))
+	}
+}
+
+func TestOrders_RevokeStopOrder(t *testing.T) {
+	t.Parallel()
+
+	app, appStop := NewTestDnAppMockVM()
+	defer appStop()
+
+	genValidators, _, _, genPrivKeys := CreateGenAccounts(3, GenDefCoins(t))
+	CheckSetGenesisMockVM(t, app, genValidators)
+
+	baseDenom, quoteDenom := "base", "quote"
+	baseDecimals, quoteDecimals := uint8(0), uint8(0)
+	baseSupply, quoteSupply := sdk.NewInt(1000), sdk.NewInt(1000)
+
+	clientAddr, clientPrivKey := genValidators[0].Address, genPrivKeys[0]
+	tester := NewOrderBookTester(t, app)
+
+	marketID := dnTypes.ID{}
+	// init currencies and clients
+	{
+		tester.BeginBlock()
+
+		marketID = tester.RegisterMarket(clientAddr, baseDenom, baseDecimals, quoteDenom, quoteDecimals)
+		tester.AddClient(clientAddr, baseSupply, quoteSupply)
+
+		tester.EndBlock()
+	}
+	assetCode := tester.Markets[marketID.String()].GetAssetCode()
+
+	checkBalance := func(base, quote int64) {
+		acc := app.accountKeeper.GetAccount(GetContext(app, true), clientAddr)
+		require.True(t, acc.GetCoins().AmountOf(baseDenom).Equal(sdk.NewInt(base)), "base %s", acc.GetCoins().AmountOf(baseDenom))
+		require.True(t, acc.GetCoins().AmountOf(quoteDenom).Equal(sdk.NewInt(quote)), "quote %s", acc.GetCoins().AmountOf(quoteDenom))
+	}
+
+	// post stop bid
+	stopID := dnTypes.ID{}
+	{
+		tester.BeginBlock()
+
+		order, err := app.orderKeeper.PostStopOrder(GetContext(app, false), clientAddr, assetCode, orders.BidDirection, sdk.NewUint(20), sdk.NewUint(10), 60, sdk.NewUint(15), orders.StopTriggerClearance)
+		require.NoError(t, err)
+		stopID = order.ID
+
+		tester.EndBlock()
+
+		checkBalance(1000, 800)
+	}
+
+	// revoke stop bid
+	{
+		acc := GetAccountCheckTx(app, clientAddr)
+		msg := orders.NewMsgRevokeOrder(clientAddr, stopID)
+		tx := GenTx([]sdk.Msg{msg}, []uint64{acc.GetAccountNumber()}, []uint64{acc.GetSequence()}, clientPrivKey)
+
+		res, err := DeliverTx(app, tx)
+		require.NoError(t, err, ResultErrorMsg(res, err))
+
+		checkBalance(1000, 1000)
+
+		ctx := GetContext(app, true)
+		_, err = app.orderKeeper.GetStopOrder(ctx, stopID)
+		require.Error(t, err)
+		require.Empty(t, app.orderKeeper.GetOwnerOrderIDs(ctx, clientAddr, nil))
+		require.Empty(t, app.orderKeeper.GetTriggeredStopOrderIDs(ctx, marketID, orders.StopTriggerClearance, sdk.NewUint(1000)))
+	}
+}
+
+func TestOrders_SelfTradePrevention(t *testing.T) {
+	t.Parallel()
+
+	app, appStop := NewTestDnAppMockVM()
+	defer appStop()
+
+	genValidators, _, _, _ := CreateGenAccounts(3, GenDefCoins(t))
+	CheckSetGenesisMockVM(t, app, genValidators)
+
+	baseDenom, quoteDenom := "base", "quote"
+	baseDecimals, quoteDecimals := uint8(0), uint8(0)
+	baseSupply, quoteSupply := sdk.NewInt(1000), sdk.NewInt(1000)
+
+	buyerAddr, sellerAddr := genValidators[0].Address, genValidators[1].Address
+	tester := NewOrderBookTester(t, app)
+
+	marketID := dnTypes.ID{}
+	// init currencies and clients
+	{
+		tester.BeginBlock()
+
+		marketID = tester.RegisterMarket(buyerAddr, baseDenom, baseDecimals, quoteDenom, quoteDecimals)
+		tester.AddClient(buyerAddr, baseSupply, quoteSupply)
+		tester.AddClient(sellerAddr, baseSupply, quoteSupply)
+
+		tester.EndBlock()
+	}
+	assetCode := tester.Markets[marketID.String()].GetAssetCode()
+
+	getOrders := func() orders.Orders {
+		request := orders.OrdersReq{Page: sdk.NewUint(1), Limit: sdk.NewUint(10)}
+		response := orders.Orders{}
+		CheckRunQuery(t, app, request, queryOrdersListPath, &response)
+
+		return response
+	}
+
+	postOrder := func(owner sdk.AccAddress, direction orders.Direction, price, quantity uint64, stp orders.SelfTradePrevention) dnTypes.ID {
+		order, err := app.orderKeeper.PostOrderWithPolicies(GetContext(app, false), owner, assetCode, direction, sdk.NewUint(price), sdk.NewUint(quantity), 60, orders.TimeInForceGTC, 0, stp)
+		require.NoError(t, err)
+
+		return order.ID
+	}
+
+	checkBalance := func(addr sdk.AccAddress, base, quote int64) {
+		acc := app.accountKeeper.GetAccount(GetContext(app, true), addr)
+		require.True(t, acc.GetCoins().AmountOf(baseDenom).Equal(sdk.NewInt(base)), "%s: base %s", addr, acc.GetCoins().AmountOf(baseDenom))
+		require.True(t, acc.GetCoins().AmountOf(quoteDenom).Equal(sdk.NewInt(quote)), "%s: quote %s", addr, acc.GetCoins().AmountOf(quoteDenom))
+	}
+
+	// decrement_both: seller orders are decremented (bid is canceled), ask leftover is matched with the buyer
+	{
+		tester.BeginBlock()
+
+		postOrder(sellerAddr, orders.AskDirection, 10, 10, orders.SelfTradePreventionNone)
+		postOrder(sellerAddr, orders.BidDirection, 10, 4, orders.SelfTradePreventionDecrementBoth)
+		bidID := postOrder(buyerAddr, orders.BidDirection, 10, 10, orders.SelfTradePreventionNone)
+
+		tester.EndBlock()
+
+		ordersList := getOrders()
+		require.Len(t, ordersList, 1)
+		require.True(t, ordersList[0].ID.Equal(bidID))
+		require.True(t, ordersList[0].Quantity.Equal(sdk.NewUint(4)))
+		checkBalance(buyerAddr, 1006, 900)
+		checkBalance(sellerAddr, 994, 1060)
+	}
+
+	// cancel_newest: the new buyer ask crossing the buyer bid is canceled and refunded
+	{
+		tester.BeginBlock()
+
+		postOrder(buyerAddr, orders.AskDirection, 5, 4, orders.SelfTradePreventionCancelNewest)
+
+		tester.EndBlock()
+
+		ordersList := getOrders()
+		require.Len(t, ordersList, 1)
+		require.True(t, ordersList[0].Quantity.Equal(sdk.NewUint(4)))
+		checkBalance(buyerAddr, 1006, 900)
+		checkBalance(sellerAddr, 994, 1060)
+	}
+}
+
+func TestOrders_Params(t *testing.T) {
+	t.Parallel()
+
+	app, appStop := NewTestDnAppMockVM()
+	defer appStop()
+
+	genValidators, _, _, _ := CreateGenAccounts(3, GenDefCoins(t))
+	CheckSetGenesisMockVM(t, app, genValidators)
+
+	baseDenom, quoteDenom := "base", "quote"
+	baseDecimals, quoteDecimals := uint8(0), uint8(0)
+	baseSupply, quoteSupply := sdk.NewInt(1000), sdk.NewInt(1000)
+
+	buyerAddr, sellerAddr := genValidators[0].Address, genValidators[1].Address
+	tester := NewOrderBookTester(t, app)
+
+	marketID := dnTypes.ID{}
+	// init currencies, clients and params: 2 open orders per market, 5 quote deposit
+	{
+		tester.BeginBlock()
+
+		marketID = tester.RegisterMarket(buyerAddr, baseDenom, baseDecimals, quoteDenom, quoteDecimals)
+		tester.AddClient(buyerAddr, baseSupply, quoteSupply)
+		tester.AddClient(sellerAddr, baseSupply, quoteSupply)
+		app.orderKeeper.SetParams(GetContext(app, false), orders.NewParams(2, sdk.NewCoins(sdk.NewInt64Coin(quoteDenom, 5)), orders.DefaultParams().FillRecordRetention, orders.DefaultParams().FillRecordPruneLimit))
+
+		tester.EndBlock()
+	}
+	assetCode := tester.Markets[marketID.String()].GetAssetCode()
+
+	postOrder := func(owner sdk.AccAddress, direction orders.Direction, price, quantity uint64) (dnTypes.ID, error) {
+		order, err := app.orderKeeper.PostOrder(GetContext(app, false), owner, assetCode, direction, sdk.NewUint(price), sdk.NewUint(quantity), 60)
+
+		return order.ID, err
+	}
+
+	checkBalance := func(addr sdk.AccAddress, base, quote int64) {
+		acc := app.accountKeeper.GetAccount(GetContext(app, true), addr)
+		require.True(t, acc.GetCoins().AmountOf(baseDenom).Equal(sdk.NewInt(base)), "%s: base %s", addr, acc.GetCoins().AmountOf(baseDenom))
+		require.True(t, acc.GetCoins().AmountOf(quoteDenom).Equal(sdk.NewInt(quote)), "%s: quote %s", addr, acc.GetCoins().AmountOf(quoteDenom))
+	}
+
+	getQuoteSupply := func() sdk.Int {
+		return app.supplyKeeper.GetSupply(GetContext(app, true)).GetTotal().AmountOf(quoteDenom)
+	}
+
+	// open orders limit: deposits are locked, the third order is rejected
+	askIDs := make([]dnTypes.ID, 0, 2)
+	{
+		tester.BeginBlock()
+
+		for _, price := range []uint64{10, 20} {
+			id, err := postOrder(sellerAddr, orders.AskDirection, price, 10)
+			require.NoError(t, err)
+			askIDs = append(askIDs, id)
+		}
+		_, err := postOrder(sellerAddr, orders.AskDirection, 30, 10)
+		require.Error(t, err)
+		require.True(t, orders.ErrMaxOpenOrders.Is(err))
+
+		tester.EndBlock()
+
+		checkBalance(sellerAddr, 980, 990)
+	}
+
+	// filled orders: deposits are refunded (the second ask is not filled)
+	{
+		tester.BeginBlock()
+
+		_, err := postOrder(buyerAddr, orders.BidDirection, 10, 10)
+		require.NoError(t, err)
+
+		tester.EndBlock()
+
+		checkBalance(buyerAddr, 1010, 900)
+		checkBalance(sellerAddr, 980, 1095)
+	}
+
+	// revoked never filled order: deposit is burned
+	{
+		prevQuoteSupply := getQuoteSupply()
+
+		tester.BeginBlock()
+		require.NoError(t, app.orderKeeper.RevokeOrder(GetContext(app, false), askIDs[1]))
+		tester.EndBlock()
+
+		checkBalance(sellerAddr, 990, 1095)
+		require.True(t, getQuoteSupply().Equal(prevQuoteSupply.SubRaw(5)))
+	}
+
+	// order revoked by market delisting: deposit is refunded
+	{
+		prevQuoteSupply := getQuoteSupply()
+
+		tester.BeginBlock()
+		_, err := postOrder(sellerAddr, orders.AskDirection, 30, 10)
+		require.NoError(t, err)
+		tester.EndBlock()
+
+		checkBalance(sellerAddr, 980, 1090)
+
+		tester.BeginBlock()
+		_, err = app.marketKeeper.SetStatus(GetContext(app, false), marketID, markets.MarketStatusDelisted)
+		require.NoError(t, err)
+		tester.EndBlock()
+
+		checkBalance(sellerAddr, 990, 1095)
+		require.True(t, getQuoteSupply().Equal(prevQuoteSupply))
+	}
+}
+
+func TestOrders_FillRecords(t *testing.T) {
+	t.Parallel()
+
+	app, appStop := NewTestDnAppMockVM()
+	defer appStop()
+
+	genValidators, _, _, _ := CreateGenAccounts(3, GenDefCoins(t))
+	CheckSetGenesisMockVM(t, app, genValidators)
+
+	baseDenom, quoteDenom := "base", "quote"
+	baseDecimals, quoteDecimals := uint8(0), uint8(0)
+	baseSupply, quoteSupply := sdk.NewInt(1000), sdk.NewInt(1000)
+
+	buyerAddr, sellerAddr := genValidators[0].Address, genValidators[1].Address
+	tester := NewOrderBookTester(t, app)
+
+	marketID := dnTypes.ID{}
+	// init currencies and clients
+	{
+		tester.BeginBlock()
+
+		marketID = tester.RegisterMarket(buyerAddr, baseDenom, baseDecimals, quoteDenom, quoteDecimals)
+		tester.AddClient(buyerAddr, baseSupply, quoteSupply)
+		tester.AddClient(sellerAddr, baseSupply, quoteSupply)
+
+		tester.EndBlock()
+	}
+	assetCode := tester.Markets[marketID.String()].GetAssetCode()
+
+	getFills := func(owner sdk.AccAddress) orders.FillRecords {
+		request := orders.FillRecordsReq{Page: sdk.NewUint(1), Limit: sdk.NewUint(10), Owner: owner, MarketID: marketID.String()}
+		response := orders.FillRecords{}
+		CheckRunQuery(t, app, request, queryFillRecordsPath, &response)
+
+		return response
+	}
+
+	// bid at 12 is partially filled by ask at 10 (bid gets the clearance price difference refund)
+	var bidID, askID dnTypes.ID
+	fillHeight := int64(0)
+	{
+		tester.BeginBlock()
+
+		bid, err := app.orderKeeper.PostOrder(GetContext(app, false), buyerAddr, assetCode, orders.BidDirection, sdk.NewUint(12), sdk.NewUint(10), 60)
+		require.NoError(t, err)
+		ask, err := app.orderKeeper.PostOrder(GetContext(app, false), sellerAddr, assetCode, orders.AskDirection, sdk.NewUint(10), sdk.NewUint(6), 60)
+		require.NoError(t, err)
+		bidID, askID = bid.ID, ask.ID
+		fillHeight = app.LastBlockHeight() + 1
+
+		tester.EndBlock()
+	}
+
+	// check fill records
+	{
+		buyerFills := getFills(buyerAddr)
+		require.Len(t, buyerFills, 1)
+		require.True(t, buyerFills[0].OrderID.Equal(bidID))
+		require.Equal(t, orders.BidDirection, buyerFills[0].Direction)
+		require.True(t, buyerFills[0].QuantityFilled.Equal(sdk.NewUint(6)))
+		require.Equal(t, fillHeight, buyerFills[0].BlockHeight)
+
+		sellerFills := getFills(sellerAddr)
+		require.Len(t, sellerFills, 1)
+		require.True(t, sellerFills[0].OrderID.Equal(askID))
+		require.Equal(t, orders.AskDirection, sellerFills[0].Direction)
+		require.True(t, sellerFills[0].QuantityFilled.Equal(sdk.NewUint(6)))
+		require.True(t, sellerFills[0].ClearancePrice.Equal(buyerFills[0].ClearancePrice))
+		require.True(t, sellerFills[0].Refund.Empty())
+
+		refundAmount := sdk.NewIntFromBigInt(sdk.NewUint(12).Sub(buyerFills[0].ClearancePrice).MulUint64(6).BigInt())
+		require.True(t, buyerFills[0].Refund.AmountOf(quoteDenom).Equal(refundAmount))
+	}
+}
+
+func TestOrders_BatchOrders(t *testing.T) {
+	t.Parallel()
+
+	app, appStop := NewTestDnAppMockVM()
+	defer appStop()
+
+	genValidators, _, _, genPrivKeys := CreateGenAccounts(3, GenDefCoins(t))
+	CheckSetGenesisMockVM(t, app, genValidators)
+
+	baseDenom, quoteDenom := "base", "quote"
+	baseDecimals, quoteDecimals := uint8(0), uint8(0)
+	baseSupply, quoteSupply := sdk.NewInt(1000), sdk.NewInt(1000)
+
+	clientAddr, clientPrivKey := genValidators[0].Address, genPrivKeys[0]
+	tester := NewOrderBookTester(t, app)
+
+	marketID := dnTypes.ID{}
+	// init currencies and clients
+	{
+		tester.BeginBlock()
+
+		marketID = tester.RegisterMarket(clientAddr, baseDenom, baseDecimals, quoteDenom, quoteDecimals)
+		tester.AddClient(clientAddr, baseSupply, quoteSupply)
+
+		tester.EndBlock()
+	}
+	assetCode := tester.Markets[marketID.String()].GetAssetCode()
+
+	checkBalance := func(base, quote int64) {
+		acc := app.accountKeeper.GetAccount(GetContext(app, true), clientAddr)
+		require.True(t, acc.GetCoins().AmountOf(baseDenom).Equal(sdk.NewInt(base)), "base %s", acc.GetCoins().AmountOf(baseDenom))
+		require.True(t, acc.GetCoins().AmountOf(quoteDenom).Equal(sdk.NewInt(quote)), "quote %s", acc.GetCoins().AmountOf(quoteDenom))
+	}
+
+	newBatchTx := func(cancels []dnTypes.ID, posts []orders.BatchOrderPost) auth.StdTx {
+		acc := GetAccountCheckTx(app, clientAddr)
+		msg := orders.NewMsgBatchOrders(clientAddr, cancels, posts)
+
+		return GenTx([]sdk.Msg{msg}, []uint64{acc.GetAccountNumber()}, []uint64{acc.GetSequence()}, clientPrivKey)
+	}
+
+	newPost := func(direction orders.Direction, price, quantity uint64) orders.BatchOrderPost {
+		return orders.BatchOrderPost{
+			AssetCode: assetCode,
+			Direction: direction,
+			Price:     sdk.NewUint(price),
+			Quantity:  sdk.NewUint(quantity),
+			TtlInSec:  60,
+		}
+	}
+
+	// post asks to cancel
+	askIDs := make([]dnTypes.ID, 0, 2)
+	{
+		tester.BeginBlock()
+
+		for _, price := range []uint64{20, 30} {
+			order, err := app.orderKeeper.PostOrder(GetContext(app, false), clientAddr, assetCode, orders.AskDirection, sdk.NewUint(price), sdk.NewUint(10), 60)
+			require.NoError(t, err)
+			askIDs = append(askIDs, order.ID)
+		}
+
+		tester.EndBlock()
+
+		checkBalance(980, 1000)
+	}
+
+	// batch: both asks are canceled, new ask and bid are posted (new order IDs are returned)
+	var newIDs []dnTypes.ID
+	{
+		tx := newBatchTx(askIDs, []orders.BatchOrderPost{newPost(orders.AskDirection, 25, 15), newPost(orders.BidDirection, 5, 10)})
+		res, err := DeliverTx(app, tx)
+		require.NoError(t, err, ResultErrorMsg(res, err))
+
+		require.NoError(t, app.cdc.UnmarshalBinaryLengthPrefixed(res.Data, &newIDs))
+		require.Len(t, newIDs, 2)
+
+		ctx := GetContext(app, true)
+		for _, id := range askIDs {
+			require.False(t, app.orderKeeper.Has(ctx, id))
+		}
+		for _, id := range newIDs {
+			order, err := app.orderKeeper.Get(ctx, id)
+			require.NoError(t, err)
+			require.True(t, order.Owner.Equals(clientAddr))
+		}
+
+		checkBalance(985, 950)
+	}
+
+	// batch with a failing post: nothing is changed
+	{
+		invalidPost := newPost(orders.AskDirection, 25, 15)
+		invalidPost.AssetCode = "foo_bar"
+
+		tx := newBatchTx(newIDs[:1], []orders.BatchOrderPost{newPost(orders.AskDirection, 40, 10), invalidPost})
+		CheckDeliverSpecificErrorTx(t, app, tx, orders.ErrWrongAssetCode)
+
+		require.True(t, app.orderKeeper.Has(GetContext(app, true), newIDs[0]))
+		checkBalance(985, 950)
+	}
+}
+
+func TestOrders_RevokeAllOrders(t *testing.T) {
+	t.Parallel()
+
+	app, appStop := NewTestDnAppMockVM()
+	defer appStop()
+
+	genValidators, _, _, genPrivKeys := CreateGenAccounts(3, GenDefCoins(t))
+	CheckSetGenesisMockVM(t, app, genValidators)
+
+	baseDenom, quoteDenom := "base", "quote"
+	baseDecimals, quoteDecimals := uint8(0), uint8(0)
+	baseSupply, quoteSupply := sdk.NewInt(1000), sdk.NewInt(1000)
+
+	clientAddr, clientPrivKey := genValidators[0].Address, genPrivKeys[0]
+	tester := NewOrderBookTester(t, app)
+
+	marketID := dnTypes.ID{}
+	// init currencies and clients
+	{
+		tester.BeginBlock()
+
+		marketID = tester.RegisterMarket(clientAddr, baseDenom, baseDecimals, quoteDenom, quoteDecimals)
+		tester.AddClient(clientAddr, baseSupply, quoteSupply)
+
+		tester.EndBlock()
+	}
+	assetCode := tester.Markets[marketID.String()].GetAssetCode()
+
+	checkBalance := func(base, quote int64) {
+		acc := app.accountKeeper.GetAccount(GetContext(app, true), clientAddr)
+		require.True(t, acc.GetCoins().AmountOf(baseDenom).Equal(sdk.NewInt(base)), "base %s", acc.GetCoins().AmountOf(baseDenom))
+		require.True(t, acc.GetCoins().AmountOf(quoteDenom).Equal(sdk.NewInt(quote)), "quote %s", acc.GetCoins().AmountOf(quoteDenom))
+	}
+
+	revokeAll := func(marketID string, direction orders.Direction) []dnTypes.ID {
+		acc := GetAccountCheckTx(app, clientAddr)
+		msg := orders.NewMsgRevokeAllOrders(clientAddr, marketID, direction)
+		tx := GenTx([]sdk.Msg{msg}, []uint64{acc.GetAccountNumber()}, []uint64{acc.GetSequence()}, clientPrivKey)
+
+		res, err := DeliverTx(app, tx)
+		require.NoError(t, err, ResultErrorMsg(res, err))
+
+		ids := make([]dnTypes.ID, 0)
+		require.NoError(t, app.cdc.UnmarshalBinaryLengthPrefixed(res.Data, &ids))
+
+		return ids
+	}
+
+	// post non-crossing bids and asks
+	bidIDs, askIDs := make([]dnTypes.ID, 0, 2), make([]dnTypes.ID, 0, 2)
+	{
+		tester.BeginBlock()
+
+		for _, price := range []uint64{5, 6} {
+			order, err := app.orderKeeper.PostOrder(GetContext(app, false), clientAddr, assetCode, orders.BidDirection, sdk.NewUint(price), sdk.NewUint(10), 60)
+			require.NoError(t, err)
+			bidIDs = append(bidIDs, order.ID)
+		}
+		for _, price := range []uint64{20, 30} {
+			order, err := app.orderKeeper.PostOrder(GetContext(app, false), clientAddr, assetCode, orders.AskDirection, sdk.NewUint(price), sdk.NewUint(10), 60)
+			require.NoError(t, err)
+			askIDs = append(askIDs, order.ID)
+		}
+
+		tester.EndBlock()
+
+		checkBalance(980, 890)
+	}
+
+	// revoke asks for the market
+	{
+		ids := revokeAll(marketID.String(), orders.AskDirection)
+		require.ElementsMatch(t, askIDs, ids)
+		checkBalance(1000, 890)
+	}
+
+	// revoke the rest
+	{
+		ids := revokeAll("", "")
+		require.ElementsMatch(t, bidIDs, ids)
+		checkBalance(1000, 1000)
+	}
+
+	// nothing to revoke
+	require.Empty(t, revokeAll("", ""))
+}
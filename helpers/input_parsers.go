@@ -127,6 +127,16 @@ func ParseSdkUintParam(argName, argValue string, paramType ParamType) (sdk.Uint,
 	return sdk.NewUintFromBigInt(vInt.BigInt()), nil
 }
 
+// ParseSdkDecParam parses sdk.Dec param.
+func ParseSdkDecParam(argName, argValue string, paramType ParamType) (sdk.Dec, error) {
+	v, err := sdk.NewDecFromStr(argValue)
+	if err != nil {
+		return sdk.Dec{}, fmt.Errorf("%s %s %q: parsing Dec: %v", argName, paramType, argValue, err)
+	}
+
+	return v, nil
+}
+
 // ParseUint8Param parses uint8 param.
 func ParseUint8Param(argName, argValue string, paramType ParamType) (uint8, error) {
 	v, err := strconv.ParseUint(argValue, 10, 8)
@@ -137,6 +147,16 @@ func ParseUint8Param(argName, argValue string, paramType ParamType) (uint8, erro
 	return uint8(v), nil
 }
 
+// ParseUint32Param parses uint32 param.
+func ParseUint32Param(argName, argValue string, paramType ParamType) (uint32, error) {
+	v, err := strconv.ParseUint(argValue, 10, 32)
+	if err != nil {
+		return uint32(0), fmt.Errorf("%s %s %q: uint32 parsing: %w", argName, paramType, argValue, err)
+	}
+
+	return uint32(v), nil
+}
+
 // ParseUint64Param parses uint64 param.
 func ParseUint64Param(argName, argValue string, paramType ParamType) (uint64, error) {
 	v, err := strconv.ParseUint(argValue, 10, 64)
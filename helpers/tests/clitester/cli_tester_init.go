@@ -12,6 +12,7 @@ import (
 
 	"github.com/dfinance/dnode/cmd/config"
 	dnConfig "github.com/dfinance/dnode/cmd/config"
+	"github.com/dfinance/dnode/x/markets"
 )
 
 func (ct *CLITester) initChain() {
@@ -214,6 +215,21 @@ func (ct *CLITester) initChain() {
 		ct.updateGenesisState(appState)
 	}
 
+	// adjust markets genesis (enable market creation via message)
+	{
+		appState := ct.GenesisState()
+
+		marketsGenesis := markets.GenesisState{}
+		require.NoError(ct.t, ct.Cdc.UnmarshalJSON(appState[markets.ModuleName], &marketsGenesis), "unmarshal markets genesisState")
+
+		marketsGenesis.Params.CreateMarketMsgEnabled = true
+		marketsGenesisRaw, err := ct.Cdc.MarshalJSON(marketsGenesis)
+		require.NoError(ct.t, err, "marshal markets genesisState")
+		appState[markets.ModuleName] = marketsGenesisRaw
+
+		ct.updateGenesisState(appState)
+	}
+
 	// collect genTXs
 	{
 		cmd := ct.newWbdCmd().AddArg("", "collect-gentxs")
@@ -218,9 +218,9 @@ func (ct *CLITester) RestQueryOracleRawPrices(assetCode dnTypes.AssetCode, block
 	return r, respMsg
 }
 
-func (ct *CLITester) RestQueryOraclePrice(assetCode dnTypes.AssetCode) (*RestRequest, *oracle.CurrentPrice) {
+func (ct *CLITester) RestQueryOraclePrice(assetCode dnTypes.AssetCode) (*RestRequest, *oracle.QueryPriceResp) {
 	reqSubPath := fmt.Sprintf("%s/currentprice/%s", oracle.ModuleName, assetCode.String())
-	respMsg := &oracle.CurrentPrice{}
+	respMsg := &oracle.QueryPriceResp{}
 
 	r := ct.newRestRequest().SetQuery("GET", reqSubPath, nil, nil, respMsg)
 
@@ -77,6 +77,12 @@ func (r *TxRequest) SetOffline() *TxRequest {
 	return r
 }
 
+func (r *TxRequest) SetFlag(name, value string) *TxRequest {
+	r.cmd.AddArg(name, value)
+
+	return r
+}
+
 func (r *TxRequest) ChangeCmdArg(oldArg, newArg string) *TxRequest {
 	r.cmd.ChangeArg(oldArg, newArg)
 
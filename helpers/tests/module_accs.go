@@ -10,6 +10,8 @@ var (
 	MAccPerms map[string][]string = map[string][]string{
 		auth.FeeCollectorName: nil,
 		"orders":     {supply.Burner},
+		"orders_fees": nil,
+		"oracle":      nil,
 	}
 )
 
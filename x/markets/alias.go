@@ -12,17 +12,52 @@ type (
 	MarketExtended  = types.MarketExtended
 	MsgCreateMarket = types.MsgCreateMarket
 	GenesisState    = types.GenesisState
+	Params          = types.Params
+	MarketStatus    = types.MarketStatus
+	PriceReference  = types.PriceReference
+	//
+	MsgSetMarketStatus = types.MsgSetMarketStatus
+	//
+	AddMarketProposal                  = types.AddMarketProposal
+	UpdateMarketFeesProposal           = types.UpdateMarketFeesProposal
+	UpdateMarketStatusProposal         = types.UpdateMarketStatusProposal
+	UpdateMarketCircuitBreakerProposal = types.UpdateMarketCircuitBreakerProposal
 )
 
 const (
-	ModuleName = types.ModuleName
-	StoreKey   = types.StoreKey
+	ModuleName   = types.ModuleName
+	StoreKey     = types.StoreKey
+	RouterKey    = types.RouterKey
+	GovRouterKey = types.GovRouterKey
+	// Default params subspace name
+	DefaultParamspace = types.DefaultParamspace
+	// Market statuses
+	MarketStatusActive   = types.MarketStatusActive
+	MarketStatusPostOnly = types.MarketStatusPostOnly
+	MarketStatusHalted   = types.MarketStatusHalted
+	MarketStatusDelisted = types.MarketStatusDelisted
+	// Circuit breaker reference prices
+	PriceReferenceClearance = types.PriceReferenceClearance
+	PriceReferenceOracle    = types.PriceReferenceOracle
 	// Event types, attribute types and values
-	EventTypeCreate = types.EventTypeCreate
+	EventTypeCreate               = types.EventTypeCreate
+	EventTypeFeesUpdate           = types.EventTypeFeesUpdate
+	EventTypeStatusUpdate         = types.EventTypeStatusUpdate
+	EventTypeCircuitBreakerUpdate = types.EventTypeCircuitBreakerUpdate
+	EventTypeOrderLimitsUpdate    = types.EventTypeOrderLimitsUpdate
 	//
-	AttributeMarketId   = types.AttributeMarketId
-	AttributeBaseDenom  = types.AttributeBaseDenom
-	AttributeQuoteDenom = types.AttributeQuoteDenom
+	AttributeMarketId          = types.AttributeMarketId
+	AttributeBaseDenom         = types.AttributeBaseDenom
+	AttributeQuoteDenom        = types.AttributeQuoteDenom
+	AttributeMakerFee          = types.AttributeMakerFee
+	AttributeTakerFee          = types.AttributeTakerFee
+	AttributeStatus            = types.AttributeStatus
+	AttributeMaxPriceDeviation = types.AttributeMaxPriceDeviation
+	AttributePriceReference    = types.AttributePriceReference
+	AttributeHaltBlocks        = types.AttributeHaltBlocks
+	AttributeTickSize          = types.AttributeTickSize
+	AttributeLotSize           = types.AttributeLotSize
+	AttributeMinNotional       = types.AttributeMinNotional
 )
 
 var (
@@ -30,19 +65,43 @@ var (
 	ModuleCdc            = types.ModuleCdc
 	AvailablePermissions = types.AvailablePermissions
 	// function aliases
-	RegisterCodec       = types.RegisterCodec
-	NewKeeper           = keeper.NewKeeper
-	NewQuerier          = keeper.NewQuerier
-	DefaultGenesisState = types.DefaultGenesisState
-	NewMarket           = types.NewMarket
-	NewMarketsFilter    = types.NewMarketsFilter
-	NewMarketExtended   = types.NewMarketExtended
+	RegisterCodec          = types.RegisterCodec
+	NewKeeper              = keeper.NewKeeper
+	NewQuerier             = keeper.NewQuerier
+	RegisterInvariants     = keeper.RegisterInvariants
+	DefaultGenesisState    = types.DefaultGenesisState
+	DefaultParams          = types.DefaultParams
+	NewParams              = types.NewParams
+	NewMarket              = types.NewMarket
+	NewMarketsFilter       = types.NewMarketsFilter
+	NewMarketExtended      = types.NewMarketExtended
+	ValidateFeeRate        = types.ValidateFeeRate
+	NewMarketStatusRaw     = types.NewMarketStatusRaw
+	NewPriceReferenceRaw   = types.NewPriceReferenceRaw
+	ValidateCircuitBreaker = types.ValidateCircuitBreaker
+	//
+	NewMsgCreateMarket    = types.NewMsgCreateMarket
+	NewMsgSetMarketStatus = types.NewMsgSetMarketStatus
+	//
+	NewAddMarketProposal                  = types.NewAddMarketProposal
+	NewUpdateMarketFeesProposal           = types.NewUpdateMarketFeesProposal
+	NewUpdateMarketStatusProposal         = types.NewUpdateMarketStatusProposal
+	NewUpdateMarketCircuitBreakerProposal = types.NewUpdateMarketCircuitBreakerProposal
 	// perms requests
 	RequestCCStoragePerms = types.RequestCCStoragePerms
 	// error aliases
-	ErrWrongID         = types.ErrWrongID
-	ErrWrongAssetDenom = types.ErrWrongAssetDenom
-	ErrMarketExists    = types.ErrMarketExists
-	ErrInvalidQuantity = types.ErrInvalidQuantity
-	ErrWrongFrom       = types.ErrWrongFrom
+	ErrWrongID              = types.ErrWrongID
+	ErrWrongAssetDenom      = types.ErrWrongAssetDenom
+	ErrMarketExists         = types.ErrMarketExists
+	ErrInvalidQuantity      = types.ErrInvalidQuantity
+	ErrWrongFrom            = types.ErrWrongFrom
+	ErrWrongFeeRate         = types.ErrWrongFeeRate
+	ErrWrongTickSize        = types.ErrWrongTickSize
+	ErrWrongLotSize         = types.ErrWrongLotSize
+	ErrMinNotional          = types.ErrMinNotional
+	ErrWrongStatus          = types.ErrWrongStatus
+	ErrWrongCircuitBreaker  = types.ErrWrongCircuitBreaker
+	ErrCreateMarketDisabled = types.ErrCreateMarketDisabled
+	//
+	ErrGovInvalidProposal = types.ErrGovInvalidProposal
 )
@@ -16,12 +16,23 @@ import (
 	"github.com/dfinance/dnode/x/markets/internal/types"
 )
 
+const (
+	flagMarketMakerFee    = "maker-fee"
+	flagMarketTakerFee    = "taker-fee"
+	flagMarketTickSize    = "tick-size"
+	flagMarketLotSize     = "lot-size"
+	flagMarketMinNotional = "min-notional"
+	flagMarketMaxPriceDev = "max-price-deviation"
+	flagMarketPriceRef    = "price-reference"
+	flagMarketHaltBlocks  = "halt-blocks"
+)
+
 // AddMarketGenCmd adds market to app genesis state.
 func AddMarketGenCmd(ctx *server.Context, cdc *codec.Codec, defaultNodeHome string) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "add-market-gen [base_denom] [quote_denom]",
 		Short:   "Add market to genesis.json",
-		Example: "add-market-gen xfi eth",
+		Example: "add-market-gen xfi eth --tick-size 1000 --lot-size 100 --min-notional 1000000",
 		Args:    cobra.ExactArgs(2),
 		RunE: func(_ *cobra.Command, args []string) error {
 			config := ctx.Config
@@ -36,6 +47,31 @@ func AddMarketGenCmd(ctx *server.Context, cdc *codec.Codec, defaultNodeHome stri
 				return err
 			}
 
+			tickSize, err := helpers.ParseSdkUintParam(flagMarketTickSize, viper.GetString(flagMarketTickSize), helpers.ParamTypeCliFlag)
+			if err != nil {
+				return err
+			}
+			lotSize, err := helpers.ParseSdkUintParam(flagMarketLotSize, viper.GetString(flagMarketLotSize), helpers.ParamTypeCliFlag)
+			if err != nil {
+				return err
+			}
+			minNotional, err := helpers.ParseSdkUintParam(flagMarketMinNotional, viper.GetString(flagMarketMinNotional), helpers.ParamTypeCliFlag)
+			if err != nil {
+				return err
+			}
+			maxPriceDev, err := helpers.ParseSdkDecParam(flagMarketMaxPriceDev, viper.GetString(flagMarketMaxPriceDev), helpers.ParamTypeCliFlag)
+			if err != nil {
+				return err
+			}
+			priceRef, err := parsePriceReferenceParam(flagMarketPriceRef, viper.GetString(flagMarketPriceRef), helpers.ParamTypeCliFlag)
+			if err != nil {
+				return err
+			}
+			haltBlocks, err := helpers.ParseUint64Param(flagMarketHaltBlocks, viper.GetString(flagMarketHaltBlocks), helpers.ParamTypeCliFlag)
+			if err != nil {
+				return err
+			}
+
 			// retrieve the app state
 			genFile := config.GenesisFile()
 			appState, genDoc, err := genutil.GenesisStateFromGenFile(cdc, genFile)
@@ -82,11 +118,13 @@ func AddMarketGenCmd(ctx *server.Context, cdc *codec.Codec, defaultNodeHome stri
 			marketID = &id
 
 			genesisMarket.LastMarketID = marketID
-			genesisMarket.Markets = append(genesisMarket.Markets, types.Market{
-				ID:              *marketID,
-				BaseAssetDenom:  baseDenom,
-				QuoteAssetDenom: quoteDenom,
-			})
+			market := types.NewMarket(*marketID, baseDenom, quoteDenom)
+			market.TickSize, market.LotSize, market.MinNotional = tickSize, lotSize, minNotional
+			market.MaxPriceDeviation, market.PriceReference, market.HaltBlocks = maxPriceDev, priceRef, haltBlocks
+			if err := market.Valid(); err != nil {
+				return err
+			}
+			genesisMarket.Markets = append(genesisMarket.Markets, market)
 
 			// update the app state
 			genesisStateBz := cdc.MustMarshalJSON(genesisMarket)
@@ -107,6 +145,12 @@ func AddMarketGenCmd(ctx *server.Context, cdc *codec.Codec, defaultNodeHome stri
 		"quote currency denomination symbol",
 	})
 	cmd.Flags().String(cli.HomeFlag, defaultNodeHome, "node's home directory")
+	cmd.Flags().String(flagMarketTickSize, "0", "(optional) order price step in quote asset min units")
+	cmd.Flags().String(flagMarketLotSize, "0", "(optional) order quantity step in base asset min units")
+	cmd.Flags().String(flagMarketMinNotional, "0", "(optional) min order price * quantity value in quote asset min units")
+	cmd.Flags().String(flagMarketMaxPriceDev, "0", "(optional) circuit breaker max clearance price deviation (fraction)")
+	cmd.Flags().String(flagMarketPriceRef, types.PriceReferenceClearance.String(), "(optional) circuit breaker reference price source [clearance,oracle]")
+	cmd.Flags().String(flagMarketHaltBlocks, "0", "(optional) circuit breaker post-only period in blocks")
 
 	return cmd
 }
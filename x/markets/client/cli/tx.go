@@ -1,20 +1,26 @@
 package cli
 
 import (
+	"fmt"
+	"os"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	govCli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
 	"github.com/spf13/cobra"
 
 	"github.com/dfinance/dnode/helpers"
 	"github.com/dfinance/dnode/x/markets/internal/types"
+	msClient "github.com/dfinance/dnode/x/multisig/client"
 )
 
 // GetCmdAddMarket returns tx command which adds a market object.
 func GetCmdAddMarket(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "add [base_denom] [quote_denom]",
-		Short:   "Add a new market",
+		Short:   "Add a new market (if enabled by module params, use add-market-proposal otherwise)",
 		Example: "add xfi eth",
 		Args:    cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -47,3 +53,356 @@ func GetCmdAddMarket(cdc *codec.Codec) *cobra.Command {
 
 	return cmd
 }
+
+// AddMarketProposal returns tx command which submits market creation gov proposal.
+func AddMarketProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "add-market-proposal [base_denom] [quote_denom]",
+		Args:    cobra.ExactArgs(2),
+		Short:   "Submit a new market creation proposal",
+		Example: "add-market-proposal btc xfi --maker-fee 0.001 --taker-fee 0.002 --tick-size 1000 --deposit 100xfi --fees 1xfi",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, txBuilder := helpers.GetTxCmdCtx(cdc, cmd.InOrStdin())
+
+			// parse inputs
+			fromAddr, err := helpers.ParseFromFlag(cliCtx)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := helpers.ParseDepositFlag(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			baseDenom, quoteDenom := args[0], args[1]
+			if err := helpers.ValidateDenomParam("base_denom", baseDenom, helpers.ParamTypeCliArg); err != nil {
+				return err
+			}
+			if err := helpers.ValidateDenomParam("quote_denom", quoteDenom, helpers.ParamTypeCliArg); err != nil {
+				return err
+			}
+
+			// prepare and send message
+			content := types.NewAddMarketProposal(baseDenom, quoteDenom)
+			if err := parseAddMarketProposalFlags(cmd, &content); err != nil {
+				return err
+			}
+			if err := content.ValidateBasic(); err != nil {
+				return err
+			}
+
+			msg := gov.NewMsgSubmitProposal(content, deposit, fromAddr)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBuilder, []sdk.Msg{msg})
+		},
+	}
+	cmd.Flags().String(govCli.FlagDeposit, "", "deposit of proposal")
+	cmd.Flags().String(flagMarketMakerFee, "", "(optional) maker fee rate")
+	cmd.Flags().String(flagMarketTakerFee, "", "(optional) taker fee rate")
+	cmd.Flags().String(flagMarketTickSize, "", "(optional) order price step in quote asset min units")
+	cmd.Flags().String(flagMarketLotSize, "", "(optional) order quantity step in base asset min units")
+	cmd.Flags().String(flagMarketMinNotional, "", "(optional) min order price * quantity value in quote asset min units")
+	helpers.BuildCmdHelp(cmd, []string{
+		"base currency denomination symbol",
+		"quote currency denomination symbol",
+	})
+
+	return cmd
+}
+
+// parseAddMarketProposalFlags parses optional market fee rates and order limits flags (only flags set by the user are applied).
+func parseAddMarketProposalFlags(cmd *cobra.Command, p *types.AddMarketProposal) error {
+	flags := cmd.Flags()
+
+	for _, item := range []struct {
+		flag   string
+		target *sdk.Dec
+	}{
+		{flagMarketMakerFee, &p.MakerFee},
+		{flagMarketTakerFee, &p.TakerFee},
+	} {
+		if !flags.Changed(item.flag) {
+			continue
+		}
+
+		value, _ := flags.GetString(item.flag)
+		rate, err := helpers.ParseSdkDecParam(item.flag, value, helpers.ParamTypeCliFlag)
+		if err != nil {
+			return err
+		}
+		*item.target = rate
+	}
+
+	for _, item := range []struct {
+		flag   string
+		target *sdk.Uint
+	}{
+		{flagMarketTickSize, &p.TickSize},
+		{flagMarketLotSize, &p.LotSize},
+		{flagMarketMinNotional, &p.MinNotional},
+	} {
+		if !flags.Changed(item.flag) {
+			continue
+		}
+
+		value, _ := flags.GetString(item.flag)
+		limit, err := helpers.ParseSdkUintParam(item.flag, value, helpers.ParamTypeCliFlag)
+		if err != nil {
+			return err
+		}
+		*item.target = limit
+	}
+
+	return nil
+}
+
+// UpdateMarketFeesProposal returns tx command which sends governance market fees update proposal.
+func UpdateMarketFeesProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "update-fees-proposal [market_id] [maker_fee] [taker_fee]",
+		Args:    cobra.ExactArgs(3),
+		Short:   "Submit market fee rates update proposal",
+		Example: "update-fees-proposal 0 0.001 0.002 --deposit 100xfi --fees 1xfi",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, txBuilder := helpers.GetTxCmdCtx(cdc, cmd.InOrStdin())
+
+			// parse inputs
+			fromAddr, err := helpers.ParseFromFlag(cliCtx)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := helpers.ParseDepositFlag(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			marketID, err := helpers.ParseDnIDParam("market_id", args[0], helpers.ParamTypeCliArg)
+			if err != nil {
+				return err
+			}
+
+			makerFee, err := helpers.ParseSdkDecParam("maker_fee", args[1], helpers.ParamTypeCliArg)
+			if err != nil {
+				return err
+			}
+
+			takerFee, err := helpers.ParseSdkDecParam("taker_fee", args[2], helpers.ParamTypeCliArg)
+			if err != nil {
+				return err
+			}
+
+			// prepare and send message
+			content := types.NewUpdateMarketFeesProposal(marketID, makerFee, takerFee)
+			if err := content.ValidateBasic(); err != nil {
+				return err
+			}
+
+			msg := gov.NewMsgSubmitProposal(content, deposit, fromAddr)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBuilder, []sdk.Msg{msg})
+		},
+	}
+	cmd.Flags().String(govCli.FlagDeposit, "", "deposit of proposal")
+	helpers.BuildCmdHelp(cmd, []string{
+		"market ID",
+		"maker fee rate (fraction of the fill amount, for ex. 0.001)",
+		"taker fee rate (fraction of the fill amount, for ex. 0.002)",
+	})
+
+	return cmd
+}
+
+// UpdateMarketCircuitBreakerProposal returns tx command which submits market circuit breaker params update gov proposal.
+func UpdateMarketCircuitBreakerProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "update-circuit-breaker-proposal [market_id] [max_price_deviation] [price_reference] [halt_blocks]",
+		Args:    cobra.ExactArgs(4),
+		Short:   "Submit market circuit breaker params update proposal",
+		Example: "update-circuit-breaker-proposal 0 0.1 oracle 10 --deposit 100xfi --fees 1xfi",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, txBuilder := helpers.GetTxCmdCtx(cdc, cmd.InOrStdin())
+
+			// parse inputs
+			fromAddr, err := helpers.ParseFromFlag(cliCtx)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := helpers.ParseDepositFlag(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			marketID, err := helpers.ParseDnIDParam("market_id", args[0], helpers.ParamTypeCliArg)
+			if err != nil {
+				return err
+			}
+
+			maxDeviation, err := helpers.ParseSdkDecParam("max_price_deviation", args[1], helpers.ParamTypeCliArg)
+			if err != nil {
+				return err
+			}
+
+			reference, err := parsePriceReferenceParam("price_reference", args[2], helpers.ParamTypeCliArg)
+			if err != nil {
+				return err
+			}
+
+			haltBlocks, err := helpers.ParseUint64Param("halt_blocks", args[3], helpers.ParamTypeCliArg)
+			if err != nil {
+				return err
+			}
+
+			// prepare and send message
+			content := types.NewUpdateMarketCircuitBreakerProposal(marketID, maxDeviation, reference, haltBlocks)
+			if err := content.ValidateBasic(); err != nil {
+				return err
+			}
+
+			msg := gov.NewMsgSubmitProposal(content, deposit, fromAddr)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBuilder, []sdk.Msg{msg})
+		},
+	}
+	cmd.Flags().String(govCli.FlagDeposit, "", "deposit of proposal")
+	helpers.BuildCmdHelp(cmd, []string{
+		"market ID",
+		"max clearance price deviation from the reference price (fraction, for ex. 0.1), 0 disables the check",
+		"reference price source [clearance,oracle]",
+		"number of blocks market is switched to post-only on breach, 0 only skips the block matching",
+	})
+
+	return cmd
+}
+
+// UpdateMarketStatusProposal returns tx command which submits market status update gov proposal.
+func UpdateMarketStatusProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "update-status-proposal [market_id] [status]",
+		Args:    cobra.ExactArgs(2),
+		Short:   "Submit market status update proposal",
+		Example: "update-status-proposal 0 halted --deposit 100xfi --fees 1xfi",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, txBuilder := helpers.GetTxCmdCtx(cdc, cmd.InOrStdin())
+
+			// parse inputs
+			fromAddr, err := helpers.ParseFromFlag(cliCtx)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := helpers.ParseDepositFlag(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			marketID, err := helpers.ParseDnIDParam("market_id", args[0], helpers.ParamTypeCliArg)
+			if err != nil {
+				return err
+			}
+
+			status, err := parseMarketStatusParam("status", args[1], helpers.ParamTypeCliArg)
+			if err != nil {
+				return err
+			}
+
+			// prepare and send message
+			content := types.NewUpdateMarketStatusProposal(marketID, status)
+			if err := content.ValidateBasic(); err != nil {
+				return err
+			}
+
+			msg := gov.NewMsgSubmitProposal(content, deposit, fromAddr)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBuilder, []sdk.Msg{msg})
+		},
+	}
+	cmd.Flags().String(govCli.FlagDeposit, "", "deposit of proposal")
+	helpers.BuildCmdHelp(cmd, []string{
+		"market ID",
+		"market status [active, post_only, halted, delisted]",
+	})
+
+	return cmd
+}
+
+// PostMsSetMarketStatus returns tx command which post a new multisig set market status request.
+func PostMsSetMarketStatus(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "ms-set-status [uniqueID] [market_id] [status]",
+		Short:   "Change market status via multisignature",
+		Example: "ms-set-status halt1 0 halted --from {account}",
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, txBuilder := helpers.GetTxCmdCtx(cdc, cmd.InOrStdin())
+
+			// parse inputs
+			fromAddr, err := helpers.ParseFromFlag(cliCtx)
+			if err != nil {
+				return err
+			}
+
+			marketID, err := helpers.ParseDnIDParam("market_id", args[1], helpers.ParamTypeCliArg)
+			if err != nil {
+				return err
+			}
+
+			status, err := parseMarketStatusParam("status", args[2], helpers.ParamTypeCliArg)
+			if err != nil {
+				return err
+			}
+
+			// prepare and send multisig message
+			msg := types.NewMsgSetMarketStatus(marketID, status)
+			callMsg := msClient.NewMsgSubmitCall(msg, args[0], fromAddr)
+			if err := callMsg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			cliCtx.WithOutput(os.Stdout)
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBuilder, []sdk.Msg{callMsg})
+		},
+	}
+	helpers.BuildCmdHelp(cmd, []string{
+		"unique multi signature call ID",
+		"market ID",
+		"market status [active, post_only, halted, delisted]",
+	})
+
+	return cmd
+}
+
+// parseMarketStatusParam parses and validates market status param.
+func parseMarketStatusParam(argName, argValue string, paramType helpers.ParamType) (types.MarketStatus, error) {
+	status := types.NewMarketStatusRaw(argValue)
+	if !status.IsValid() {
+		return "", fmt.Errorf("%s %s %q: invalid market status", argName, paramType, argValue)
+	}
+
+	return status, nil
+}
+
+// parsePriceReferenceParam parses and validates circuit breaker reference price source.
+func parsePriceReferenceParam(argName, argValue string, paramType helpers.ParamType) (types.PriceReference, error) {
+	reference := types.NewPriceReferenceRaw(argValue)
+	if !reference.IsValid() {
+		return "", fmt.Errorf("%s %s %q: invalid price reference", argName, paramType, argValue)
+	}
+
+	return reference, nil
+}
@@ -37,6 +37,11 @@ func GetTxCmd(cdc *amino.Codec) *cobra.Command {
 
 	txCmd.AddCommand(sdkClient.PostCommands(
 		cli.GetCmdAddMarket(cdc),
+		cli.AddMarketProposal(cdc),
+		cli.UpdateMarketFeesProposal(cdc),
+		cli.UpdateMarketStatusProposal(cdc),
+		cli.UpdateMarketCircuitBreakerProposal(cdc),
+		cli.PostMsSetMarketStatus(cdc),
 	)...,
 	)
 
@@ -0,0 +1,107 @@
+package markets
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	govTypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	dnTypes "github.com/dfinance/dnode/helpers/types"
+)
+
+// NewGovHandler creates proposal type handler for Gov module.
+func NewGovHandler(k Keeper) gov.Handler {
+	return func(ctx sdk.Context, c govTypes.Content) error {
+		if c.ProposalRoute() != GovRouterKey {
+			return fmt.Errorf("invalid proposal route %q for module %q", c.ProposalRoute(), ModuleName)
+		}
+
+		switch p := c.(type) {
+		case AddMarketProposal:
+			return handleAddMarketProposal(ctx, k, p)
+		case UpdateMarketFeesProposal:
+			return handleUpdateMarketFeesProposal(ctx, k, p)
+		case UpdateMarketStatusProposal:
+			return handleUpdateMarketStatusProposal(ctx, k, p)
+		case UpdateMarketCircuitBreakerProposal:
+			return handleUpdateMarketCircuitBreakerProposal(ctx, k, p)
+		default:
+			return fmt.Errorf("unsupported proposal content type %q for module %q", c.ProposalType(), ModuleName)
+		}
+	}
+}
+
+// handleAddMarketProposal handles market creation proposal (optional fee rates and order limits are applied to the new market).
+func handleAddMarketProposal(ctx sdk.Context, k Keeper, p AddMarketProposal) error {
+	logger := k.GetLogger(ctx)
+
+	market, err := k.Add(ctx, p.BaseAssetDenom, p.QuoteAssetDenom)
+	if err != nil {
+		return sdkErrors.Wrapf(ErrGovInvalidProposal, "creating market: %v", err)
+	}
+
+	if p.HasFees() {
+		if _, err := k.SetFees(ctx, market.ID, p.MakerFee, p.TakerFee); err != nil {
+			return sdkErrors.Wrapf(ErrGovInvalidProposal, "setting market fees: %v", err)
+		}
+	}
+
+	if p.HasOrderLimits() {
+		if _, err := k.SetOrderLimits(ctx, market.ID, p.TickSize, p.LotSize, p.MinNotional); err != nil {
+			return sdkErrors.Wrapf(ErrGovInvalidProposal, "setting market order limits: %v", err)
+		}
+	}
+
+	logger.Info(fmt.Sprintf("proposal executed:\n%s", p.String()))
+
+	ctx.EventManager().EmitEvent(dnTypes.NewModuleNameEvent(ModuleName))
+
+	return nil
+}
+
+// handleUpdateMarketFeesProposal handles market fee rates update proposal.
+func handleUpdateMarketFeesProposal(ctx sdk.Context, k Keeper, p UpdateMarketFeesProposal) error {
+	logger := k.GetLogger(ctx)
+
+	if _, err := k.SetFees(ctx, p.MarketID, p.MakerFee, p.TakerFee); err != nil {
+		return sdkErrors.Wrapf(ErrGovInvalidProposal, "updating market fees: %v", err)
+	}
+
+	logger.Info(fmt.Sprintf("proposal executed:\n%s", p.String()))
+
+	ctx.EventManager().EmitEvent(dnTypes.NewModuleNameEvent(ModuleName))
+
+	return nil
+}
+
+// handleUpdateMarketStatusProposal handles market status update proposal.
+func handleUpdateMarketStatusProposal(ctx sdk.Context, k Keeper, p UpdateMarketStatusProposal) error {
+	logger := k.GetLogger(ctx)
+
+	if _, err := k.SetStatus(ctx, p.MarketID, p.Status); err != nil {
+		return sdkErrors.Wrapf(ErrGovInvalidProposal, "updating market status: %v", err)
+	}
+
+	logger.Info(fmt.Sprintf("proposal executed:\n%s", p.String()))
+
+	ctx.EventManager().EmitEvent(dnTypes.NewModuleNameEvent(ModuleName))
+
+	return nil
+}
+
+// handleUpdateMarketCircuitBreakerProposal handles market circuit breaker params update proposal.
+func handleUpdateMarketCircuitBreakerProposal(ctx sdk.Context, k Keeper, p UpdateMarketCircuitBreakerProposal) error {
+	logger := k.GetLogger(ctx)
+
+	if _, err := k.SetCircuitBreaker(ctx, p.MarketID, p.MaxPriceDeviation, p.PriceReference, p.HaltBlocks); err != nil {
+		return sdkErrors.Wrapf(ErrGovInvalidProposal, "updating market circuit breaker: %v", err)
+	}
+
+	logger.Info(fmt.Sprintf("proposal executed:\n%s", p.String()))
+
+	ctx.EventManager().EmitEvent(dnTypes.NewModuleNameEvent(ModuleName))
+
+	return nil
+}
@@ -22,8 +22,12 @@ func NewHandler(k Keeper) sdk.Handler {
 }
 
 // handleMsgCreateMarket handles handleMsgCreateMarket message type.
-// Creates and stores new market object.
+// Creates and stores new market object (if enabled by params, otherwise markets are added via AddMarketProposal).
 func handleMsgCreateMarket(ctx sdk.Context, k Keeper, msg MsgCreateMarket) (*sdk.Result, error) {
+	if !k.GetParams(ctx).CreateMarketMsgEnabled {
+		return nil, ErrCreateMarketDisabled
+	}
+
 	market, err := k.Add(ctx, msg.BaseAssetDenom, msg.QuoteAssetDenom)
 	if err != nil {
 		return nil, err
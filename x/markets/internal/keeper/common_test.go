@@ -100,7 +100,7 @@ func NewTestInput(t *testing.T) TestInput {
 		input.vmStorage,
 		types.RequestCCStoragePerms(),
 	)
-	input.keeper = NewKeeper(input.cdc, input.keyMarkets, input.ccsStorage)
+	input.keeper = NewKeeper(input.cdc, input.keyMarkets, input.paramsKeeper.Subspace(types.DefaultParamspace), input.ccsStorage)
 
 	// create context
 	input.ctx = sdk.NewContext(mstore, abci.Header{ChainID: "test-chain-id"}, false, log.NewNopLogger())
@@ -16,6 +16,9 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data json.RawMessage) {
 	state := types.GenesisState{}
 	k.cdc.MustUnmarshalJSON(data, &state)
 
+	// params
+	k.SetParams(ctx, state.Params)
+
 	// lastMarketID
 	if state.LastMarketID != nil {
 		k.setLastID(ctx, *state.LastMarketID)
@@ -39,6 +42,7 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data json.RawMessage) {
 // ExportGenesis exports module genesis state using current params state.
 func (k Keeper) ExportGenesis(ctx sdk.Context) json.RawMessage {
 	state := types.GenesisState{
+		Params:       k.GetParams(ctx),
 		Markets:      k.GetList(ctx),
 		LastMarketID: k.getLastMarketID(ctx),
 	}
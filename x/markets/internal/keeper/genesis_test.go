@@ -32,6 +32,7 @@ func TestMarketsKeeper_Genesis(t *testing.T) {
 			},
 		},
 		LastMarketID: &lastID,
+		Params:       types.NewParams(true),
 	}
 
 	// init
@@ -42,6 +43,9 @@ func TestMarketsKeeper_Genesis(t *testing.T) {
 		require.NotNil(t, keeper.getLastMarketID(ctx))
 		require.Equal(t, initState.LastMarketID.String(), keeper.getLastMarketID(ctx).String())
 
+		// params
+		require.Equal(t, initState.Params, keeper.GetParams(ctx))
+
 		// markets
 		getMarkets := keeper.GetList(ctx)
 		require.Len(t, getMarkets, len(initState.Markets))
@@ -67,6 +71,9 @@ func TestMarketsKeeper_Genesis(t *testing.T) {
 		require.NotNil(t, exportState.LastMarketID)
 		require.Equal(t, keeper.getLastMarketID(ctx).String(), exportState.LastMarketID.String())
 
+		// params
+		require.Equal(t, keeper.GetParams(ctx), exportState.Params)
+
 		// markets
 		getMarkets := keeper.GetList(ctx)
 		require.Len(t, exportState.Markets, len(getMarkets))
@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dfinance/dnode/x/markets/internal/types"
+)
+
+// RegisterInvariants registers all module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "last-id", LastID(k))
+	ir.RegisterRoute(types.ModuleName, "currencies", Currencies(k))
+}
+
+// LastID checks that market IDs don't exceed the last market ID counter.
+func LastID(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		lastID := k.getLastMarketID(ctx)
+
+		irComment := ""
+		k.iterateMarkets(ctx, func(market types.Market) bool {
+			if lastID == nil || market.ID.GT(*lastID) {
+				irComment += fmt.Sprintf("\tmarket %s: ID is above the last market ID\n", market.ID)
+			}
+			return true
+		})
+
+		broken := irComment != ""
+		irComment += fmt.Sprintf("\tlast market ID: %v\n", lastID)
+
+		return sdk.FormatInvariant(types.ModuleName, "last-id", irComment), broken
+	}
+}
+
+// Currencies checks that every market refers to registered base / quote currencies.
+func Currencies(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		irComment := ""
+		k.iterateMarkets(ctx, func(market types.Market) bool {
+			if _, err := k.GetExtended(ctx, market.ID); err != nil {
+				irComment += fmt.Sprintf("\tmarket %s: %v\n", market.ID, err)
+			}
+			return true
+		})
+
+		return sdk.FormatInvariant(types.ModuleName, "currencies", irComment), irComment != ""
+	}
+}
@@ -0,0 +1,53 @@
+// +build unit
+
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dfinance/dnode/x/markets/internal/types"
+)
+
+func TestMarketsKeeper_Invariants(t *testing.T) {
+	t.Parallel()
+
+	input := NewTestInput(t)
+
+	checkInvariant := func(invariant sdk.Invariant, expBroken bool) {
+		msg, broken := invariant(input.ctx)
+		require.Equal(t, expBroken, broken, msg)
+	}
+
+	// empty state
+	checkInvariant(LastID(input.keeper), false)
+	checkInvariant(Currencies(input.keeper), false)
+
+	// add markets
+	btcMarket, err := input.keeper.Add(input.ctx, input.baseBtcDenom, input.quoteDenom)
+	require.NoError(t, err)
+	_, err = input.keeper.Add(input.ctx, input.baseEthDenom, input.quoteDenom)
+	require.NoError(t, err)
+
+	checkInvariant(LastID(input.keeper), false)
+	checkInvariant(Currencies(input.keeper), false)
+
+	// market with non-existing currency
+	{
+		market := btcMarket
+		market.BaseAssetDenom = "test"
+		input.keeper.set(input.ctx, market)
+
+		checkInvariant(Currencies(input.keeper), true)
+	}
+
+	// market ID above the last ID
+	{
+		market := types.NewMarket(input.keeper.nextID(input.ctx).Incr(), input.baseBtcDenom, input.quoteDenom)
+		input.keeper.set(input.ctx, market)
+
+		checkInvariant(LastID(input.keeper), true)
+	}
+}
@@ -30,14 +30,16 @@ func (k Keeper) GetLogger(ctx sdk.Context) log.Logger {
 func NewKeeper(
 	cdc *codec.Codec,
 	storeKey sdk.StoreKey,
+	paramSubspace subspace.Subspace,
 	ccsKeeper ccstorage.Keeper,
 	permsRequesters ...perms.RequestModulePermissions,
 ) Keeper {
 	k := Keeper{
-		cdc:         cdc,
-		storeKey:    storeKey,
-		ccsStorage:  ccsKeeper,
-		modulePerms: types.NewModulePerms(),
+		cdc:           cdc,
+		storeKey:      storeKey,
+		paramSubspace: paramSubspace.WithKeyTable(types.ParamKeyTable()),
+		ccsStorage:    ccsKeeper,
+		modulePerms:   types.NewModulePerms(),
 	}
 	for _, requester := range permsRequesters {
 		k.modulePerms.AutoAddRequester(requester)
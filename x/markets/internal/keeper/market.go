@@ -98,6 +98,92 @@ func (k Keeper) Add(ctx sdk.Context, baseAsset, quoteAsset string) (types.Market
 	return market, nil
 }
 
+// SetFees updates market maker / taker fee rates.
+func (k Keeper) SetFees(ctx sdk.Context, id dnTypes.ID, makerFee, takerFee sdk.Dec) (types.Market, error) {
+	k.modulePerms.AutoCheck(types.PermCreate)
+
+	market, err := k.Get(ctx, id)
+	if err != nil {
+		return types.Market{}, err
+	}
+
+	market.MakerFee, market.TakerFee = makerFee, takerFee
+	if err := market.Valid(); err != nil {
+		return types.Market{}, err
+	}
+	k.set(ctx, market)
+
+	ctx.EventManager().EmitEvent(types.NewMarketFeesUpdatedEvent(market))
+
+	return market, nil
+}
+
+// SetOrderLimits updates market order price / quantity steps and min notional value.
+func (k Keeper) SetOrderLimits(ctx sdk.Context, id dnTypes.ID, tickSize, lotSize, minNotional sdk.Uint) (types.Market, error) {
+	k.modulePerms.AutoCheck(types.PermCreate)
+
+	market, err := k.Get(ctx, id)
+	if err != nil {
+		return types.Market{}, err
+	}
+
+	market.TickSize, market.LotSize, market.MinNotional = tickSize, lotSize, minNotional
+	if err := market.Valid(); err != nil {
+		return types.Market{}, err
+	}
+	k.set(ctx, market)
+
+	ctx.EventManager().EmitEvent(types.NewMarketOrderLimitsUpdatedEvent(market))
+
+	return market, nil
+}
+
+// SetCircuitBreaker updates market circuit breaker params.
+func (k Keeper) SetCircuitBreaker(ctx sdk.Context, id dnTypes.ID, maxDeviation sdk.Dec, reference types.PriceReference, haltBlocks uint64) (types.Market, error) {
+	k.modulePerms.AutoCheck(types.PermCreate)
+
+	market, err := k.Get(ctx, id)
+	if err != nil {
+		return types.Market{}, err
+	}
+
+	market.MaxPriceDeviation, market.PriceReference, market.HaltBlocks = maxDeviation, reference, haltBlocks
+	if err := market.Valid(); err != nil {
+		return types.Market{}, err
+	}
+	k.set(ctx, market)
+
+	ctx.EventManager().EmitEvent(types.NewMarketCircuitBreakerUpdatedEvent(market))
+
+	return market, nil
+}
+
+// SetStatus updates market lifecycle status.
+// Orders module handles delisted market orders refund (market status is checked by orders / orderbook modules).
+func (k Keeper) SetStatus(ctx sdk.Context, id dnTypes.ID, status types.MarketStatus) (types.Market, error) {
+	k.modulePerms.AutoCheck(types.PermCreate)
+
+	if !status.IsValid() {
+		return types.Market{}, sdkErrors.Wrap(types.ErrWrongStatus, status.String())
+	}
+
+	market, err := k.Get(ctx, id)
+	if err != nil {
+		return types.Market{}, err
+	}
+
+	if market.GetStatus() == types.MarketStatusDelisted {
+		return types.Market{}, sdkErrors.Wrap(types.ErrWrongStatus, "market is delisted")
+	}
+
+	market.Status = status
+	k.set(ctx, market)
+
+	ctx.EventManager().EmitEvent(types.NewMarketStatusUpdatedEvent(market))
+
+	return market, nil
+}
+
 // GetList returns all market objects.
 func (k Keeper) GetList(ctx sdk.Context) types.Markets {
 	k.modulePerms.AutoCheck(types.PermRead)
@@ -156,3 +156,109 @@ func TestMarketsKeeper_AddDuplicate(t *testing.T) {
 	_, err = input.keeper.Add(input.ctx, input.baseBtcDenom, input.quoteDenom)
 	require.Error(t, err)
 }
+
+func TestMarketsKeeper_SetFees(t *testing.T) {
+	t.Parallel()
+
+	input := NewTestInput(t)
+
+	market, err := input.keeper.Add(input.ctx, input.baseBtcDenom, input.quoteDenom)
+	require.NoError(t, err)
+	require.True(t, market.MakerFee.IsZero())
+	require.True(t, market.TakerFee.IsZero())
+
+	// non-existing market
+	{
+		_, err := input.keeper.SetFees(input.ctx, dnTypes.NewIDFromUint64(1), sdk.ZeroDec(), sdk.ZeroDec())
+		require.Error(t, err)
+	}
+
+	// invalid fee rates
+	{
+		_, err := input.keeper.SetFees(input.ctx, market.ID, sdk.NewDec(-1), sdk.ZeroDec())
+		require.Error(t, err)
+
+		_, err = input.keeper.SetFees(input.ctx, market.ID, sdk.ZeroDec(), sdk.OneDec())
+		require.Error(t, err)
+	}
+
+	// ok
+	{
+		makerFee, takerFee := sdk.NewDecWithPrec(1, 3), sdk.NewDecWithPrec(2, 3)
+		_, err := input.keeper.SetFees(input.ctx, market.ID, makerFee, takerFee)
+		require.NoError(t, err)
+
+		updMarket, err := input.keeper.Get(input.ctx, market.ID)
+		require.NoError(t, err)
+		require.True(t, updMarket.MakerFee.Equal(makerFee))
+		require.True(t, updMarket.TakerFee.Equal(takerFee))
+		require.True(t, updMarket.GetFeeRate(true).Equal(makerFee))
+		require.True(t, updMarket.GetFeeRate(false).Equal(takerFee))
+	}
+}
+
+func TestMarketsKeeper_SetOrderLimits(t *testing.T) {
+	t.Parallel()
+
+	input := NewTestInput(t)
+
+	market, err := input.keeper.Add(input.ctx, input.baseBtcDenom, input.quoteDenom)
+	require.NoError(t, err)
+	require.True(t, market.GetTickSize().IsZero())
+	require.True(t, market.GetLotSize().IsZero())
+	require.True(t, market.GetMinNotional().IsZero())
+
+	// non-existing market
+	{
+		_, err := input.keeper.SetOrderLimits(input.ctx, dnTypes.NewIDFromUint64(1), sdk.ZeroUint(), sdk.ZeroUint(), sdk.ZeroUint())
+		require.Error(t, err)
+	}
+
+	// ok
+	{
+		tickSize, lotSize, minNotional := sdk.NewUint(1000), sdk.NewUint(100), sdk.NewUint(1000000)
+		_, err := input.keeper.SetOrderLimits(input.ctx, market.ID, tickSize, lotSize, minNotional)
+		require.NoError(t, err)
+
+		updMarket, err := input.keeper.Get(input.ctx, market.ID)
+		require.NoError(t, err)
+		require.True(t, updMarket.GetTickSize().Equal(tickSize))
+		require.True(t, updMarket.GetLotSize().Equal(lotSize))
+		require.True(t, updMarket.GetMinNotional().Equal(minNotional))
+	}
+}
+
+func TestMarketsKeeper_SetStatus(t *testing.T) {
+	t.Parallel()
+
+	input := NewTestInput(t)
+
+	market, err := input.keeper.Add(input.ctx, input.baseBtcDenom, input.quoteDenom)
+	require.NoError(t, err)
+	require.Equal(t, types.MarketStatusActive, market.GetStatus())
+
+	// invalid status
+	{
+		_, err := input.keeper.SetStatus(input.ctx, market.ID, types.NewMarketStatusRaw("unknown"))
+		require.Error(t, err)
+	}
+
+	// ok
+	{
+		_, err := input.keeper.SetStatus(input.ctx, market.ID, types.MarketStatusHalted)
+		require.NoError(t, err)
+
+		updMarket, err := input.keeper.Get(input.ctx, market.ID)
+		require.NoError(t, err)
+		require.Equal(t, types.MarketStatusHalted, updMarket.GetStatus())
+	}
+
+	// delisted market status can't be changed
+	{
+		_, err := input.keeper.SetStatus(input.ctx, market.ID, types.MarketStatusDelisted)
+		require.NoError(t, err)
+
+		_, err = input.keeper.SetStatus(input.ctx, market.ID, types.MarketStatusActive)
+		require.Error(t, err)
+	}
+}
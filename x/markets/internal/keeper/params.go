@@ -0,0 +1,24 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dfinance/dnode/x/markets/internal/types"
+)
+
+// GetParams gets params from the store.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	k.modulePerms.AutoCheck(types.PermRead)
+
+	params := types.Params{}
+	k.paramSubspace.GetParamSet(ctx, &params)
+
+	return params
+}
+
+// SetParams updates params in the store.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.modulePerms.AutoCheck(types.PermCreate)
+
+	k.paramSubspace.SetParamSet(ctx, &params)
+}
@@ -1,16 +1,31 @@
 package types
 
 import (
-	"fmt"
-
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+
+	msClient "github.com/dfinance/dnode/x/multisig/client"
+)
+
+const (
+	CodecNameMsgCreateMarket                    = ModuleName + "/MsgCreateMarket"
+	CodecNameMsgSetMarketStatus                 = ModuleName + "/MsgSetMarketStatus"
+	CodecNameUpdateMarketFeesProposal           = ModuleName + "/UpdateMarketFeesProposal"
+	CodecNameUpdateMarketStatusProposal         = ModuleName + "/UpdateMarketStatusProposal"
+	CodecNameUpdateMarketCircuitBreakerProposal = ModuleName + "/UpdateMarketCircuitBreakerProposal"
+	CodecNameAddMarketProposal                  = ModuleName + "/AddMarketProposal"
 )
 
 var ModuleCdc *codec.Codec
 
 // RegisterCodec registers module specific messages.
 func RegisterCodec(cdc *codec.Codec) {
-	cdc.RegisterConcrete(MsgCreateMarket{}, fmt.Sprintf("%s/MsgCreateMarket", ModuleName), nil)
+	cdc.RegisterConcrete(MsgCreateMarket{}, CodecNameMsgCreateMarket, nil)
+	cdc.RegisterConcrete(MsgSetMarketStatus{}, CodecNameMsgSetMarketStatus, nil)
+	cdc.RegisterConcrete(UpdateMarketFeesProposal{}, CodecNameUpdateMarketFeesProposal, nil)
+	cdc.RegisterConcrete(UpdateMarketStatusProposal{}, CodecNameUpdateMarketStatusProposal, nil)
+	cdc.RegisterConcrete(UpdateMarketCircuitBreakerProposal{}, CodecNameUpdateMarketCircuitBreakerProposal, nil)
+	cdc.RegisterConcrete(AddMarketProposal{}, CodecNameAddMarketProposal, nil)
 }
 
 func init() {
@@ -18,4 +33,15 @@ func init() {
 	RegisterCodec(cdc)
 	codec.RegisterCrypto(cdc)
 	ModuleCdc = cdc.Seal()
+
+	msClient.RegisterMultiSigTypeCodec(MsgSetMarketStatus{}, CodecNameMsgSetMarketStatus)
+
+	gov.RegisterProposalType(ProposalTypeUpdateMarketFees)
+	gov.RegisterProposalTypeCodec(UpdateMarketFeesProposal{}, CodecNameUpdateMarketFeesProposal)
+	gov.RegisterProposalType(ProposalTypeUpdateMarketStatus)
+	gov.RegisterProposalTypeCodec(UpdateMarketStatusProposal{}, CodecNameUpdateMarketStatusProposal)
+	gov.RegisterProposalType(ProposalTypeUpdateMarketCircuitBreaker)
+	gov.RegisterProposalTypeCodec(UpdateMarketCircuitBreakerProposal{}, CodecNameUpdateMarketCircuitBreakerProposal)
+	gov.RegisterProposalType(ProposalTypeAddMarket)
+	gov.RegisterProposalTypeCodec(AddMarketProposal{}, CodecNameAddMarketProposal)
 }
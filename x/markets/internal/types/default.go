@@ -1,6 +1,10 @@
 package types
 
 const (
-	ModuleName = "markets"
-	StoreKey   = ModuleName
+	ModuleName   = "markets"
+	StoreKey     = ModuleName
+	RouterKey    = ModuleName
+	GovRouterKey = RouterKey
+	// Default params subspace name
+	DefaultParamspace = ModuleName
 )
@@ -14,4 +14,20 @@ var (
 	ErrInvalidQuantity = sdkErrors.Register(ModuleName, 104, "base to quote asset quantity normalization failed")
 	// MsgCreateMarket.From is empty.
 	ErrWrongFrom = sdkErrors.Register(ModuleName, 105, "wrong from address, should not be empty")
+	// Market fee rate is invalid.
+	ErrWrongFeeRate = sdkErrors.Register(ModuleName, 106, "wrong fee rate")
+	// Order price is not a multiple of the market tick size.
+	ErrWrongTickSize = sdkErrors.Register(ModuleName, 107, "price doesn't match market tick size")
+	// Order quantity is not a multiple of the market lot size.
+	ErrWrongLotSize = sdkErrors.Register(ModuleName, 108, "quantity doesn't match market lot size")
+	// Order price * quantity is less than the market min notional.
+	ErrMinNotional = sdkErrors.Register(ModuleName, 109, "order notional is less than market min notional")
+	// Market status is invalid.
+	ErrWrongStatus = sdkErrors.Register(ModuleName, 110, "wrong market status")
+	// Market circuit breaker params are invalid.
+	ErrWrongCircuitBreaker = sdkErrors.Register(ModuleName, 111, "wrong market circuit breaker params")
+	// MsgCreateMarket is disabled by params (markets are added via gov proposals).
+	ErrCreateMarketDisabled = sdkErrors.Register(ModuleName, 112, "market creation via message is disabled")
+	// Gov proposal is invalid.
+	ErrGovInvalidProposal = sdkErrors.Register(ModuleName, 200, "invalid proposal")
 )
@@ -1,13 +1,30 @@
 package types
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 const (
-	EventTypeCreate = ModuleName + ".create"
+	EventTypeCreate               = ModuleName + ".create"
+	EventTypeFeesUpdate           = ModuleName + ".fees_update"
+	EventTypeStatusUpdate         = ModuleName + ".status_update"
+	EventTypeCircuitBreakerUpdate = ModuleName + ".circuit_breaker_update"
+	EventTypeOrderLimitsUpdate    = ModuleName + ".order_limits_update"
 	//
-	AttributeMarketId   = "market_id"
-	AttributeBaseDenom  = "base_denom"
-	AttributeQuoteDenom = "quote_denom"
+	AttributeMarketId          = "market_id"
+	AttributeBaseDenom         = "base_denom"
+	AttributeQuoteDenom        = "quote_denom"
+	AttributeMakerFee          = "maker_fee"
+	AttributeTakerFee          = "taker_fee"
+	AttributeStatus            = "status"
+	AttributeMaxPriceDeviation = "max_price_deviation"
+	AttributePriceReference    = "price_reference"
+	AttributeHaltBlocks        = "halt_blocks"
+	AttributeTickSize          = "tick_size"
+	AttributeLotSize           = "lot_size"
+	AttributeMinNotional       = "min_notional"
 )
 
 // NewMarketCreatedEvent creates an Event on market creation.
@@ -19,3 +36,44 @@ func NewMarketCreatedEvent(market Market) sdk.Event {
 		sdk.NewAttribute(AttributeQuoteDenom, market.QuoteAssetDenom),
 	)
 }
+
+// NewMarketFeesUpdatedEvent creates an Event on market fee rates update.
+func NewMarketFeesUpdatedEvent(market Market) sdk.Event {
+	return sdk.NewEvent(
+		EventTypeFeesUpdate,
+		sdk.NewAttribute(AttributeMarketId, market.ID.String()),
+		sdk.NewAttribute(AttributeMakerFee, market.GetFeeRate(true).String()),
+		sdk.NewAttribute(AttributeTakerFee, market.GetFeeRate(false).String()),
+	)
+}
+
+// NewMarketStatusUpdatedEvent creates an Event on market status update.
+func NewMarketStatusUpdatedEvent(market Market) sdk.Event {
+	return sdk.NewEvent(
+		EventTypeStatusUpdate,
+		sdk.NewAttribute(AttributeMarketId, market.ID.String()),
+		sdk.NewAttribute(AttributeStatus, market.GetStatus().String()),
+	)
+}
+
+// NewMarketCircuitBreakerUpdatedEvent creates an Event on market circuit breaker params update.
+func NewMarketCircuitBreakerUpdatedEvent(market Market) sdk.Event {
+	return sdk.NewEvent(
+		EventTypeCircuitBreakerUpdate,
+		sdk.NewAttribute(AttributeMarketId, market.ID.String()),
+		sdk.NewAttribute(AttributeMaxPriceDeviation, market.GetMaxPriceDeviation().String()),
+		sdk.NewAttribute(AttributePriceReference, market.GetPriceReference().String()),
+		sdk.NewAttribute(AttributeHaltBlocks, strconv.FormatUint(market.HaltBlocks, 10)),
+	)
+}
+
+// NewMarketOrderLimitsUpdatedEvent creates an Event on market order limits (tick / lot size, min notional) update.
+func NewMarketOrderLimitsUpdatedEvent(market Market) sdk.Event {
+	return sdk.NewEvent(
+		EventTypeOrderLimitsUpdate,
+		sdk.NewAttribute(AttributeMarketId, market.ID.String()),
+		sdk.NewAttribute(AttributeTickSize, market.GetTickSize().String()),
+		sdk.NewAttribute(AttributeLotSize, market.GetLotSize().String()),
+		sdk.NewAttribute(AttributeMinNotional, market.GetMinNotional().String()),
+	)
+}
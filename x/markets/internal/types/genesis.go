@@ -8,12 +8,17 @@ import (
 
 // Module genesis state object.
 type GenesisState struct {
+	Params       Params      `json:"params" yaml:"params"`
 	Markets      Markets     `json:"markets" yaml:"markets"`
 	LastMarketID *dnTypes.ID `json:"last_market_id" yaml:"last_market_id"`
 }
 
 // Validate checks that genesis state is valid.
 func (s GenesisState) Validate() error {
+	if err := s.Params.Validate(); err != nil {
+		return fmt.Errorf("params: %w", err)
+	}
+
 	maxMarketID := dnTypes.NewZeroID()
 	marketsSet := make(map[string]bool, len(s.Markets))
 	for i, m := range s.Markets {
@@ -52,6 +57,7 @@ func (s GenesisState) Validate() error {
 // DefaultGenesisState returns module default genesis state.
 func DefaultGenesisState() GenesisState {
 	return GenesisState{
+		Params:  DefaultParams(),
 		Markets: Markets{},
 	}
 }
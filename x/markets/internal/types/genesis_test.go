@@ -14,7 +14,7 @@ import (
 func TestMarkets_Genesis_Valid(t *testing.T) {
 	t.Parallel()
 
-	lastID := dnTypes.NewIDFromUint64(1)
+	lastID := dnTypes.NewIDFromUint64(2)
 	state := GenesisState{
 		Markets: Markets{
 			Market{
@@ -27,10 +27,32 @@ func TestMarkets_Genesis_Valid(t *testing.T) {
 				BaseAssetDenom:  "eth",
 				QuoteAssetDenom: "xfi",
 			},
+			Market{
+				ID:              dnTypes.NewIDFromUint64(2),
+				BaseAssetDenom:  "usdt",
+				QuoteAssetDenom: "xfi",
+				TickSize:        sdk.NewUint(1000),
+				LotSize:         sdk.NewUint(100),
+				MinNotional:     sdk.NewUint(1000000),
+			},
 		},
 		LastMarketID: &lastID,
 	}
 	require.NoError(t, state.Validate())
+
+	// market limits JSON fields are optional
+	{
+		stateJSON := `{"markets":[{"id":"0","base_asset_denom":"btc","quote_asset_denom":"xfi","lot_size":"100"}],"last_market_id":"0"}`
+
+		state := GenesisState{}
+		require.NoError(t, ModuleCdc.UnmarshalJSON([]byte(stateJSON), &state))
+		require.NoError(t, state.Validate())
+
+		market := state.Markets[0]
+		require.True(t, market.GetTickSize().IsZero())
+		require.True(t, market.GetLotSize().Equal(sdk.NewUint(100)))
+		require.True(t, market.GetMinNotional().IsZero())
+	}
 }
 
 func TestMarkets_Genesis_Invalid(t *testing.T) {
@@ -0,0 +1,92 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+
+	dnTypes "github.com/dfinance/dnode/helpers/types"
+)
+
+const (
+	ProposalTypeAddMarket = "AddMarket"
+)
+
+var (
+	_ gov.Content = AddMarketProposal{}
+)
+
+// AddMarketProposal is a gov proposal to create a new market between two registered currencies.
+// Fee rates and order limits are optional (nil / zero values keep market defaults).
+type AddMarketProposal struct {
+	BaseAssetDenom  string   `json:"base_asset_denom" yaml:"base_asset_denom"`
+	QuoteAssetDenom string   `json:"quote_asset_denom" yaml:"quote_asset_denom"`
+	MakerFee        sdk.Dec  `json:"maker_fee" yaml:"maker_fee"`
+	TakerFee        sdk.Dec  `json:"taker_fee" yaml:"taker_fee"`
+	TickSize        sdk.Uint `json:"tick_size" yaml:"tick_size"`
+	LotSize         sdk.Uint `json:"lot_size" yaml:"lot_size"`
+	MinNotional     sdk.Uint `json:"min_notional" yaml:"min_notional"`
+}
+
+func (p AddMarketProposal) GetTitle() string { return "Add market" }
+func (p AddMarketProposal) GetDescription() string {
+	return "Creates a new market"
+}
+func (p AddMarketProposal) ProposalRoute() string { return GovRouterKey }
+func (p AddMarketProposal) ProposalType() string  { return ProposalTypeAddMarket }
+
+func (p AddMarketProposal) ValidateBasic() error {
+	if err := dnTypes.DenomFilter(p.BaseAssetDenom); err != nil {
+		return fmt.Errorf("base_asset_denom: %w", err)
+	}
+	if err := dnTypes.DenomFilter(p.QuoteAssetDenom); err != nil {
+		return fmt.Errorf("quote_asset_denom: %w", err)
+	}
+	if p.BaseAssetDenom == p.QuoteAssetDenom {
+		return fmt.Errorf("base_asset_denom / quote_asset_denom: equal")
+	}
+	if err := ValidateFeeRate(p.MakerFee); err != nil {
+		return fmt.Errorf("maker_fee: %w", err)
+	}
+	if err := ValidateFeeRate(p.TakerFee); err != nil {
+		return fmt.Errorf("taker_fee: %w", err)
+	}
+
+	return nil
+}
+
+// HasFees checks if proposal sets market fee rates.
+func (p AddMarketProposal) HasFees() bool {
+	return !p.MakerFee.IsNil() || !p.TakerFee.IsNil()
+}
+
+// HasOrderLimits checks if proposal sets market order limits.
+func (p AddMarketProposal) HasOrderLimits() bool {
+	return !uintOrZero(p.TickSize).IsZero() || !uintOrZero(p.LotSize).IsZero() || !uintOrZero(p.MinNotional).IsZero()
+}
+
+func (p AddMarketProposal) String() string {
+	b := strings.Builder{}
+	b.WriteString("Proposal:\n")
+	b.WriteString(fmt.Sprintf("  Title: %s\n", p.GetTitle()))
+	b.WriteString(fmt.Sprintf("  Description: %s\n", p.GetDescription()))
+	b.WriteString(fmt.Sprintf("  BaseAssetDenom: %s\n", p.BaseAssetDenom))
+	b.WriteString(fmt.Sprintf("  QuoteAssetDenom: %s\n", p.QuoteAssetDenom))
+	b.WriteString(fmt.Sprintf("  MakerFee: %s\n", p.MakerFee.String()))
+	b.WriteString(fmt.Sprintf("  TakerFee: %s\n", p.TakerFee.String()))
+	b.WriteString(fmt.Sprintf("  TickSize: %s\n", uintOrZero(p.TickSize).String()))
+	b.WriteString(fmt.Sprintf("  LotSize: %s\n", uintOrZero(p.LotSize).String()))
+	b.WriteString(fmt.Sprintf("  MinNotional: %s", uintOrZero(p.MinNotional).String()))
+
+	return b.String()
+}
+
+// NewAddMarketProposal creates an AddMarketProposal object.
+func NewAddMarketProposal(baseAssetDenom, quoteAssetDenom string) AddMarketProposal {
+	return AddMarketProposal{
+		BaseAssetDenom:  baseAssetDenom,
+		QuoteAssetDenom: quoteAssetDenom,
+	}
+}
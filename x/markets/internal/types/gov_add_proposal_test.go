@@ -0,0 +1,62 @@
+// +build unit
+
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMarkets_AddMarketProposal_Valid(t *testing.T) {
+	t.Parallel()
+
+	// ok
+	{
+		p := NewAddMarketProposal("btc", "xfi")
+		require.NoError(t, p.ValidateBasic())
+	}
+
+	// ok: with fee rates and order limits
+	{
+		p := NewAddMarketProposal("btc", "xfi")
+		p.MakerFee, p.TakerFee = sdk.NewDecWithPrec(1, 3), sdk.NewDecWithPrec(2, 3)
+		p.TickSize, p.LotSize, p.MinNotional = sdk.NewUint(1000), sdk.NewUint(100), sdk.NewUint(1000000)
+		require.NoError(t, p.ValidateBasic())
+		require.True(t, p.HasFees())
+		require.True(t, p.HasOrderLimits())
+	}
+
+	// invalid maker fee
+	{
+		p := NewAddMarketProposal("btc", "xfi")
+		p.MakerFee = sdk.NewDecWithPrec(-1, 3)
+		require.Error(t, p.ValidateBasic())
+	}
+
+	// invalid taker fee
+	{
+		p := NewAddMarketProposal("btc", "xfi")
+		p.TakerFee = sdk.OneDec()
+		require.Error(t, p.ValidateBasic())
+	}
+
+	// invalid base denom
+	{
+		p := NewAddMarketProposal("", "xfi")
+		require.Error(t, p.ValidateBasic())
+	}
+
+	// invalid quote denom
+	{
+		p := NewAddMarketProposal("btc", "XFI")
+		require.Error(t, p.ValidateBasic())
+	}
+
+	// equal denoms
+	{
+		p := NewAddMarketProposal("xfi", "xfi")
+		require.Error(t, p.ValidateBasic())
+	}
+}
@@ -0,0 +1,73 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+
+	dnTypes "github.com/dfinance/dnode/helpers/types"
+)
+
+const (
+	ProposalTypeUpdateMarketCircuitBreaker = "UpdateMarketCircuitBreaker"
+)
+
+var (
+	_ gov.Content = UpdateMarketCircuitBreakerProposal{}
+)
+
+// UpdateMarketCircuitBreakerProposal is a gov proposal to change market circuit breaker params.
+type UpdateMarketCircuitBreakerProposal struct {
+	MarketID          dnTypes.ID     `json:"market_id" yaml:"market_id"`
+	MaxPriceDeviation sdk.Dec        `json:"max_price_deviation" yaml:"max_price_deviation"`
+	PriceReference    PriceReference `json:"price_reference" yaml:"price_reference"`
+	HaltBlocks        uint64         `json:"halt_blocks" yaml:"halt_blocks"`
+}
+
+func (p UpdateMarketCircuitBreakerProposal) GetTitle() string { return "Update market circuit breaker" }
+func (p UpdateMarketCircuitBreakerProposal) GetDescription() string {
+	return "Changes market clearance price max deviation band and halt period"
+}
+func (p UpdateMarketCircuitBreakerProposal) ProposalRoute() string { return GovRouterKey }
+func (p UpdateMarketCircuitBreakerProposal) ProposalType() string {
+	return ProposalTypeUpdateMarketCircuitBreaker
+}
+
+func (p UpdateMarketCircuitBreakerProposal) ValidateBasic() error {
+	if err := p.MarketID.Valid(); err != nil {
+		return fmt.Errorf("market_id: %w", err)
+	}
+	if p.MaxPriceDeviation.IsNil() {
+		return fmt.Errorf("max_price_deviation: nil")
+	}
+	if err := ValidateCircuitBreaker(p.MaxPriceDeviation, p.PriceReference); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (p UpdateMarketCircuitBreakerProposal) String() string {
+	b := strings.Builder{}
+	b.WriteString("Proposal:\n")
+	b.WriteString(fmt.Sprintf("  Title: %s\n", p.GetTitle()))
+	b.WriteString(fmt.Sprintf("  Description: %s\n", p.GetDescription()))
+	b.WriteString(fmt.Sprintf("  MarketID: %s\n", p.MarketID.String()))
+	b.WriteString(fmt.Sprintf("  MaxPriceDeviation: %s\n", p.MaxPriceDeviation.String()))
+	b.WriteString(fmt.Sprintf("  PriceReference: %s\n", p.PriceReference.String()))
+	b.WriteString(fmt.Sprintf("  HaltBlocks: %d", p.HaltBlocks))
+
+	return b.String()
+}
+
+// NewUpdateMarketCircuitBreakerProposal creates a UpdateMarketCircuitBreakerProposal object.
+func NewUpdateMarketCircuitBreakerProposal(marketID dnTypes.ID, maxDeviation sdk.Dec, reference PriceReference, haltBlocks uint64) UpdateMarketCircuitBreakerProposal {
+	return UpdateMarketCircuitBreakerProposal{
+		MarketID:          marketID,
+		MaxPriceDeviation: maxDeviation,
+		PriceReference:    reference,
+		HaltBlocks:        haltBlocks,
+	}
+}
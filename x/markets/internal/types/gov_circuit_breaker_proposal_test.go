@@ -0,0 +1,61 @@
+// +build unit
+
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	dnTypes "github.com/dfinance/dnode/helpers/types"
+)
+
+func TestMarkets_UpdateMarketCircuitBreakerProposal_Valid(t *testing.T) {
+	t.Parallel()
+
+	marketID := dnTypes.NewIDFromUint64(0)
+
+	// ok
+	{
+		p := NewUpdateMarketCircuitBreakerProposal(marketID, sdk.NewDecWithPrec(1, 1), PriceReferenceOracle, 10)
+		require.NoError(t, p.ValidateBasic())
+
+		// disabled
+		p = NewUpdateMarketCircuitBreakerProposal(marketID, sdk.ZeroDec(), PriceReferenceClearance, 0)
+		require.NoError(t, p.ValidateBasic())
+	}
+
+	// nil deviation
+	{
+		p := NewUpdateMarketCircuitBreakerProposal(marketID, sdk.Dec{}, PriceReferenceClearance, 0)
+		require.Error(t, p.ValidateBasic())
+	}
+
+	// negative deviation
+	{
+		p := NewUpdateMarketCircuitBreakerProposal(marketID, sdk.NewDecWithPrec(-1, 2), PriceReferenceClearance, 0)
+		require.Error(t, p.ValidateBasic())
+	}
+
+	// invalid reference
+	{
+		p := NewUpdateMarketCircuitBreakerProposal(marketID, sdk.NewDecWithPrec(1, 1), NewPriceReferenceRaw(""), 0)
+		require.Error(t, p.ValidateBasic())
+	}
+
+	// market validation
+	{
+		market := NewMarket(marketID, "btc", "xfi")
+		require.NoError(t, market.Valid())
+
+		market.MaxPriceDeviation = sdk.NewDecWithPrec(-1, 1)
+		require.True(t, ErrWrongCircuitBreaker.Is(market.Valid()))
+
+		// empty values are treated as disabled breaker
+		market.MaxPriceDeviation, market.PriceReference = sdk.Dec{}, ""
+		require.NoError(t, market.Valid())
+		require.True(t, market.GetMaxPriceDeviation().IsZero())
+		require.Equal(t, PriceReferenceClearance, market.GetPriceReference())
+	}
+}
@@ -0,0 +1,74 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+
+	dnTypes "github.com/dfinance/dnode/helpers/types"
+)
+
+const (
+	ProposalTypeUpdateMarketFees = "UpdateMarketFees"
+)
+
+var (
+	_ gov.Content = UpdateMarketFeesProposal{}
+)
+
+// UpdateMarketFeesProposal is a gov proposal to change market maker / taker fee rates.
+type UpdateMarketFeesProposal struct {
+	MarketID dnTypes.ID `json:"market_id" yaml:"market_id"`
+	MakerFee sdk.Dec    `json:"maker_fee" yaml:"maker_fee"`
+	TakerFee sdk.Dec    `json:"taker_fee" yaml:"taker_fee"`
+}
+
+func (p UpdateMarketFeesProposal) GetTitle() string { return "Update market fees" }
+func (p UpdateMarketFeesProposal) GetDescription() string {
+	return "Changes market maker / taker fee rates"
+}
+func (p UpdateMarketFeesProposal) ProposalRoute() string { return GovRouterKey }
+func (p UpdateMarketFeesProposal) ProposalType() string  { return ProposalTypeUpdateMarketFees }
+
+func (p UpdateMarketFeesProposal) ValidateBasic() error {
+	if err := p.MarketID.Valid(); err != nil {
+		return fmt.Errorf("market_id: %w", err)
+	}
+	if p.MakerFee.IsNil() {
+		return fmt.Errorf("maker_fee: nil")
+	}
+	if err := ValidateFeeRate(p.MakerFee); err != nil {
+		return fmt.Errorf("maker_fee: %w", err)
+	}
+	if p.TakerFee.IsNil() {
+		return fmt.Errorf("taker_fee: nil")
+	}
+	if err := ValidateFeeRate(p.TakerFee); err != nil {
+		return fmt.Errorf("taker_fee: %w", err)
+	}
+
+	return nil
+}
+
+func (p UpdateMarketFeesProposal) String() string {
+	b := strings.Builder{}
+	b.WriteString("Proposal:\n")
+	b.WriteString(fmt.Sprintf("  Title: %s\n", p.GetTitle()))
+	b.WriteString(fmt.Sprintf("  Description: %s\n", p.GetDescription()))
+	b.WriteString(fmt.Sprintf("  MarketID: %s\n", p.MarketID.String()))
+	b.WriteString(fmt.Sprintf("  MakerFee: %s\n", p.MakerFee.String()))
+	b.WriteString(fmt.Sprintf("  TakerFee: %s", p.TakerFee.String()))
+
+	return b.String()
+}
+
+// NewUpdateMarketFeesProposal creates a UpdateMarketFeesProposal object.
+func NewUpdateMarketFeesProposal(marketID dnTypes.ID, makerFee, takerFee sdk.Dec) UpdateMarketFeesProposal {
+	return UpdateMarketFeesProposal{
+		MarketID: marketID,
+		MakerFee: makerFee,
+		TakerFee: takerFee,
+	}
+}
@@ -0,0 +1,45 @@
+// +build unit
+
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	dnTypes "github.com/dfinance/dnode/helpers/types"
+)
+
+func TestMarkets_UpdateMarketFeesProposal_Valid(t *testing.T) {
+	t.Parallel()
+
+	marketID := dnTypes.NewIDFromUint64(0)
+
+	// ok
+	{
+		p := NewUpdateMarketFeesProposal(marketID, sdk.ZeroDec(), sdk.NewDecWithPrec(25, 4))
+		require.NoError(t, p.ValidateBasic())
+	}
+
+	// nil fees
+	{
+		p := NewUpdateMarketFeesProposal(marketID, sdk.Dec{}, sdk.ZeroDec())
+		require.Error(t, p.ValidateBasic())
+
+		p = NewUpdateMarketFeesProposal(marketID, sdk.ZeroDec(), sdk.Dec{})
+		require.Error(t, p.ValidateBasic())
+	}
+
+	// negative fee
+	{
+		p := NewUpdateMarketFeesProposal(marketID, sdk.NewDecWithPrec(-1, 2), sdk.ZeroDec())
+		require.Error(t, p.ValidateBasic())
+	}
+
+	// fee GTE 1.0
+	{
+		p := NewUpdateMarketFeesProposal(marketID, sdk.ZeroDec(), sdk.OneDec())
+		require.Error(t, p.ValidateBasic())
+	}
+}
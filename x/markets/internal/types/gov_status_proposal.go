@@ -0,0 +1,61 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/x/gov"
+
+	dnTypes "github.com/dfinance/dnode/helpers/types"
+)
+
+const (
+	ProposalTypeUpdateMarketStatus = "UpdateMarketStatus"
+)
+
+var (
+	_ gov.Content = UpdateMarketStatusProposal{}
+)
+
+// UpdateMarketStatusProposal is a gov proposal to change market lifecycle status.
+type UpdateMarketStatusProposal struct {
+	MarketID dnTypes.ID   `json:"market_id" yaml:"market_id"`
+	Status   MarketStatus `json:"status" yaml:"status"`
+}
+
+func (p UpdateMarketStatusProposal) GetTitle() string { return "Update market status" }
+func (p UpdateMarketStatusProposal) GetDescription() string {
+	return "Changes market lifecycle status"
+}
+func (p UpdateMarketStatusProposal) ProposalRoute() string { return GovRouterKey }
+func (p UpdateMarketStatusProposal) ProposalType() string  { return ProposalTypeUpdateMarketStatus }
+
+func (p UpdateMarketStatusProposal) ValidateBasic() error {
+	if err := p.MarketID.Valid(); err != nil {
+		return fmt.Errorf("market_id: %w", err)
+	}
+	if !p.Status.IsValid() {
+		return fmt.Errorf("status: invalid %q", p.Status)
+	}
+
+	return nil
+}
+
+func (p UpdateMarketStatusProposal) String() string {
+	b := strings.Builder{}
+	b.WriteString("Proposal:\n")
+	b.WriteString(fmt.Sprintf("  Title: %s\n", p.GetTitle()))
+	b.WriteString(fmt.Sprintf("  Description: %s\n", p.GetDescription()))
+	b.WriteString(fmt.Sprintf("  MarketID: %s\n", p.MarketID.String()))
+	b.WriteString(fmt.Sprintf("  Status: %s", p.Status.String()))
+
+	return b.String()
+}
+
+// NewUpdateMarketStatusProposal creates a UpdateMarketStatusProposal object.
+func NewUpdateMarketStatusProposal(marketID dnTypes.ID, status MarketStatus) UpdateMarketStatusProposal {
+	return UpdateMarketStatusProposal{
+		MarketID: marketID,
+		Status:   status,
+	}
+}
@@ -3,8 +3,11 @@ package types
 import (
 	"bytes"
 	"fmt"
+	"reflect"
+	"strconv"
 	"strings"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/olekukonko/tablewriter"
 
@@ -20,6 +23,24 @@ type Market struct {
 	BaseAssetDenom string `json:"base_asset_denom" yaml:"base_asset_denom" example:"btc"`
 	// Quote asset denomination (for ex. xfi)
 	QuoteAssetDenom string `json:"quote_asset_denom" yaml:"quote_asset_denom" example:"xfi"`
+	// Fee rate for orders resting in the book before the matching block (fraction of the fill amount)
+	MakerFee sdk.Dec `json:"maker_fee" yaml:"maker_fee" swaggertype:"string" example:"0.001"`
+	// Fee rate for orders posted in the matching block (fraction of the fill amount)
+	TakerFee sdk.Dec `json:"taker_fee" yaml:"taker_fee" swaggertype:"string" example:"0.002"`
+	// Order price step (in quote asset min units), zero value disables the check
+	TickSize sdk.Uint `json:"tick_size" yaml:"tick_size" swaggertype:"string" example:"1000"`
+	// Order quantity step (in base asset min units), zero value disables the check
+	LotSize sdk.Uint `json:"lot_size" yaml:"lot_size" swaggertype:"string" example:"100"`
+	// Minimal order price * quantity value (in quote asset min units), zero value disables the check
+	MinNotional sdk.Uint `json:"min_notional" yaml:"min_notional" swaggertype:"string" example:"1000000"`
+	// Market lifecycle status (empty value is treated as active)
+	Status MarketStatus `json:"status" yaml:"status" swaggertype:"string" example:"active"`
+	// Circuit breaker: max clearance price deviation from the reference price (fraction), zero value disables the check
+	MaxPriceDeviation sdk.Dec `json:"max_price_deviation" yaml:"max_price_deviation" swaggertype:"string" example:"0.1"`
+	// Circuit breaker: reference price source (empty value is treated as clearance)
+	PriceReference PriceReference `json:"price_reference" yaml:"price_reference" swaggertype:"string" example:"clearance"`
+	// Circuit breaker: number of blocks market is switched to post-only on breach, zero value only skips the block matching
+	HaltBlocks uint64 `json:"halt_blocks" yaml:"halt_blocks" example:"10"`
 }
 
 // Valid check object validity.
@@ -33,10 +54,78 @@ func (m Market) Valid() error {
 	if err := dnTypes.DenomFilter(m.QuoteAssetDenom); err != nil {
 		return sdkErrors.Wrapf(ErrWrongAssetDenom, "QuoteAsset is invalid: %v", err)
 	}
+	if err := ValidateFeeRate(m.MakerFee); err != nil {
+		return sdkErrors.Wrapf(ErrWrongFeeRate, "MakerFee: %v", err)
+	}
+	if err := ValidateFeeRate(m.TakerFee); err != nil {
+		return sdkErrors.Wrapf(ErrWrongFeeRate, "TakerFee: %v", err)
+	}
+	if !m.GetStatus().IsValid() {
+		return sdkErrors.Wrap(ErrWrongStatus, m.Status.String())
+	}
+	if err := ValidateCircuitBreaker(m.MaxPriceDeviation, m.GetPriceReference()); err != nil {
+		return sdkErrors.Wrap(ErrWrongCircuitBreaker, err.Error())
+	}
 
 	return nil
 }
 
+// GetFeeRate returns maker / taker fee rate (nil rate is treated as zero).
+func (m Market) GetFeeRate(isMaker bool) sdk.Dec {
+	rate := m.TakerFee
+	if isMaker {
+		rate = m.MakerFee
+	}
+
+	if rate.IsNil() {
+		return sdk.ZeroDec()
+	}
+
+	return rate
+}
+
+// GetStatus returns market status (empty value is treated as active).
+func (m Market) GetStatus() MarketStatus {
+	if m.Status == "" {
+		return MarketStatusActive
+	}
+
+	return m.Status
+}
+
+// GetMaxPriceDeviation returns circuit breaker max price deviation (nil value is treated as zero).
+func (m Market) GetMaxPriceDeviation() sdk.Dec {
+	if m.MaxPriceDeviation.IsNil() {
+		return sdk.ZeroDec()
+	}
+
+	return m.MaxPriceDeviation
+}
+
+// GetPriceReference returns circuit breaker reference price source (empty value is treated as clearance).
+func (m Market) GetPriceReference() PriceReference {
+	if m.PriceReference == "" {
+		return PriceReferenceClearance
+	}
+
+	return m.PriceReference
+}
+
+// GetTickSize returns order price step (nil value is treated as zero).
+func (m Market) GetTickSize() sdk.Uint {
+	return uintOrZero(m.TickSize)
+}
+
+// GetLotSize returns order quantity step (nil value is treated as zero).
+func (m Market) GetLotSize() sdk.Uint {
+	return uintOrZero(m.LotSize)
+}
+
+// GetMinNotional returns minimal order notional value (nil value is treated as zero).
+func (m Market) GetMinNotional() sdk.Uint {
+	return uintOrZero(m.MinNotional)
+}
+
 // String returns multi-line text object representation.
 func (m Market) String() string {
 	b := strings.Builder{}
@@ -44,6 +133,15 @@ func (m Market) String() string {
 	b.WriteString(fmt.Sprintf("  ID:              %s\n", m.ID.String()))
 	b.WriteString(fmt.Sprintf("  BaseAssetDenom:  %s\n", m.BaseAssetDenom))
 	b.WriteString(fmt.Sprintf("  QuoteAssetDenom: %s\n", m.QuoteAssetDenom))
+	b.WriteString(fmt.Sprintf("  MakerFee:        %s\n", m.GetFeeRate(true).String()))
+	b.WriteString(fmt.Sprintf("  TakerFee:        %s\n", m.GetFeeRate(false).String()))
+	b.WriteString(fmt.Sprintf("  TickSize:        %s\n", m.GetTickSize().String()))
+	b.WriteString(fmt.Sprintf("  LotSize:         %s\n", m.GetLotSize().String()))
+	b.WriteString(fmt.Sprintf("  MinNotional:     %s\n", m.GetMinNotional().String()))
+	b.WriteString(fmt.Sprintf("  Status:          %s\n", m.GetStatus().String()))
+	b.WriteString(fmt.Sprintf("  MaxPriceDev:     %s\n", m.GetMaxPriceDeviation().String()))
+	b.WriteString(fmt.Sprintf("  PriceReference:  %s\n", m.GetPriceReference().String()))
+	b.WriteString(fmt.Sprintf("  HaltBlocks:      %d\n", m.HaltBlocks))
 
 	return b.String()
 }
@@ -54,6 +152,15 @@ func (m Market) TableHeaders() []string {
 		"M.ID",
 		"M.BaseAssetDenom",
 		"M.QuoteAssetDenom",
+		"M.MakerFee",
+		"M.TakerFee",
+		"M.TickSize",
+		"M.LotSize",
+		"M.MinNotional",
+		"M.Status",
+		"M.MaxPriceDeviation",
+		"M.PriceReference",
+		"M.HaltBlocks",
 	}
 }
 
@@ -63,6 +170,15 @@ func (m Market) TableValues() []string {
 		m.ID.String(),
 		m.BaseAssetDenom,
 		m.QuoteAssetDenom,
+		m.GetFeeRate(true).String(),
+		m.GetFeeRate(false).String(),
+		m.GetTickSize().String(),
+		m.GetLotSize().String(),
+		m.GetMinNotional().String(),
+		m.GetStatus().String(),
+		m.GetMaxPriceDeviation().String(),
+		m.GetPriceReference().String(),
+		strconv.FormatUint(m.HaltBlocks, 10),
 	}
 }
 
@@ -77,7 +193,53 @@ func NewMarket(id dnTypes.ID, baseAsset, quoteAsset string) Market {
 		ID:              id,
 		BaseAssetDenom:  baseAsset,
 		QuoteAssetDenom: quoteAsset,
+		MakerFee:        sdk.ZeroDec(),
+		TakerFee:        sdk.ZeroDec(),
+		TickSize:        sdk.ZeroUint(),
+		LotSize:         sdk.ZeroUint(),
+		MinNotional:     sdk.ZeroUint(),
+		Status:          MarketStatusActive,
+		//
+		MaxPriceDeviation: sdk.ZeroDec(),
+		PriceReference:    PriceReferenceClearance,
+	}
+}
+
+// ValidateFeeRate checks fee rate is in [0, 1) range (nil rate is treated as zero).
+func ValidateFeeRate(rate sdk.Dec) error {
+	if rate.IsNil() {
+		return nil
+	}
+	if rate.IsNegative() {
+		return fmt.Errorf("negative")
+	}
+	if rate.GTE(sdk.OneDec()) {
+		return fmt.Errorf("should be LT 1.0")
+	}
+
+	return nil
+}
+
+// ValidateCircuitBreaker checks circuit breaker max price deviation is not negative (nil value is treated as zero)
+// and reference price source is valid.
+func ValidateCircuitBreaker(maxDeviation sdk.Dec, reference PriceReference) error {
+	if !maxDeviation.IsNil() && maxDeviation.IsNegative() {
+		return fmt.Errorf("max_price_deviation: negative")
+	}
+	if !reference.IsValid() {
+		return fmt.Errorf("price_reference: invalid %q", reference)
 	}
+
+	return nil
+}
+
+// uintOrZero converts nil sdk.Uint (not set JSON field) to zero value.
+func uintOrZero(value sdk.Uint) sdk.Uint {
+	if reflect.DeepEqual(value, sdk.Uint{}) {
+		return sdk.ZeroUint()
+	}
+
+	return value
 }
 
 // Market slice type.
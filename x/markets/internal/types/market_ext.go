@@ -21,6 +21,12 @@ type MarketExtended struct {
 	BaseCurrency ccstorage.Currency `json:"base_currency" yaml:"base_currency"`
 	// Quote asset currency (for ex. xfi)
 	QuoteCurrency ccstorage.Currency `json:"quote_currency" yaml:"quote_currency"`
+	// Order price step (in quote asset min units)
+	TickSize sdk.Uint `json:"tick_size" yaml:"tick_size" swaggertype:"string" example:"1000"`
+	// Order quantity step (in base asset min units)
+	LotSize sdk.Uint `json:"lot_size" yaml:"lot_size" swaggertype:"string" example:"100"`
+	// Minimal order price * quantity value (in quote asset min units)
+	MinNotional sdk.Uint `json:"min_notional" yaml:"min_notional" swaggertype:"string" example:"1000000"`
 }
 
 // Valid checks that MarketExtended is valid.
@@ -70,6 +76,27 @@ func (m MarketExtended) BaseToQuoteQuantity(basePrice sdk.Uint, baseQuantity sdk
 	return quoteQuantity, nil
 }
 
+// CheckPriceQuantity checks order price and quantity against market tick / lot size and min notional limits.
+func (m MarketExtended) CheckPriceQuantity(price sdk.Uint, quantity sdk.Uint) error {
+	if tickSize := uintOrZero(m.TickSize); !tickSize.IsZero() && !price.Mod(tickSize).IsZero() {
+		return sdkErrors.Wrapf(ErrWrongTickSize, "price %s is not a multiple of %s", price, tickSize)
+	}
+	if lotSize := uintOrZero(m.LotSize); !lotSize.IsZero() && !quantity.Mod(lotSize).IsZero() {
+		return sdkErrors.Wrapf(ErrWrongLotSize, "quantity %s is not a multiple of %s", quantity, lotSize)
+	}
+	if minNotional := uintOrZero(m.MinNotional); !minNotional.IsZero() {
+		notional, err := m.BaseToQuoteQuantity(price, quantity)
+		if err != nil {
+			return err
+		}
+		if notional.LT(minNotional) {
+			return sdkErrors.Wrapf(ErrMinNotional, "price * quantity %s should be GTE than %s", notional, minNotional)
+		}
+	}
+
+	return nil
+}
+
 // BaseDenom return string base asset denom representation.
 func (m MarketExtended) BaseDenom() string {
 	return string(m.BaseCurrency.Denom)
@@ -87,6 +114,9 @@ func (m MarketExtended) String() string {
 	b.WriteString(fmt.Sprintf("  ID: %s\n", m.ID.String()))
 	b.WriteString(fmt.Sprintf("  BaseCurrency: %s\n", m.BaseCurrency.String()))
 	b.WriteString(fmt.Sprintf("  QuoteCurrency: %s\n", m.QuoteCurrency.String()))
+	b.WriteString(fmt.Sprintf("  TickSize: %s\n", uintOrZero(m.TickSize).String()))
+	b.WriteString(fmt.Sprintf("  LotSize: %s\n", uintOrZero(m.LotSize).String()))
+	b.WriteString(fmt.Sprintf("  MinNotional: %s\n", uintOrZero(m.MinNotional).String()))
 
 	return b.String()
 }
@@ -113,10 +143,14 @@ func (m MarketExtended) TableValues() []string {
 	}
 }
 
+// NewMarketExtended creates a new MarketExtended object.
 func NewMarketExtended(market Market, baseCurrency, quoteCurrency ccstorage.Currency) MarketExtended {
 	return MarketExtended{
 		ID:            market.ID,
 		BaseCurrency:  baseCurrency,
 		QuoteCurrency: quoteCurrency,
+		TickSize:      market.GetTickSize(),
+		LotSize:       market.GetLotSize(),
+		MinNotional:   market.GetMinNotional(),
 	}
 }
@@ -0,0 +1,45 @@
+package types
+
+// Enum type to define market lifecycle status.
+type MarketStatus string
+
+const (
+	// Orders are accepted and matched
+	MarketStatusActive MarketStatus = "active"
+	// Orders are accepted, but not matched
+	MarketStatusPostOnly MarketStatus = "post_only"
+	// Orders are not accepted and not matched (existing orders can still be revoked)
+	MarketStatusHalted MarketStatus = "halted"
+	// Orders are not accepted, existing orders are revoked and refunded
+	MarketStatusDelisted MarketStatus = "delisted"
+)
+
+// IsValid validates enum.
+func (s MarketStatus) IsValid() bool {
+	switch s {
+	case MarketStatusActive, MarketStatusPostOnly, MarketStatusHalted, MarketStatusDelisted:
+		return true
+	}
+
+	return false
+}
+
+// AcceptsOrders checks if new orders can be posted to the market.
+func (s MarketStatus) AcceptsOrders() bool {
+	return s == MarketStatusActive || s == MarketStatusPostOnly
+}
+
+// AllowsMatching checks if market orders can be matched.
+func (s MarketStatus) AllowsMatching() bool {
+	return s == MarketStatusActive
+}
+
+// String returns string enum representation.
+func (s MarketStatus) String() string {
+	return string(s)
+}
+
+// NewMarketStatusRaw creates a new MarketStatus object without checks.
+func NewMarketStatusRaw(str string) MarketStatus {
+	return MarketStatus(str)
+}
@@ -0,0 +1,51 @@
+// +build unit
+
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	dnTypes "github.com/dfinance/dnode/helpers/types"
+)
+
+func TestMarkets_MarketStatus(t *testing.T) {
+	t.Parallel()
+
+	require.True(t, MarketStatusActive.IsValid())
+	require.True(t, MarketStatusPostOnly.IsValid())
+	require.True(t, MarketStatusHalted.IsValid())
+	require.True(t, MarketStatusDelisted.IsValid())
+	require.False(t, NewMarketStatusRaw("unknown").IsValid())
+	require.False(t, NewMarketStatusRaw("").IsValid())
+
+	require.True(t, MarketStatusActive.AcceptsOrders())
+	require.True(t, MarketStatusPostOnly.AcceptsOrders())
+	require.False(t, MarketStatusHalted.AcceptsOrders())
+	require.False(t, MarketStatusDelisted.AcceptsOrders())
+
+	require.True(t, MarketStatusActive.AllowsMatching())
+	require.False(t, MarketStatusPostOnly.AllowsMatching())
+	require.False(t, MarketStatusHalted.AllowsMatching())
+	require.False(t, MarketStatusDelisted.AllowsMatching())
+
+	// empty status is treated as active
+	market := Market{ID: dnTypes.NewIDFromUint64(0), BaseAssetDenom: "btc", QuoteAssetDenom: "xfi"}
+	require.Equal(t, MarketStatusActive, market.GetStatus())
+	require.NoError(t, market.Valid())
+
+	market.Status = NewMarketStatusRaw("unknown")
+	require.Error(t, market.Valid())
+}
+
+func TestMarkets_MsgSetMarketStatus_Valid(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, NewMsgSetMarketStatus(dnTypes.NewIDFromUint64(0), MarketStatusHalted).ValidateBasic())
+	require.Error(t, NewMsgSetMarketStatus(dnTypes.NewIDFromUint64(0), NewMarketStatusRaw("unknown")).ValidateBasic())
+	require.Error(t, NewMsgSetMarketStatus(dnTypes.ID{}, MarketStatusHalted).ValidateBasic())
+
+	require.NoError(t, NewUpdateMarketStatusProposal(dnTypes.NewIDFromUint64(0), MarketStatusDelisted).ValidateBasic())
+	require.Error(t, NewUpdateMarketStatusProposal(dnTypes.NewIDFromUint64(0), NewMarketStatusRaw("")).ValidateBasic())
+}
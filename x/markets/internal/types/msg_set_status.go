@@ -0,0 +1,57 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	dnTypes "github.com/dfinance/dnode/helpers/types"
+)
+
+// Client multisig message to change market status.
+type MsgSetMarketStatus struct {
+	// Market ID
+	MarketID dnTypes.ID `json:"market_id" yaml:"market_id"`
+	// New market status
+	Status MarketStatus `json:"status" yaml:"status"`
+}
+
+// Implements sdk.Msg interface.
+func (msg MsgSetMarketStatus) Route() string {
+	return RouterKey
+}
+
+// Implements sdk.Msg interface.
+func (msg MsgSetMarketStatus) Type() string {
+	return "set_market_status"
+}
+
+// Implements sdk.Msg interface.
+func (msg MsgSetMarketStatus) ValidateBasic() error {
+	if err := msg.MarketID.Valid(); err != nil {
+		return sdkErrors.Wrap(ErrWrongID, err.Error())
+	}
+	if !msg.Status.IsValid() {
+		return sdkErrors.Wrap(ErrWrongStatus, msg.Status.String())
+	}
+
+	return nil
+}
+
+// Implements sdk.Msg interface.
+func (msg MsgSetMarketStatus) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// Implements sdk.Msg interface.
+// Msg is a multisig, so there are not signers.
+func (msg MsgSetMarketStatus) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{}
+}
+
+// NewMsgSetMarketStatus creates a new MsgSetMarketStatus message.
+func NewMsgSetMarketStatus(marketID dnTypes.ID, status MarketStatus) MsgSetMarketStatus {
+	return MsgSetMarketStatus{
+		MarketID: marketID,
+		Status:   status,
+	}
+}
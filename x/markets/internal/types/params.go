@@ -0,0 +1,69 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+// Default parameters values.
+const (
+	// markets are created via gov proposals only
+	DefCreateMarketMsgEnabled = false
+)
+
+// Parameter store keys.
+var (
+	ParamStoreKeyCreateMarketMsgEnabled = []byte("createmarketmsgenabled")
+)
+
+// Params defines keeper params.
+type Params struct {
+	// MsgCreateMarket is accepted (market could be created by any account), otherwise markets are added via AddMarketProposal only
+	CreateMarketMsgEnabled bool `json:"create_market_msg_enabled" yaml:"create_market_msg_enabled"`
+}
+
+// Implements subspace.ParamSet interface.
+func (p *Params) ParamSetPairs() params.ParamSetPairs {
+	return params.ParamSetPairs{
+		{Key: ParamStoreKeyCreateMarketMsgEnabled, Value: &p.CreateMarketMsgEnabled, ValidatorFn: validateCreateMarketMsgEnabled},
+	}
+}
+
+// Validate validates params.
+func (p Params) Validate() error {
+	return validateCreateMarketMsgEnabled(p.CreateMarketMsgEnabled)
+}
+
+func (p Params) String() string {
+	return fmt.Sprintf("Params:\n"+
+		"CreateMarketMsgEnabled: %v",
+		p.CreateMarketMsgEnabled,
+	)
+}
+
+// NewParams creates a new module Params.
+func NewParams(createMarketMsgEnabled bool) Params {
+	return Params{
+		CreateMarketMsgEnabled: createMarketMsgEnabled,
+	}
+}
+
+// DefaultParams returns default module Params.
+func DefaultParams() Params {
+	return NewParams(DefCreateMarketMsgEnabled)
+}
+
+// ParamKeyTable returns Key declaration for parameters storage.
+func ParamKeyTable() params.KeyTable {
+	return params.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// validateCreateMarketMsgEnabled validates CreateMarketMsgEnabled param value.
+func validateCreateMarketMsgEnabled(value interface{}) error {
+	if _, ok := value.(bool); !ok {
+		return fmt.Errorf("create_market_msg_enabled: invalid parameter type: %T", value)
+	}
+
+	return nil
+}
@@ -0,0 +1,31 @@
+package types
+
+// Enum type to define the circuit breaker reference price source.
+type PriceReference string
+
+const (
+	// Previous market clearance price
+	PriceReferenceClearance PriceReference = "clearance"
+	// Oracle current price for the market asset code
+	PriceReferenceOracle PriceReference = "oracle"
+)
+
+// IsValid validates enum.
+func (r PriceReference) IsValid() bool {
+	switch r {
+	case PriceReferenceClearance, PriceReferenceOracle:
+		return true
+	}
+
+	return false
+}
+
+// String returns string enum representation.
+func (r PriceReference) String() string {
+	return string(r)
+}
+
+// NewPriceReferenceRaw creates a new PriceReference object without checks.
+func NewPriceReferenceRaw(str string) PriceReference {
+	return PriceReference(str)
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/spf13/cobra"
 	abci "github.com/tendermint/tendermint/abci/types"
 
+	"github.com/dfinance/dnode/x/core/msmodule"
 	"github.com/dfinance/dnode/x/markets/client"
 	"github.com/dfinance/dnode/x/markets/client/rest"
 )
@@ -20,6 +21,7 @@ import (
 var (
 	_ module.AppModule      = AppModule{}
 	_ module.AppModuleBasic = AppModuleBasic{}
+	_ msmodule.AppMsModule  = AppModule{}
 )
 
 // AppModuleBasic app module basics object.
@@ -79,13 +81,23 @@ func NewAppModule(keeper Keeper) AppModule {
 	}
 }
 
+// NewAppMsModule creates new AppMsModule object.
+func NewAppMsModule(keeper Keeper) msmodule.AppMsModule {
+	return AppModule{
+		AppModuleBasic: AppModuleBasic{},
+		keeper:         keeper,
+	}
+}
+
 // Name gets module name.
 func (app AppModule) Name() string {
 	return ModuleName
 }
 
 // RegisterInvariants registers module invariants.
-func (app AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
+func (app AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	RegisterInvariants(ir, app.keeper)
+}
 
 // Route returns module messages route.
 func (app AppModule) Route() string {
@@ -97,6 +109,11 @@ func (app AppModule) NewHandler() sdk.Handler {
 	return NewHandler(app.keeper)
 }
 
+// NewMsHandler returns module multisig messages handler.
+func (app AppModule) NewMsHandler() msmodule.MsHandler {
+	return NewMsHandler(app.keeper)
+}
+
 // QuerierRoute returns module querier route.
 func (app AppModule) QuerierRoute() string {
 	return ModuleName
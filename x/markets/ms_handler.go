@@ -0,0 +1,32 @@
+package markets
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	dnTypes "github.com/dfinance/dnode/helpers/types"
+	"github.com/dfinance/dnode/x/core/msmodule"
+)
+
+// NewMsHandler creates core.MsMsg type messages handler.
+func NewMsHandler(k Keeper) msmodule.MsHandler {
+	return func(ctx sdk.Context, msg msmodule.MsMsg) error {
+		switch msg := msg.(type) {
+		case MsgSetMarketStatus:
+			return handleMsMsgSetMarketStatus(ctx, k, msg)
+		default:
+			return sdkErrors.Wrapf(sdkErrors.ErrUnknownRequest, "unrecognized %s module multisig msg type: %v", ModuleName, msg.Type())
+		}
+	}
+}
+
+// handleMsMsgSetMarketStatus hanldes MsgSetMarketStatus multisig message.
+func handleMsMsgSetMarketStatus(ctx sdk.Context, k Keeper, msg MsgSetMarketStatus) error {
+	if _, err := k.SetStatus(ctx, msg.MarketID, msg.Status); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(dnTypes.NewModuleNameEvent(ModuleName))
+
+	return nil
+}
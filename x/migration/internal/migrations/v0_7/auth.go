@@ -0,0 +1,98 @@
+package v0_7
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dfinance/dnode/x/orders"
+)
+
+// migrateOrdersModuleAccount converts auth module genesis state: orders module account balance is set to open orders locked coins.
+// Prior to v0.7 order fills haven't withdrawn spent lock coins from the orders module account (fill coins were added to
+// the owner balance), so the module account balance exceeds open orders locked coins by the amount of all spent coins.
+// The surplus is removed, so account balances match the total supply again.
+func migrateOrdersModuleAccount(stateOldBz json.RawMessage, lockedCoins sdk.Coins) (json.RawMessage, error) {
+	state := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(stateOldBz, &state); err != nil {
+		return nil, fmt.Errorf("oldState JSON unmarshal: %w", err)
+	}
+
+	var accounts []map[string]json.RawMessage
+	if accountsBz, ok := state["accounts"]; ok && string(accountsBz) != "null" {
+		if err := json.Unmarshal(accountsBz, &accounts); err != nil {
+			return nil, fmt.Errorf("accounts: JSON unmarshal: %w", err)
+		}
+	}
+
+	found := false
+	for i, account := range accounts {
+		var accType string
+		if err := json.Unmarshal(account["type"], &accType); err != nil {
+			return nil, fmt.Errorf("accounts[%d]: type: JSON unmarshal: %w", i, err)
+		}
+		if accType != "cosmos-sdk/ModuleAccount" {
+			continue
+		}
+
+		accValue := make(map[string]json.RawMessage)
+		if err := json.Unmarshal(account["value"], &accValue); err != nil {
+			return nil, fmt.Errorf("accounts[%d]: value: JSON unmarshal: %w", i, err)
+		}
+
+		var accName string
+		if err := json.Unmarshal(accValue["name"], &accName); err != nil {
+			return nil, fmt.Errorf("accounts[%d]: name: JSON unmarshal: %w", i, err)
+		}
+		if accName != orders.ModuleName {
+			continue
+		}
+
+		var accCoins sdk.Coins
+		if coinsBz, ok := accValue["coins"]; ok && string(coinsBz) != "null" {
+			if err := json.Unmarshal(coinsBz, &accCoins); err != nil {
+				return nil, fmt.Errorf("accounts[%d]: coins: JSON unmarshal: %w", i, err)
+			}
+		}
+		if !accCoins.IsAllGTE(lockedCoins) {
+			return nil, fmt.Errorf("orders module account: balance %s is less than orders locked coins %s", accCoins, lockedCoins)
+		}
+
+		coinsBz, err := json.Marshal(lockedCoins)
+		if err != nil {
+			return nil, fmt.Errorf("accounts[%d]: coins: JSON marshal: %w", i, err)
+		}
+		accValue["coins"] = coinsBz
+
+		valueBz, err := json.Marshal(accValue)
+		if err != nil {
+			return nil, fmt.Errorf("accounts[%d]: value: JSON marshal: %w", i, err)
+		}
+		account["value"] = valueBz
+
+		found = true
+		break
+	}
+
+	if !found {
+		if !lockedCoins.Empty() {
+			return nil, fmt.Errorf("orders module account: not found with orders locked coins %s", lockedCoins)
+		}
+
+		return stateOldBz, nil
+	}
+
+	accountsBz, err := json.Marshal(accounts)
+	if err != nil {
+		return nil, fmt.Errorf("accounts: JSON marshal: %w", err)
+	}
+	state["accounts"] = accountsBz
+
+	stateNewBz, err := json.Marshal(state)
+	if err != nil {
+		return nil, fmt.Errorf("newState JSON marshal: %w", err)
+	}
+
+	return stateNewBz, nil
+}
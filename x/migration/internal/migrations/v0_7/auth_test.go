@@ -0,0 +1,74 @@
+// +build unit
+
+package v0_7
+
+import (
+	"encoding/json"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigration_V07_OrdersModuleAccount(t *testing.T) {
+	buildState := func(name, coins string) json.RawMessage {
+		return json.RawMessage(`{
+			"params": {"max_memo_characters": "256"},
+			"accounts": [
+				{"type": "cosmos-sdk/Account", "value": {"address": "", "coins": [{"denom": "xfi", "amount": "100"}]}},
+				{"type": "cosmos-sdk/ModuleAccount", "value": {"address": "", "coins": ` + coins + `, "name": "` + name + `", "permissions": null}}
+			]
+		}`)
+	}
+
+	getAccountsCoins := func(stateBz json.RawMessage) []sdk.Coins {
+		var state struct {
+			Accounts []struct {
+				Value struct {
+					Coins sdk.Coins `json:"coins"`
+				} `json:"value"`
+			} `json:"accounts"`
+		}
+		require.NoError(t, json.Unmarshal(stateBz, &state))
+
+		coins := make([]sdk.Coins, 0, len(state.Accounts))
+		for _, acc := range state.Accounts {
+			coins = append(coins, acc.Value.Coins)
+		}
+
+		return coins
+	}
+
+	lockedCoins := sdk.NewCoins(sdk.NewInt64Coin("btc", 10), sdk.NewInt64Coin("xfi", 5))
+
+	// ok: surplus is removed
+	{
+		stateBz, err := migrateOrdersModuleAccount(buildState("orders", `[{"denom": "btc", "amount": "15"}, {"denom": "xfi", "amount": "5"}, {"denom": "eth", "amount": "1"}]`), lockedCoins)
+		require.NoError(t, err)
+
+		coins := getAccountsCoins(stateBz)
+		require.Len(t, coins, 2)
+		require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("xfi", 100)).String(), coins[0].String())
+		require.Equal(t, lockedCoins.String(), coins[1].String())
+	}
+
+	// ok: no orders and no orders module account
+	{
+		stateOldBz := buildState("gov", `[{"denom": "btc", "amount": "15"}]`)
+		stateBz, err := migrateOrdersModuleAccount(stateOldBz, sdk.NewCoins())
+		require.NoError(t, err)
+		require.Equal(t, stateOldBz, stateBz)
+	}
+
+	// fail: module account balance is less than locked coins
+	{
+		_, err := migrateOrdersModuleAccount(buildState("orders", `[{"denom": "btc", "amount": "9"}, {"denom": "xfi", "amount": "5"}]`), lockedCoins)
+		require.Error(t, err)
+	}
+
+	// fail: module account not found
+	{
+		_, err := migrateOrdersModuleAccount(buildState("gov", `[{"denom": "btc", "amount": "15"}]`), lockedCoins)
+		require.Error(t, err)
+	}
+}
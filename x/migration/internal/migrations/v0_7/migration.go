@@ -1,7 +1,13 @@
 package v0_7
 
 import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/genutil"
+
+	"github.com/dfinance/dnode/x/oracle"
+	"github.com/dfinance/dnode/x/orders"
 )
 
 // Migrate migrates exported state from v0.6.X to a v0.7.0 genesis state.
@@ -34,5 +40,39 @@ func Migrate(appState genutil.AppMap) (genutil.AppMap, error) {
 	//	appState[moduleName] = stateNewBz
 	//}
 
+	// migrate orders module (orders module account balance is fixed up using the old state orders)
+	moduleName := orders.ModuleName
+	if stateOldBz := appState[moduleName]; stateOldBz != nil {
+		lockedCoins, err := getOrdersLockedCoins(stateOldBz)
+		if err != nil {
+			return nil, fmt.Errorf("module %q: locked coins: %w", moduleName, err)
+		}
+
+		stateNewBz, err := migrateOrders(stateOldBz)
+		if err != nil {
+			return nil, fmt.Errorf("module %q: migration: %w", moduleName, err)
+		}
+		appState[moduleName] = stateNewBz
+
+		if authStateOldBz := appState[auth.ModuleName]; authStateOldBz != nil {
+			authStateNewBz, err := migrateOrdersModuleAccount(authStateOldBz, lockedCoins)
+			if err != nil {
+				return nil, fmt.Errorf("module %q: migration: %w", auth.ModuleName, err)
+			}
+			appState[auth.ModuleName] = authStateNewBz
+		}
+	}
+
+	// migrate oracle module
+	moduleName = oracle.ModuleName
+	if stateOldBz := appState[moduleName]; stateOldBz != nil {
+		stateNewBz, err := migrateOracle(stateOldBz)
+		if err != nil {
+			return nil, fmt.Errorf("module %q: migration: %w", moduleName, err)
+		}
+
+		appState[moduleName] = stateNewBz
+	}
+
 	return appState, nil
 }
@@ -0,0 +1,62 @@
+package v0_7
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/dfinance/dnode/x/oracle"
+)
+
+// migrateOracle converts oracle module genesis state: default CurrentPrice aggregation, RawPrices storage and oracles rewards params are added.
+// Assets without the aggregation mode use the median mode, so those are kept as is.
+func migrateOracle(stateOldBz json.RawMessage) (json.RawMessage, error) {
+	state := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(stateOldBz, &state); err != nil {
+		return nil, fmt.Errorf("oldState JSON unmarshal: %w", err)
+	}
+
+	params := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(state["asset_params"], &params); err != nil {
+		return nil, fmt.Errorf("asset_params: JSON unmarshal: %w", err)
+	}
+
+	defaultParams := oracle.DefaultParams()
+	newParams := map[string]interface{}{
+		"aggregation": defaultParams.Aggregation,
+		"raw_prices":  defaultParams.RawPrices,
+		"rewards":     defaultParams.Rewards,
+	}
+	for key, value := range newParams {
+		if valueBz, ok := params[key]; ok && string(valueBz) != "null" {
+			continue
+		}
+
+		valueBz, err := oracle.ModuleCdc.MarshalJSON(value)
+		if err != nil {
+			return nil, fmt.Errorf("asset_params: %s: JSON marshal: %w", key, err)
+		}
+		params[key] = valueBz
+	}
+
+	paramsBz, err := json.Marshal(params)
+	if err != nil {
+		return nil, fmt.Errorf("asset_params: JSON marshal: %w", err)
+	}
+	state["asset_params"] = paramsBz
+
+	stateNewBz, err := json.Marshal(state)
+	if err != nil {
+		return nil, fmt.Errorf("newState JSON marshal: %w", err)
+	}
+
+	var stateNew oracle.GenesisState
+	if err := oracle.ModuleCdc.UnmarshalJSON(stateNewBz, &stateNew); err != nil {
+		return nil, fmt.Errorf("newState JSON unmarshal: %w", err)
+	}
+	if err := stateNew.Validate(time.Time{}); err != nil {
+		return nil, fmt.Errorf("newState validation: %w", err)
+	}
+
+	return stateNewBz, nil
+}
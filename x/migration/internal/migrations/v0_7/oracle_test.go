@@ -0,0 +1,74 @@
+// +build unit
+
+package v0_7
+
+import (
+	"encoding/json"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dfinance/dnode/x/oracle"
+)
+
+func TestMigration_V07_Oracle(t *testing.T) {
+	expState := oracle.DefaultGenesisState()
+	expState.Params.Assets = oracle.Assets{
+		oracle.NewAsset("btc_xfi", oracle.Oracles{{Address: sdk.AccAddress("wallet13jyjuz3kkdvqw")}}, true),
+	}
+	expState.Params.Nominees = []string{"wallet13jyjuz3kkdvqw"}
+
+	// build the old state: aggregation, rawPrices and rewards params are removed
+	buildOldState := func() json.RawMessage {
+		state := make(map[string]json.RawMessage)
+		require.NoError(t, json.Unmarshal(oracle.ModuleCdc.MustMarshalJSON(expState), &state))
+
+		params := make(map[string]json.RawMessage)
+		require.NoError(t, json.Unmarshal(state["asset_params"], &params))
+		delete(params, "aggregation")
+		delete(params, "raw_prices")
+		delete(params, "rewards")
+
+		bz, err := json.Marshal(params)
+		require.NoError(t, err)
+		state["asset_params"] = bz
+
+		bz, err = json.Marshal(state)
+		require.NoError(t, err)
+
+		return bz
+	}
+
+	// ok
+	{
+		stateBz, err := migrateOracle(buildOldState())
+		require.NoError(t, err)
+
+		var state oracle.GenesisState
+		require.NoError(t, oracle.ModuleCdc.UnmarshalJSON(stateBz, &state))
+		require.True(t, expState.Equal(state))
+		require.Equal(t, oracle.AggregationMedian, state.Params.Assets[0].AggregationMode())
+	}
+
+	// already migrated state is not changed
+	{
+		migratedState := expState
+		migratedState.Params.Aggregation.TwapWindow = 5
+		migratedState.Params.RawPrices.RetentionBlocks = 0
+		migratedState.Params.Rewards.EpochBlocks = 0
+
+		stateBz, err := migrateOracle(oracle.ModuleCdc.MustMarshalJSON(migratedState))
+		require.NoError(t, err)
+
+		var state oracle.GenesisState
+		require.NoError(t, oracle.ModuleCdc.UnmarshalJSON(stateBz, &state))
+		require.True(t, migratedState.Equal(state))
+	}
+
+	// invalid params
+	{
+		_, err := migrateOracle(json.RawMessage(`{"asset_params":"invalid"}`))
+		require.Error(t, err)
+	}
+}
@@ -0,0 +1,157 @@
+package v0_7
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dfinance/dnode/x/markets"
+	"github.com/dfinance/dnode/x/orders"
+)
+
+// migrateOrders converts orders module genesis state: orders embedded markets (MarketExtended) are replaced with market IDs,
+// missing module params are set to defaults.
+// Migration is done on JSON level as other order fields are not changed.
+func migrateOrders(stateOldBz json.RawMessage) (json.RawMessage, error) {
+	state := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(stateOldBz, &state); err != nil {
+		return nil, fmt.Errorf("oldState JSON unmarshal: %w", err)
+	}
+
+	for _, key := range []string{"orders", "stop_orders"} {
+		ordersBz, ok := state[key]
+		if !ok || string(ordersBz) == "null" {
+			continue
+		}
+
+		var ordersList []map[string]json.RawMessage
+		if err := json.Unmarshal(ordersBz, &ordersList); err != nil {
+			return nil, fmt.Errorf("%s: JSON unmarshal: %w", key, err)
+		}
+
+		for i, order := range ordersList {
+			if _, ok := order["market_id"]; ok {
+				continue
+			}
+
+			marketBz, ok := order["market"]
+			if !ok {
+				return nil, fmt.Errorf("%s[%d]: market: not found", key, i)
+			}
+
+			market := struct {
+				ID json.RawMessage `json:"id"`
+			}{}
+			if err := json.Unmarshal(marketBz, &market); err != nil {
+				return nil, fmt.Errorf("%s[%d]: market: JSON unmarshal: %w", key, i, err)
+			}
+			if market.ID == nil {
+				return nil, fmt.Errorf("%s[%d]: market: id not found", key, i)
+			}
+
+			delete(order, "market")
+			order["market_id"] = market.ID
+		}
+
+		migratedBz, err := json.Marshal(ordersList)
+		if err != nil {
+			return nil, fmt.Errorf("%s: JSON marshal: %w", key, err)
+		}
+		state[key] = migratedBz
+	}
+
+	params := make(map[string]json.RawMessage)
+	if paramsBz, ok := state["params"]; ok && string(paramsBz) != "null" {
+		if err := json.Unmarshal(paramsBz, &params); err != nil {
+			return nil, fmt.Errorf("params: JSON unmarshal: %w", err)
+		}
+	}
+
+	defaultParamsBz, err := orders.ModuleCdc.MarshalJSON(orders.DefaultParams())
+	if err != nil {
+		return nil, fmt.Errorf("default params: JSON marshal: %w", err)
+	}
+	defaultParams := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(defaultParamsBz, &defaultParams); err != nil {
+		return nil, fmt.Errorf("default params: JSON unmarshal: %w", err)
+	}
+	for key, valueBz := range defaultParams {
+		if curValueBz, ok := params[key]; ok && string(curValueBz) != "null" {
+			continue
+		}
+		params[key] = valueBz
+	}
+
+	paramsBz, err := json.Marshal(params)
+	if err != nil {
+		return nil, fmt.Errorf("params: JSON marshal: %w", err)
+	}
+	state["params"] = paramsBz
+
+	stateNewBz, err := json.Marshal(state)
+	if err != nil {
+		return nil, fmt.Errorf("newState JSON marshal: %w", err)
+	}
+
+	var stateNew orders.GenesisState
+	if err := orders.ModuleCdc.UnmarshalJSON(stateNewBz, &stateNew); err != nil {
+		return nil, fmt.Errorf("newState JSON unmarshal: %w", err)
+	}
+	if err := stateNew.Validate(time.Time{}); err != nil {
+		return nil, fmt.Errorf("newState validation: %w", err)
+	}
+
+	return stateNewBz, nil
+}
+
+// getOrdersLockedCoins returns the sum of lock coins and deposits of the v0.6 orders module genesis state orders.
+// Order lock coin is calculated using the order embedded market (MarketExtended).
+func getOrdersLockedCoins(stateOldBz json.RawMessage) (sdk.Coins, error) {
+	state := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(stateOldBz, &state); err != nil {
+		return nil, fmt.Errorf("oldState JSON unmarshal: %w", err)
+	}
+
+	lockedCoins := sdk.NewCoins()
+	for _, key := range []string{"orders", "stop_orders"} {
+		ordersBz, ok := state[key]
+		if !ok || string(ordersBz) == "null" {
+			continue
+		}
+
+		var ordersList []struct {
+			ID        json.RawMessage         `json:"id"`
+			Market    *markets.MarketExtended `json:"market"`
+			Direction orders.Direction        `json:"direction"`
+			Price     sdk.Uint                `json:"price"`
+			Quantity  sdk.Uint                `json:"quantity"`
+			Deposit   sdk.Coins               `json:"deposit"`
+		}
+		if err := orders.ModuleCdc.UnmarshalJSON(ordersBz, &ordersList); err != nil {
+			return nil, fmt.Errorf("%s: JSON unmarshal: %w", key, err)
+		}
+
+		for i, oldOrder := range ordersList {
+			if oldOrder.Market == nil {
+				return nil, fmt.Errorf("%s[%d]: market: not found", key, i)
+			}
+
+			order := orders.Order{
+				Market:    *oldOrder.Market,
+				Direction: oldOrder.Direction,
+				Price:     oldOrder.Price,
+				Quantity:  oldOrder.Quantity,
+			}
+			lockCoin, err := order.LockCoin()
+			if err != nil {
+				return nil, fmt.Errorf("%s[%d]: lock coin: %w", key, i, err)
+			}
+
+			lockedCoins = lockedCoins.Add(lockCoin).Add(oldOrder.Deposit...)
+		}
+	}
+
+	return lockedCoins, nil
+}
@@ -0,0 +1,150 @@
+// +build unit
+
+package v0_7
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	dnTypes "github.com/dfinance/dnode/helpers/types"
+	"github.com/dfinance/dnode/x/orders"
+)
+
+func TestMigration_V07_Orders(t *testing.T) {
+	now := time.Now().Add(-time.Hour).UTC()
+	newOrder := func(id, marketID uint64) orders.StoredOrder {
+		return orders.StoredOrder{
+			ID:        dnTypes.NewIDFromUint64(id),
+			Owner:     sdk.AccAddress("wallet13jyjuz3kkdvqw"),
+			MarketID:  dnTypes.NewIDFromUint64(marketID),
+			Direction: orders.BidDirection,
+			Price:     sdk.NewUint(100),
+			Quantity:  sdk.NewUint(10),
+			Ttl:       60,
+			CreatedAt: now,
+			UpdatedAt: now,
+		}
+	}
+
+	lastID := dnTypes.NewIDFromUint64(1)
+	stopOrder := newOrder(1, 1)
+	stopOrder.StopPrice, stopOrder.StopTrigger = sdk.NewUint(50), orders.StopTriggerClearance
+	expState := orders.DefaultGenesisState()
+	expState.Orders = orders.StoredOrders{newOrder(0, 0)}
+	expState.StopOrders = orders.StoredOrders{stopOrder}
+	expState.LastOrderId = &lastID
+
+	// build the old state: market ID is replaced with an embedded market object, params are not set
+	buildOldState := func() json.RawMessage {
+		state := make(map[string]json.RawMessage)
+		require.NoError(t, json.Unmarshal(orders.ModuleCdc.MustMarshalJSON(expState), &state))
+		delete(state, "params")
+
+		for _, key := range []string{"orders", "stop_orders"} {
+			var ordersList []map[string]json.RawMessage
+			require.NoError(t, json.Unmarshal(state[key], &ordersList))
+			for _, order := range ordersList {
+				order["market"] = json.RawMessage(`{"id":` + string(order["market_id"]) + `,"base_currency":{"denom":"btc","decimals":8,"supply":"0"},"quote_currency":{"denom":"xfi","decimals":18,"supply":"0"}}`)
+				delete(order, "market_id")
+			}
+
+			bz, err := json.Marshal(ordersList)
+			require.NoError(t, err)
+			state[key] = bz
+		}
+
+		bz, err := json.Marshal(state)
+		require.NoError(t, err)
+
+		return bz
+	}
+
+	// ok
+	{
+		stateBz, err := migrateOrders(buildOldState())
+		require.NoError(t, err)
+
+		var state orders.GenesisState
+		require.NoError(t, orders.ModuleCdc.UnmarshalJSON(stateBz, &state))
+		require.True(t, expState.Equal(state))
+	}
+
+	// already migrated state is not changed
+	{
+		stateBz, err := migrateOrders(orders.ModuleCdc.MustMarshalJSON(expState))
+		require.NoError(t, err)
+
+		var state orders.GenesisState
+		require.NoError(t, orders.ModuleCdc.UnmarshalJSON(stateBz, &state))
+		require.True(t, expState.Equal(state))
+	}
+
+	// v0.6 state: no params, stop orders and fill records
+	{
+		owner := sdk.AccAddress("wallet13jyjuz3kkdvqw")
+		createdAt := now.Format(time.RFC3339Nano)
+		oldStateBz := json.RawMessage(`{
+			"orders": [{
+				"id": "0",
+				"owner": "` + owner.String() + `",
+				"market": {"id": "0", "base_currency": {"denom": "btc", "decimals": 8}, "quote_currency": {"denom": "xfi", "decimals": 18}},
+				"direction": "bid",
+				"price": "100",
+				"quantity": "10",
+				"ttl_dur": "60",
+				"created_at": "` + createdAt + `",
+				"updated_at": "` + createdAt + `"
+			}],
+			"last_order_id": "0"
+		}`)
+
+		stateBz, err := migrateOrders(oldStateBz)
+		require.NoError(t, err)
+
+		var state orders.GenesisState
+		require.NoError(t, orders.ModuleCdc.UnmarshalJSON(stateBz, &state))
+		require.Equal(t, orders.DefaultParams().String(), state.Params.String())
+		require.NotZero(t, state.Params.FillRecordRetention)
+		require.Len(t, state.Orders, 1)
+		require.True(t, state.Orders[0].MarketID.Equal(dnTypes.NewIDFromUint64(0)))
+		require.True(t, state.Orders[0].Owner.Equals(owner))
+	}
+
+	// order without market
+	{
+		_, err := migrateOrders(json.RawMessage(`{"orders":[{"id":"0"}]}`))
+		require.Error(t, err)
+
+		_, err = getOrdersLockedCoins(json.RawMessage(`{"orders":[{"id":"0"}]}`))
+		require.Error(t, err)
+	}
+
+	// locked coins: bid order locks quote coins, ask stop order locks base coins and deposit
+	{
+		oldStateBz := json.RawMessage(`{
+			"orders": [{
+				"id": "0",
+				"market": {"id": "0", "base_currency": {"denom": "btc", "decimals": 8}, "quote_currency": {"denom": "xfi", "decimals": 18}},
+				"direction": "bid",
+				"price": "1000000000000000000",
+				"quantity": "50000000"
+			}],
+			"stop_orders": [{
+				"id": "1",
+				"market": {"id": "0", "base_currency": {"denom": "btc", "decimals": 8}, "quote_currency": {"denom": "xfi", "decimals": 18}},
+				"direction": "ask",
+				"price": "100",
+				"quantity": "10",
+				"deposit": [{"denom": "xfi", "amount": "5"}]
+			}]
+		}`)
+
+		lockedCoins, err := getOrdersLockedCoins(oldStateBz)
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("btc", 10), sdk.NewCoin("xfi", sdk.NewInt(500000000000000005))).String(), lockedCoins.String())
+	}
+}
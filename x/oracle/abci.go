@@ -17,5 +17,15 @@ func EndBlocker(ctx sdk.Context, k Keeper) []abci.ValidatorUpdate {
 		panic(err.Error())
 	}
 
+	// mark outdated CurrentPrices as stale (after the update as the price might have been refreshed)
+	k.CheckPricesStaleness(ctx)
+
+	// update oracles stats with the current block RawPrices, pay rewards and jail oracles at the end of the epoch
+	k.UpdateOracleStats(ctx)
+	k.ProcessRewardsEpoch(ctx)
+
+	// remove outdated RawPrices (after the CurrentPrice is updated as the aggregation might use them)
+	k.PruneRawPrices(ctx)
+
 	return []abci.ValidatorUpdate{}
 }
@@ -6,24 +6,37 @@ import (
 )
 
 type (
-	GenesisState       = types.GenesisState
-	MsgPostPrice       = types.MsgPostPrice
-	Params             = types.Params
-	QueryRawPricesResp = types.QueryRawPricesResp
-	QueryAssetsResp    = types.QueryAssetsResp
-	Asset              = types.Asset
-	Assets             = types.Assets
-	Oracle             = types.Oracle
-	Oracles            = types.Oracles
-	CurrentPrice       = types.CurrentPrice
-	CurrentPrices      = types.CurrentPrices
-	PostedPrice        = types.PostedPrice
-	Keeper             = keeper.Keeper
-	MsgAddOracle       = types.MsgAddOracle
-	MsgSetOracles      = types.MsgSetOracles
-	MsgAddAsset        = types.MsgAddAsset
-	MsgSetAsset        = types.MsgSetAsset
-	PostPriceParams    = types.PostPriceParams
+	GenesisState            = types.GenesisState
+	MsgPostPrice            = types.MsgPostPrice
+	Params                  = types.Params
+	QueryRawPricesResp      = types.QueryRawPricesResp
+	QueryAssetsResp         = types.QueryAssetsResp
+	Asset                   = types.Asset
+	Assets                  = types.Assets
+	Oracle                  = types.Oracle
+	Oracles                 = types.Oracles
+	CurrentPrice            = types.CurrentPrice
+	CurrentPrices           = types.CurrentPrices
+	PostedPrice             = types.PostedPrice
+	Keeper                  = keeper.Keeper
+	MsgAddOracle            = types.MsgAddOracle
+	MsgSetOracles           = types.MsgSetOracles
+	MsgAddAsset             = types.MsgAddAsset
+	MsgSetAsset             = types.MsgSetAsset
+	MsgFundRewardPool       = types.MsgFundRewardPool
+	PostPriceParams         = types.PostPriceParams
+	AggregationParams       = types.AggregationParams
+	AggregationMode         = types.AggregationMode
+	PriceQuorum             = types.PriceQuorum
+	QueryPriceResp          = types.QueryPriceResp
+	RawPricesParams         = types.RawPricesParams
+	BlockRawPrices          = types.BlockRawPrices
+	QueryRawPricesRangeResp = types.QueryRawPricesRangeResp
+	RewardParams            = types.RewardParams
+	RewardSource            = types.RewardSource
+	OracleCounters          = types.OracleCounters
+	OracleStats             = types.OracleStats
+	OracleStatsList         = types.OracleStatsList
 )
 
 const (
@@ -31,16 +44,43 @@ const (
 	RouterKey         = types.RouterKey
 	DefaultParamspace = types.DefaultParamspace
 	StoreKey          = types.StoreKey
+	PriceDecimals     = types.PriceDecimals
 	//
-	QueryAssets    = types.QueryAssets
-	QueryRawPrices = types.QueryRawPrices
-	QueryPrice     = types.QueryPrice
+	QueryAssets          = types.QueryAssets
+	QueryRawPrices       = types.QueryRawPrices
+	QueryRawPricesRange  = types.QueryRawPricesRange
+	QueryPrice           = types.QueryPrice
+	QueryOracleStats     = types.QueryOracleStats
+	QueryOracleStatsList = types.QueryOracleStatsList
 	// Event types, attribute types and values
-	EventTypePrice = types.EventTypePrice
+	EventTypePrice      = types.EventTypePrice
+	EventTypePriceStale = types.EventTypePriceStale
+	EventTypeReward     = types.EventTypeReward
+	EventTypeJail       = types.EventTypeJail
+	EventTypeFundPool   = types.EventTypeFundPool
 	//
-	AttributeAssetCode  = types.AttributeAssetCode
-	AttributePrice      = types.AttributePrice
-	AttributeReceivedAt = types.AttributeReceivedAt
+	AttributeAssetCode      = types.AttributeAssetCode
+	AttributePrice          = types.AttributePrice
+	AttributeReceivedAt     = types.AttributeReceivedAt
+	AttributeQuorumPosted   = types.AttributeQuorumPosted
+	AttributeQuorumRequired = types.AttributeQuorumRequired
+	AttributeStaleReason    = types.AttributeStaleReason
+	StaleReasonQuorum       = types.StaleReasonQuorum
+	StaleReasonMaxAge       = types.StaleReasonMaxAge
+	AttributeOracle         = types.AttributeOracle
+	AttributeAmount         = types.AttributeAmount
+	AttributeJailedUntil    = types.AttributeJailedUntil
+	AttributeJailReason     = types.AttributeJailReason
+	AttributeDepositor      = types.AttributeDepositor
+	JailReasonMisses        = types.JailReasonMisses
+	JailReasonDeviations    = types.JailReasonDeviations
+	// Aggregation modes
+	AggregationMedian    = types.AggregationMedian
+	AggregationMedianMAD = types.AggregationMedianMAD
+	AggregationTWAP      = types.AggregationTWAP
+	// Reward sources
+	RewardSourceModule       = types.RewardSourceModule
+	RewardSourceFeeCollector = types.RewardSourceFeeCollector
 )
 
 var (
@@ -48,15 +88,21 @@ var (
 	ModuleCdc            = types.ModuleCdc
 	AvailablePermissions = types.AvailablePermissions
 	// functions aliases
-	RegisterCodec       = types.RegisterCodec
-	NewKeeper           = keeper.NewKeeper
-	NewQuerier          = keeper.NewQuerier
-	DefaultGenesisState = types.DefaultGenesisState
-	DefaultParams       = types.DefaultParams
-	NewParams           = types.NewParams
-	NewAsset            = types.NewAsset
-	NewMsgPostPrice     = types.NewMsgPostPrice
-	GetAssetCodePath    = types.GetAssetCodePath
+	RegisterCodec            = types.RegisterCodec
+	NewKeeper                = keeper.NewKeeper
+	NewQuerier               = keeper.NewQuerier
+	DefaultGenesisState      = types.DefaultGenesisState
+	DefaultParams            = types.DefaultParams
+	NewParams                = types.NewParams
+	NewAsset                 = types.NewAsset
+	NewAggregationModeRaw    = types.NewAggregationModeRaw
+	NewPriceQuorum           = types.NewPriceQuorum
+	DefaultAggregationParams = types.DefaultAggregationParams
+	DefaultRewardParams      = types.DefaultRewardParams
+	NewOracleStats           = types.NewOracleStats
+	NewMsgPostPrice          = types.NewMsgPostPrice
+	NewMsgFundRewardPool     = types.NewMsgFundRewardPool
+	GetAssetCodePath         = types.GetAssetCodePath
 	// perms requests
 	RequestVMStoragePerms = types.RequestVMStoragePerms
 	// errors
@@ -67,4 +113,6 @@ var (
 	ErrExistingAsset = types.ErrExistingAsset
 	ErrInvalidAsset  = types.ErrInvalidAsset
 	ErrInvalidOracle = types.ErrInvalidOracle
+	ErrInvalidHeight = types.ErrInvalidHeight
+	ErrOracleJailed  = types.ErrOracleJailed
 )
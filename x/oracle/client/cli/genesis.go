@@ -16,7 +16,11 @@ import (
 )
 
 const (
-	flagClientHome = "home-client"
+	flagClientHome          = "home-client"
+	flagAssetAggregation    = "aggregation"
+	flagAssetQuorumMin      = "quorum-min"
+	flagAssetQuorumFraction = "quorum-fraction"
+	flagAssetMaxPriceAge    = "max-price-age"
 )
 
 // AddOracleNomineesCmd returns add-oracle-nominees command for adding a nominee to genesis.
@@ -93,7 +97,7 @@ func AddAssetGenCmd(ctx *server.Context, cdc *codec.Codec,
 		Use:   "add-oracle-asset-gen [assetCode] [oracleAddresses]",
 		Short: "Add oracle asset to genesis.json",
 		Args:  cobra.ExactArgs(2),
-		RunE: func(_ *cobra.Command, args []string) error {
+		RunE: func(cmd *cobra.Command, args []string) error {
 			// setup viper config
 			config := ctx.Config
 			config.SetRoot(viper.GetString(cli.HomeFlag))
@@ -133,10 +137,15 @@ func AddAssetGenCmd(ctx *server.Context, cdc *codec.Codec,
 
 			if foundIdx == -1 {
 				genesisOracle.Params.Assets = append(genesisOracle.Params.Assets, types.NewAsset(assetCode, oracles, true))
+				foundIdx = len(genesisOracle.Params.Assets) - 1
 			} else {
 				genesisOracle.Params.Assets[foundIdx].Oracles = oracles
 			}
 
+			if err := parseAssetFlags(cmd, &genesisOracle.Params.Assets[foundIdx]); err != nil {
+				return err
+			}
+
 			// update and export app state
 			genesisStateBz := cdc.MustMarshalJSON(genesisOracle)
 			appState[types.ModuleName] = genesisStateBz
@@ -157,6 +166,7 @@ func AddAssetGenCmd(ctx *server.Context, cdc *codec.Codec,
 	})
 	cmd.Flags().String(cli.HomeFlag, defaultNodeHome, "node's home directory")
 	cmd.Flags().String(flagClientHome, defaultClientHome, "client's home directory")
+	addAssetFlags(cmd)
 
 	return cmd
 }
@@ -175,3 +185,60 @@ func parseOraclesArg(argName, argValue string) (retOracles types.Oracles, retErr
 
 	return
 }
+
+// addAssetFlags adds optional asset params flags.
+func addAssetFlags(cmd *cobra.Command) {
+	cmd.Flags().String(flagAssetAggregation, types.AggregationMedian.String(), "(optional) current price aggregation mode [median,median_mad,twap]")
+	cmd.Flags().String(flagAssetQuorumMin, "0", "(optional) min number of oracles to post prices within a block to update the current price")
+	cmd.Flags().String(flagAssetQuorumFraction, "0", "(optional) min fraction of oracles to post prices within a block to update the current price")
+	cmd.Flags().String(flagAssetMaxPriceAge, "0", "(optional) max current price age in seconds, older price is marked as stale")
+}
+
+// parseAssetFlags parses optional asset params flags and updates the asset (only flags set by the user are applied).
+func parseAssetFlags(cmd *cobra.Command, asset *types.Asset) error {
+	flags := cmd.Flags()
+
+	if flags.Changed(flagAssetAggregation) {
+		aggregation, err := parseAggregationModeParam(flagAssetAggregation, viper.GetString(flagAssetAggregation), helpers.ParamTypeCliFlag)
+		if err != nil {
+			return err
+		}
+		asset.Aggregation = aggregation
+	}
+
+	if flags.Changed(flagAssetQuorumMin) {
+		quorumMin, err := helpers.ParseUint32Param(flagAssetQuorumMin, viper.GetString(flagAssetQuorumMin), helpers.ParamTypeCliFlag)
+		if err != nil {
+			return err
+		}
+		asset.QuorumMin = quorumMin
+	}
+
+	if flags.Changed(flagAssetQuorumFraction) {
+		quorumFraction, err := helpers.ParseSdkDecParam(flagAssetQuorumFraction, viper.GetString(flagAssetQuorumFraction), helpers.ParamTypeCliFlag)
+		if err != nil {
+			return err
+		}
+		asset.QuorumFraction = quorumFraction
+	}
+
+	if flags.Changed(flagAssetMaxPriceAge) {
+		maxPriceAge, err := helpers.ParseUint32Param(flagAssetMaxPriceAge, viper.GetString(flagAssetMaxPriceAge), helpers.ParamTypeCliFlag)
+		if err != nil {
+			return err
+		}
+		asset.MaxPriceAgeInS = maxPriceAge
+	}
+
+	return nil
+}
+
+// parseAggregationModeParam parses asset aggregation mode (empty value is converted to median).
+func parseAggregationModeParam(argName, argValue string, paramType helpers.ParamType) (types.AggregationMode, error) {
+	mode := types.NewAggregationModeRaw(argValue)
+	if !mode.IsValid() {
+		return "", fmt.Errorf("%s %s %q: invalid aggregation mode", argName, paramType, argValue)
+	}
+
+	return mode, nil
+}
@@ -58,7 +58,7 @@ func GetCmdCurrentPrice(queryRoute string, cdc *codec.Codec) *cobra.Command {
 				return err
 			}
 
-			var out types.CurrentPrice
+			var out types.QueryPriceResp
 			cdc.MustUnmarshalJSON(res, &out)
 
 			return cliCtx.PrintOutput(out)
@@ -112,6 +112,52 @@ func GetCmdRawPrices(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	return cmd
 }
 
+// GetCmdRawPricesRange returns query command that returns raw prices for the asset within block heights range.
+func GetCmdRawPricesRange(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rawprices-range [assetCode] [fromHeight] [toHeight]",
+		Short: "Get raw oracle prices for an asset for block heights range (within the retention window)",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// parse inputs
+			assetCode, err := helpers.ParseAssetCodeParam("assetCode", args[0], helpers.ParamTypeCliArg)
+			if err != nil {
+				return err
+			}
+
+			fromHeight, err := helpers.ParseUint64Param("fromHeight", args[1], helpers.ParamTypeCliArg)
+			if err != nil {
+				return err
+			}
+
+			toHeight, err := helpers.ParseUint64Param("toHeight", args[2], helpers.ParamTypeCliArg)
+			if err != nil {
+				return err
+			}
+
+			// query and parse the result
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s/%d/%d", queryRoute, types.QueryRawPricesRange, assetCode, fromHeight, toHeight), nil)
+			if err != nil {
+				return err
+			}
+
+			var out types.QueryRawPricesRangeResp
+			cdc.MustUnmarshalJSON(res, &out)
+
+			return cliCtx.PrintOutput(out)
+		},
+	}
+	helpers.BuildCmdHelp(cmd, []string{
+		"asset code symbol",
+		"range start block height [uint]",
+		"range end block height (inclusive) [uint]",
+	})
+
+	return cmd
+}
+
 // GetCmdAssets returns query command that returns list of assets.
 func GetCmdAssets(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
@@ -133,3 +179,59 @@ func GetCmdAssets(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		},
 	}
 }
+
+// GetCmdOracleStats returns query command that returns oracle prices accuracy stats.
+func GetCmdOracleStats(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "oracle-stats [oracleAddress]",
+		Short: "Get oracle posted prices stats, rewards and jailing status",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// parse inputs
+			address, err := helpers.ParseSdkAddressParam("oracleAddress", args[0], helpers.ParamTypeCliArg)
+			if err != nil {
+				return err
+			}
+
+			// query and parse the result
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", queryRoute, types.QueryOracleStats, address), nil)
+			if err != nil {
+				return err
+			}
+
+			var out types.OracleStats
+			cdc.MustUnmarshalJSON(res, &out)
+
+			return cliCtx.PrintOutput(out)
+		},
+	}
+	helpers.BuildCmdHelp(cmd, []string{
+		"oracle address",
+	})
+
+	return cmd
+}
+
+// GetCmdOracleStatsList returns query command that returns all oracles prices accuracy stats.
+func GetCmdOracleStatsList(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "oracle-stats-list",
+		Short: "Get all oracles posted prices stats, rewards and jailing status",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// query and parse the result
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryOracleStatsList), nil)
+			if err != nil {
+				return err
+			}
+
+			var out types.OracleStatsList
+			cdc.MustUnmarshalJSON(res, &out)
+
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
@@ -172,6 +172,9 @@ func GetCmdAddAsset(cdc *codec.Codec) *cobra.Command {
 
 			// prepare and send message
 			asset := types.NewAsset(assetCode, oracles, true)
+			if err := parseAssetFlags(cmd, &asset); err != nil {
+				return err
+			}
 			if err := asset.ValidateBasic(); err != nil {
 				return err
 			}
@@ -185,6 +188,7 @@ func GetCmdAddAsset(cdc *codec.Codec) *cobra.Command {
 		"asset code symbol",
 		"comma separated list of oracle addresses",
 	})
+	addAssetFlags(cmd)
 
 	return cmd
 }
@@ -218,8 +222,31 @@ func GetCmdSetAsset(cdc *codec.Codec) *cobra.Command {
 				return fmt.Errorf("%s argument %q: empty slice", "oracleAddresses", args[1])
 			}
 
+			// query the existing asset to keep its params not overridden by flags
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.ModuleName, types.QueryAssets), nil)
+			if err != nil {
+				return err
+			}
+
+			var assets types.Assets
+			cdc.MustUnmarshalJSON(res, &assets)
+
+			asset, found := types.Asset{}, false
+			for _, a := range assets {
+				if a.AssetCode == assetCode {
+					asset, found = a, true
+					break
+				}
+			}
+			if !found {
+				return fmt.Errorf("%s argument %q: asset not found", "assetCode", args[0])
+			}
+
 			// prepare and send message
-			asset := types.NewAsset(assetCode, oracles, true)
+			asset.Oracles = oracles
+			if err := parseAssetFlags(cmd, &asset); err != nil {
+				return err
+			}
 			if err := asset.ValidateBasic(); err != nil {
 				return err
 			}
@@ -234,6 +261,44 @@ func GetCmdSetAsset(cdc *codec.Codec) *cobra.Command {
 		"asset code symbol",
 		"comma separated list of oracle addresses",
 	})
+	addAssetFlags(cmd)
+
+	return cmd
+}
+
+// GetCmdFundRewardPool returns tx command which transfers coins to the oracles rewards pool.
+func GetCmdFundRewardPool(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "fund-reward-pool [amount]",
+		Short:   "Fund the oracles rewards pool (oracle module account)",
+		Example: "fund-reward-pool 1000000000000000000xfi",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, txBuilder := helpers.GetTxCmdCtx(cdc, cmd.InOrStdin())
+
+			// parse inputs
+			fromAddr, err := helpers.ParseFromFlag(cliCtx)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoins(args[0])
+			if err != nil {
+				return fmt.Errorf("%s argument %q: parsing Coins: %w", "amount", args[0], err)
+			}
+
+			// prepare and send message
+			msg := types.NewMsgFundRewardPool(fromAddr, amount)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBuilder, []sdk.Msg{msg})
+		},
+	}
+	helpers.BuildCmdHelp(cmd, []string{
+		"coins transferred to the pool in Coins format (1.0 xfi with 18 decimals -> 1000000000000000000xfi)",
+	})
 
 	return cmd
 }
@@ -20,7 +20,10 @@ func GetQueryCmd(cdc *amino.Codec) *cobra.Command {
 	queryCmd.AddCommand(sdkClient.GetCommands(
 		cli.GetCmdCurrentPrice(types.ModuleName, cdc),
 		cli.GetCmdRawPrices(types.ModuleName, cdc),
+		cli.GetCmdRawPricesRange(types.ModuleName, cdc),
 		cli.GetCmdAssets(types.ModuleName, cdc),
+		cli.GetCmdOracleStats(types.ModuleName, cdc),
+		cli.GetCmdOracleStatsList(types.ModuleName, cdc),
 		cli.GetCmdAssetCodeHex(),
 	)...)
 
@@ -43,6 +46,7 @@ func GetTxCmd(cdc *amino.Codec) *cobra.Command {
 		cli.GetCmdSetOracles(cdc),
 		cli.GetCmdSetAsset(cdc),
 		cli.GetCmdAddAsset(cdc),
+		cli.GetCmdFundRewardPool(cdc),
 	)...,
 	)
 
@@ -17,6 +17,9 @@ import (
 const (
 	assetCodeKey   = "assetCode"
 	blockHeightKey = "blockHeight"
+	fromHeightKey  = "fromHeight"
+	toHeightKey    = "toHeight"
+	oracleKey      = "oracle"
 )
 
 type PostPriceReq struct {
@@ -29,12 +32,22 @@ type PostPriceReq struct {
 	ReceivedAt string `json:"received_at" format:"RFC 3339" example:"2020-03-27T13:45:15.293426Z"`
 }
 
+type FundRewardPoolReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+	// Coins transferred to the pool
+	Amount string `json:"amount" example:"1000000000000000000xfi"`
+}
+
 // RegisterRoutes Central function to define routes that get registered by the main application
 func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, storeName string) {
 	r.HandleFunc(fmt.Sprintf("/%s/rawprices", storeName), postPriceHandler(cliCtx)).Methods("PUT")
+	r.HandleFunc(fmt.Sprintf("/%s/rewardpool", storeName), fundRewardPoolHandler(cliCtx)).Methods("PUT")
 	r.HandleFunc(fmt.Sprintf("/%s/rawprices/{%s}/{%s}", storeName, assetCodeKey, blockHeightKey), getRawPricesHandler(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/rawpricesrange/{%s}/{%s}/{%s}", storeName, assetCodeKey, fromHeightKey, toHeightKey), getRawPricesRangeHandler(cliCtx, storeName)).Methods("GET")
 	r.HandleFunc(fmt.Sprintf("/%s/currentprice/{%s}", storeName, assetCodeKey), getCurrentPriceHandler(cliCtx, storeName)).Methods("GET")
 	r.HandleFunc(fmt.Sprintf("/%s/assets", storeName), getAssetsHandler(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/stats", storeName), getOracleStatsListHandler(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/stats/{%s}", storeName, oracleKey), getOracleStatsHandler(cliCtx, storeName)).Methods("GET")
 }
 
 // PostPrice godoc
@@ -98,6 +111,55 @@ func postPriceHandler(cliCtx context.CLIContext) http.HandlerFunc {
 	}
 }
 
+// FundRewardPool godoc
+// @Tags Oracle
+// @Summary Fund oracles rewards pool
+// @Description Send coins to the oracle module account (rewards pool) signed Tx
+// @ID oracleFundRewardPool
+// @Accept  json
+// @Produce json
+// @Param postRequest body FundRewardPoolReq true "FundRewardPool request with signed transaction"
+// @Success 200 {object} OracleRespGetAssets
+// @Failure 400 {object} rest.ErrorResponse "Returned if the request doesn't have valid query params"
+// @Failure 500 {object} rest.ErrorResponse "Returned on server error"
+// @Router /oracle/rewardpool [put]
+func fundRewardPoolHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// parse inputs
+		var req FundRewardPoolReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		addr, err := helpers.ParseSdkAddressParam("from", baseReq.From, helpers.ParamTypeRestRequest)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		amount, err := sdk.ParseCoins(req.Amount)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("amount %s %q: parsing Coins: %v", helpers.ParamTypeRestRequest, req.Amount, err))
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgFundRewardPool(addr, amount)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
 // GetRawPrices godoc
 // @Tags Oracle
 // @Summary Get rawPrices
@@ -146,6 +208,61 @@ func getRawPricesHandler(cliCtx context.CLIContext, storeName string) http.Handl
 	}
 }
 
+// GetRawPricesRange godoc
+// @Tags Oracle
+// @Summary Get rawPrices for block heights range
+// @Description Get rawPrice objects by assetCode for [fromHeight, toHeight] block heights range (within the retention window)
+// @ID oracleGetRawPricesRange
+// @Accept  json
+// @Produce json
+// @Param assetCode path string true "asset code"
+// @Param fromHeight path int true "range start block height"
+// @Param toHeight path int true "range end block height (inclusive)"
+// @Success 200 {object} OracleRespGetRawPricesRange
+// @Failure 400 {object} rest.ErrorResponse "Returned if the request doesn't have valid query params"
+// @Failure 404 {object} rest.ErrorResponse "Returned if requested data wasn't found"
+// @Failure 500 {object} rest.ErrorResponse "Returned on server error"
+// @Router /oracle/rawpricesrange/{assetCode}/{fromHeight}/{toHeight} [get]
+func getRawPricesRangeHandler(cliCtx context.CLIContext, storeName string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// parse inputs and prepare request
+		vars := mux.Vars(r)
+
+		assetCode, err := helpers.ParseAssetCodeParam("assetCode", vars[assetCodeKey], helpers.ParamTypeRestPath)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		fromHeight, err := helpers.ParseUint64Param(fromHeightKey, vars[fromHeightKey], helpers.ParamTypeRestPath)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		toHeight, err := helpers.ParseUint64Param(toHeightKey, vars[toHeightKey], helpers.ParamTypeRestPath)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		// send request and process response
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s/%d/%d", storeName, types.QueryRawPricesRange, assetCode, fromHeight, toHeight), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+		cliCtx = cliCtx.WithHeight(height)
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
 // GetCurPrice godoc
 // @Tags Oracle
 // @Summary Get current Price
@@ -218,3 +335,76 @@ func getAssetsHandler(cliCtx context.CLIContext, storeName string) http.HandlerF
 		rest.PostProcessResponse(w, cliCtx, res)
 	}
 }
+
+// GetOracleStats godoc
+// @Tags Oracle
+// @Summary Get oracle stats
+// @Description Get oracle posted prices stats, rewards and jailing status by oracle address
+// @ID oracleGetOracleStats
+// @Accept  json
+// @Produce json
+// @Param oracle path string true "oracle address"
+// @Success 200 {object} OracleRespGetOracleStats
+// @Failure 400 {object} rest.ErrorResponse "Returned if the request doesn't have valid query params"
+// @Failure 404 {object} rest.ErrorResponse "Returned if requested data wasn't found"
+// @Failure 500 {object} rest.ErrorResponse "Returned on server error"
+// @Router /oracle/stats/{oracle} [get]
+func getOracleStatsHandler(cliCtx context.CLIContext, storeName string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// parse inputs and prepare request
+		vars := mux.Vars(r)
+
+		address, err := helpers.ParseSdkAddressParam(oracleKey, vars[oracleKey], helpers.ParamTypeRestPath)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		// send request and process response
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", storeName, types.QueryOracleStats, address), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+		cliCtx = cliCtx.WithHeight(height)
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// GetOracleStatsList godoc
+// @Tags Oracle
+// @Summary Get all oracles stats
+// @Description Get all oracles posted prices stats, rewards and jailing status
+// @ID oracleGetOracleStatsList
+// @Accept  json
+// @Produce json
+// @Success 200 {object} OracleRespGetOracleStatsList
+// @Failure 400 {object} rest.ErrorResponse "Returned if the request doesn't have valid query params"
+// @Failure 404 {object} rest.ErrorResponse "Returned if requested data wasn't found"
+// @Failure 500 {object} rest.ErrorResponse "Returned on server error"
+// @Router /oracle/stats [get]
+func getOracleStatsListHandler(cliCtx context.CLIContext, storeName string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// parse inputs and prepare request
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		// send request and process response
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", storeName, types.QueryOracleStatsList), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+		cliCtx = cliCtx.WithHeight(height)
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
@@ -11,13 +11,28 @@ type (
 		Result []types.PostedPrice `json:"result"`
 	}
 
+	OracleRespGetRawPricesRange struct {
+		Height int64                         `json:"height"`
+		Result types.QueryRawPricesRangeResp `json:"result"`
+	}
+
 	OracleRespGetPrice struct {
-		Height int64              `json:"height"`
-		Result types.CurrentPrice `json:"result"`
+		Height int64                `json:"height"`
+		Result types.QueryPriceResp `json:"result"`
 	}
 
 	OracleRespGetAssets struct {
 		Height int64        `json:"height"`
 		Result types.Assets `json:"result"`
 	}
+
+	OracleRespGetOracleStats struct {
+		Height int64             `json:"height"`
+		Result types.OracleStats `json:"result"`
+	}
+
+	OracleRespGetOracleStatsList struct {
+		Height int64                 `json:"height"`
+		Result types.OracleStatsList `json:"result"`
+	}
 )
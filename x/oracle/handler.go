@@ -22,6 +22,8 @@ func NewHandler(k Keeper) sdk.Handler {
 			return handleMsgSetAsset(ctx, k, msg)
 		case MsgAddAsset:
 			return handleMsgAddAsset(ctx, k, msg)
+		case MsgFundRewardPool:
+			return handleMsgFundRewardPool(ctx, k, msg)
 		default:
 			return nil, sdkErrors.Wrapf(sdkErrors.ErrUnknownRequest, "unrecognized oracle message type: %T", msg)
 		}
@@ -120,3 +122,16 @@ func handleMsgAddAsset(ctx sdk.Context, k Keeper, msg MsgAddAsset) (*sdk.Result,
 
 	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
 }
+
+// handleMsgFundRewardPool handles FundRewardPool message.
+func handleMsgFundRewardPool(ctx sdk.Context, k Keeper, msg MsgFundRewardPool) (*sdk.Result, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if err := k.FundRewardPool(ctx, msg.Depositor, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
@@ -0,0 +1,167 @@
+package keeper
+
+import (
+	"sort"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	dnTypes "github.com/dfinance/dnode/helpers/types"
+	"github.com/dfinance/dnode/x/oracle/internal/types"
+)
+
+// aggregatePrice computes the asset price for the current block using the asset aggregation mode.
+// Zero price is returned if there are no RawPrices for the current block.
+func (k Keeper) aggregatePrice(ctx sdk.Context, asset types.Asset, params types.AggregationParams) (sdk.Int, time.Time) {
+	rawPrices := k.GetRawPrices(ctx, asset.AssetCode, ctx.BlockHeight())
+	if len(rawPrices) == 0 {
+		return sdk.ZeroInt(), time.Time{}
+	}
+
+	switch asset.AggregationMode() {
+	case types.AggregationMedianMAD:
+		return medianPrice(ctx, filterMADOutliers(rawPrices, params.MadThreshold))
+	case types.AggregationTWAP:
+		return k.twapPrice(ctx, asset.AssetCode, params.TwapWindow)
+	default:
+		return medianPrice(ctx, rawPrices)
+	}
+}
+
+// twapPrice computes the time-weighted average of per block median prices over the last {window} blocks.
+// Each block median is weighted by the time passed until the next block median (or the current block time).
+func (k Keeper) twapPrice(ctx sdk.Context, assetCode dnTypes.AssetCode, window uint32) (sdk.Int, time.Time) {
+	type twapPoint struct {
+		price      sdk.Int
+		receivedAt time.Time
+	}
+
+	points := make([]twapPoint, 0, window)
+	for height := ctx.BlockHeight() - int64(window) + 1; height <= ctx.BlockHeight(); height++ {
+		if height < 0 {
+			continue
+		}
+
+		rawPrices := k.GetRawPrices(ctx, assetCode, height)
+		if len(rawPrices) == 0 {
+			continue
+		}
+
+		point := twapPoint{}
+		point.price, _ = medianPrice(ctx, rawPrices)
+		for _, rawPrice := range rawPrices {
+			if rawPrice.ReceivedAt.After(point.receivedAt) {
+				point.receivedAt = rawPrice.ReceivedAt
+			}
+		}
+		points = append(points, point)
+	}
+
+	if len(points) == 0 {
+		return sdk.ZeroInt(), time.Time{}
+	}
+
+	weightedSum, weightsSum, priceSum := sdk.ZeroInt(), sdk.ZeroInt(), sdk.ZeroInt()
+	for i, point := range points {
+		periodEnd := ctx.BlockTime()
+		if i < len(points)-1 {
+			periodEnd = points[i+1].receivedAt
+		}
+
+		weight := sdk.ZeroInt()
+		if periodDur := periodEnd.Sub(point.receivedAt); periodDur > 0 {
+			weight = sdk.NewInt(periodDur.Nanoseconds())
+		}
+
+		weightedSum = weightedSum.Add(point.price.Mul(weight))
+		weightsSum = weightsSum.Add(weight)
+		priceSum = priceSum.Add(point.price)
+	}
+
+	// all timestamps are equal (or unordered): fallback to a simple average
+	if weightsSum.IsZero() {
+		return priceSum.QuoRaw(int64(len(points))), ctx.BlockTime().UTC()
+	}
+
+	return weightedSum.Quo(weightsSum), ctx.BlockTime().UTC()
+}
+
+// medianPrice computes the median of RawPrices.
+// Median receivedAt timestamp is the current block time for an even number of prices.
+func medianPrice(ctx sdk.Context, rawPrices []types.PostedPrice) (sdk.Int, time.Time) {
+	l := len(rawPrices)
+	if l == 0 {
+		return sdk.ZeroInt(), time.Time{}
+	}
+
+	// Return immediately if there's only one price
+	if l == 1 {
+		return rawPrices[0].Price, rawPrices[0].ReceivedAt
+	}
+
+	sort.Slice(rawPrices, func(i, j int) bool {
+		return rawPrices[i].Price.LT(rawPrices[j].Price)
+	})
+
+	// If there's an even number of prices
+	if l%2 == 0 {
+		// Since it's a price and not a balance, division with precision loss is OK.
+		price1 := rawPrices[l/2-1].Price
+		price2 := rawPrices[l/2].Price
+
+		return price1.Add(price2).QuoRaw(2), ctx.BlockTime().UTC()
+	}
+
+	// integer division, so we'll get an integer back, rounded down
+	return rawPrices[l/2].Price, rawPrices[l/2].ReceivedAt
+}
+
+// filterMADOutliers rejects RawPrices which deviation from the median is greater than {threshold} * MAD.
+// MAD (median absolute deviation) is meaningless for less than 3 prices, so those are returned as is.
+func filterMADOutliers(rawPrices []types.PostedPrice, threshold sdk.Dec) []types.PostedPrice {
+	if len(rawPrices) < 3 {
+		return rawPrices
+	}
+
+	prices := make([]sdk.Int, 0, len(rawPrices))
+	for _, rawPrice := range rawPrices {
+		prices = append(prices, rawPrice.Price)
+	}
+	median := medianInt(prices)
+
+	deviations := make([]sdk.Int, 0, len(rawPrices))
+	for _, price := range prices {
+		deviation := price.Sub(median)
+		if deviation.IsNegative() {
+			deviation = deviation.Neg()
+		}
+		deviations = append(deviations, deviation)
+	}
+	maxDeviation := threshold.MulInt(medianInt(deviations))
+
+	filteredPrices := make([]types.PostedPrice, 0, len(rawPrices))
+	for i, rawPrice := range rawPrices {
+		if deviations[i].ToDec().LTE(maxDeviation) {
+			filteredPrices = append(filteredPrices, rawPrice)
+		}
+	}
+
+	return filteredPrices
+}
+
+// medianInt computes the median of values without modifying the input slice.
+func medianInt(values []sdk.Int) sdk.Int {
+	sorted := make([]sdk.Int, len(values))
+	copy(sorted, values)
+
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].LT(sorted[j])
+	})
+
+	l := len(sorted)
+	if l%2 == 0 {
+		return sorted[l/2-1].Add(sorted[l/2]).QuoRaw(2)
+	}
+
+	return sorted[l/2]
+}
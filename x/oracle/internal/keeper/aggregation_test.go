@@ -0,0 +1,155 @@
+// +build unit
+
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dfinance/dnode/x/oracle/internal/types"
+)
+
+// Check filterMADOutliers function with different price sets.
+func TestOracleKeeper_FilterMADOutliers(t *testing.T) {
+	t.Parallel()
+
+	newRawPrices := func(prices ...int64) []types.PostedPrice {
+		rawPrices := make([]types.PostedPrice, 0, len(prices))
+		for _, price := range prices {
+			rawPrices = append(rawPrices, types.PostedPrice{Price: sdk.NewInt(price)})
+		}
+
+		return rawPrices
+	}
+
+	checkPrices := func(rawPrices []types.PostedPrice, expected ...int64) {
+		require.Len(t, rawPrices, len(expected))
+		for i, price := range expected {
+			require.True(t, rawPrices[i].Price.Equal(sdk.NewInt(price)), "price [%d]: %s / %d", i, rawPrices[i].Price, price)
+		}
+	}
+
+	threshold := sdk.NewDec(3)
+
+	// less than 3 prices are not filtered
+	{
+		checkPrices(filterMADOutliers(newRawPrices(100, 1000), threshold), 100, 1000)
+	}
+
+	// outlier rejected
+	{
+		checkPrices(filterMADOutliers(newRawPrices(100, 1000, 102, 104), threshold), 100, 102, 104)
+	}
+
+	// no outliers
+	{
+		checkPrices(filterMADOutliers(newRawPrices(100, 110, 120, 130), threshold), 100, 110, 120, 130)
+	}
+
+	// zero MAD: only prices equal to the median are kept
+	{
+		checkPrices(filterMADOutliers(newRawPrices(100, 100, 100, 101), threshold), 100, 100, 100)
+	}
+}
+
+// Check SetCurrentPrices method with median_mad aggregation mode.
+func TestOracleKeeper_SetCurrentPrices_MedianMAD(t *testing.T) {
+	t.Parallel()
+
+	input := NewTestInput(t)
+	keeper := input.keeper
+	ctx := input.ctx.WithBlockHeight(1).WithBlockTime(time.Now().UTC())
+
+	postPrices := func(ctx sdk.Context, prices ...int64) {
+		for i, price := range prices {
+			_, err := keeper.SetPrice(ctx, input.addresses[i], input.stdAssetCode, sdk.NewInt(price), ctx.BlockTime())
+			require.NoError(t, err)
+		}
+	}
+
+	// median mode: outlier affects the price
+	{
+		postPrices(ctx, 100, 1000, 102, 104)
+		require.NoError(t, keeper.SetCurrentPrices(ctx))
+
+		price := keeper.GetCurrentPrice(ctx, input.stdAssetCode)
+		require.True(t, price.Price.Equal(sdk.NewInt(103)), "price: %s", price.Price)
+	}
+
+	// median_mad mode: outlier rejected
+	{
+		asset, found := keeper.GetAsset(ctx, input.stdAssetCode)
+		require.True(t, found)
+		asset.Aggregation = types.AggregationMedianMAD
+		require.NoError(t, keeper.SetAsset(ctx, input.stdNominee, asset))
+
+		ctx = ctx.WithBlockHeight(2)
+		postPrices(ctx, 100, 1000, 102, 104)
+		require.NoError(t, keeper.SetCurrentPrices(ctx))
+
+		price := keeper.GetCurrentPrice(ctx, input.stdAssetCode)
+		require.True(t, price.Price.Equal(sdk.NewInt(102)), "price: %s", price.Price)
+		require.Equal(t, ctx.BlockTime(), price.ReceivedAt)
+	}
+}
+
+// Check SetCurrentPrices method with twap aggregation mode.
+func TestOracleKeeper_SetCurrentPrices_TWAP(t *testing.T) {
+	t.Parallel()
+
+	input := NewTestInput(t)
+	keeper := input.keeper
+	ctx := input.ctx
+
+	params := keeper.GetParams(ctx)
+	params.Assets[0].Aggregation = types.AggregationTWAP
+	params.Aggregation.TwapWindow = 3
+	keeper.SetParams(ctx, params)
+
+	startTime := time.Now().UTC()
+	postPrice := func(height int64, price int64, receivedAtOffset, blockTimeOffset time.Duration) sdk.Context {
+		blockCtx := ctx.WithBlockHeight(height).WithBlockTime(startTime.Add(blockTimeOffset))
+		_, err := keeper.SetPrice(blockCtx, input.addresses[0], input.stdAssetCode, sdk.NewInt(price), startTime.Add(receivedAtOffset))
+		require.NoError(t, err)
+		require.NoError(t, keeper.SetCurrentPrices(blockCtx))
+
+		return blockCtx
+	}
+
+	// single block: price as is
+	{
+		blockCtx := postPrice(1, 100, 0, 5*time.Second)
+
+		price := keeper.GetCurrentPrice(blockCtx, input.stdAssetCode)
+		require.True(t, price.Price.Equal(sdk.NewInt(100)), "price: %s", price.Price)
+	}
+
+	// no prices posted: current price is not updated
+	{
+		blockCtx := ctx.WithBlockHeight(2).WithBlockTime(startTime.Add(10 * time.Second))
+		require.NoError(t, keeper.SetCurrentPrices(blockCtx))
+
+		price := keeper.GetCurrentPrice(blockCtx, input.stdAssetCode)
+		require.True(t, price.Price.Equal(sdk.NewInt(100)), "price: %s", price.Price)
+	}
+
+	// window is filled: (100 * 30s + 400 * 10s) / 40s
+	{
+		blockCtx := postPrice(3, 400, 30*time.Second, 40*time.Second)
+
+		price := keeper.GetCurrentPrice(blockCtx, input.stdAssetCode)
+		require.True(t, price.Price.Equal(sdk.NewInt(175)), "price: %s", price.Price)
+		require.Equal(t, blockCtx.BlockTime(), price.ReceivedAt)
+	}
+
+	// window moved (block 1 is excluded): (400 * 20s + 200 * 10s) / 30s
+	{
+		blockCtx := postPrice(4, 200, 50*time.Second, 60*time.Second)
+
+		price := keeper.GetCurrentPrice(blockCtx, input.stdAssetCode)
+		require.True(t, price.Price.Equal(sdk.NewInt(333)), "price: %s", price.Price)
+	}
+}
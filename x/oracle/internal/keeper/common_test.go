@@ -67,6 +67,8 @@ func NewTestInput(t *testing.T) TestInput {
 	// register codec
 	sdk.RegisterCodec(input.cdc)
 	codec.RegisterCrypto(input.cdc)
+	auth.RegisterCodec(input.cdc)
+	supply.RegisterCodec(input.cdc)
 
 	// init in-memory DB
 	db := dbm.NewMemDB()
@@ -84,7 +86,8 @@ func NewTestInput(t *testing.T) TestInput {
 	input.paramsKeeper = params.NewKeeper(input.cdc, input.keyParams, input.tKeyParams)
 	input.accountKeeper = auth.NewAccountKeeper(input.cdc, input.keyAccount, input.paramsKeeper.Subspace(auth.DefaultParamspace), auth.ProtoBaseAccount)
 	input.bankKeeper = bank.NewBaseKeeper(input.accountKeeper, input.paramsKeeper.Subspace(bank.DefaultParamspace), tests.ModuleAccountAddrs())
-	input.keeper = NewKeeper(input.cdc, input.keyOracle, input.paramsKeeper.Subspace(types.DefaultParamspace), input.vmStorage)
+	input.supplyKeeper = supply.NewKeeper(input.cdc, input.keySupply, input.accountKeeper, input.bankKeeper, tests.MAccPerms)
+	input.keeper = NewKeeper(input.cdc, input.keyOracle, input.paramsKeeper.Subspace(types.DefaultParamspace), input.vmStorage, input.supplyKeeper)
 
 	// create context
 	input.ctx = sdk.NewContext(mstore, abci.Header{ChainID: "test-chain-id"}, false, log.NewNopLogger())
@@ -110,6 +113,8 @@ func NewTestInput(t *testing.T) TestInput {
 		PostPrice: types.PostPriceParams{
 			ReceivedAtDiffInS: 60 * 60,
 		},
+		Aggregation: types.DefaultAggregationParams(),
+		Rewards:     types.DefaultRewardParams(),
 	}
 
 	input.keeper.SetParams(input.ctx, params)
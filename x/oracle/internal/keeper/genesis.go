@@ -28,6 +28,10 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data json.RawMessage) {
 		}
 		k.addCurrentPrice(ctx, cPrice)
 	}
+
+	for _, stats := range state.OracleStats {
+		k.setOracleStats(ctx, stats)
+	}
 }
 
 // ExportGenesis exports module genesis state using current params state.
@@ -42,6 +46,7 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) json.RawMessage {
 	state := types.GenesisState{
 		Params:        k.GetParams(ctx),
 		CurrentPrices: currentPrices,
+		OracleStats:   k.GetOracleStatsList(ctx),
 	}
 
 	return k.cdc.MustMarshalJSON(state)
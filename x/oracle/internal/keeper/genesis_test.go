@@ -83,9 +83,13 @@ func TestOracleKeeper_Genesis_Init(t *testing.T) {
 			NewMockCurrentPrice("usdt_xfi", 400),
 		}
 
+		oracleStats := types.NewOracleStats(input.addresses[0])
+		oracleStats.Total.Posts, oracleStats.JailedUntil = 10, 5
+
 		state := types.GenesisState{
 			Params:        params,
 			CurrentPrices: cpList,
+			OracleStats:   types.OracleStatsList{oracleStats},
 		}
 
 		// initialize and check current state with init values
@@ -107,6 +111,10 @@ func TestOracleKeeper_Genesis_Init(t *testing.T) {
 		require.Equal(t, exportedState.Params.Nominees, params.Nominees)
 		require.Equal(t, exportedState.Params.PostPrice, params.PostPrice)
 		require.Equal(t, len(exportedState.CurrentPrices), len(state.CurrentPrices))
+		require.Len(t, exportedState.OracleStats, 1)
+		require.True(t, exportedState.OracleStats[0].Address.Equals(oracleStats.Address))
+		require.EqualValues(t, 10, exportedState.OracleStats[0].Total.Posts)
+		require.EqualValues(t, 5, exportedState.OracleStats[0].JailedUntil)
 
 		// checking all of items existing in the export
 		sumPrices := sdk.NewIntFromUint64(0)
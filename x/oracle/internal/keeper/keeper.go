@@ -7,6 +7,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/supply"
 
 	"github.com/dfinance/dnode/helpers/perms"
 	"github.com/dfinance/dnode/x/common_vm"
@@ -15,11 +16,12 @@ import (
 
 // Keeper struct for oracle module
 type Keeper struct {
-	storeKey    sdk.StoreKey        // The keys used to access the stores from Context
-	cdc         *codec.Codec        // Codec for binary encoding/decoding
-	paramstore  params.Subspace     // The reference to the Paramstore to get and set oracle specific params
-	vmKeeper    common_vm.VMStorage // Virtual machine keeper
-	modulePerms perms.ModulePermissions
+	storeKey     sdk.StoreKey        // The keys used to access the stores from Context
+	cdc          *codec.Codec        // Codec for binary encoding/decoding
+	paramstore   params.Subspace     // The reference to the Paramstore to get and set oracle specific params
+	vmKeeper     common_vm.VMStorage // Virtual machine keeper
+	supplyKeeper supply.Keeper       // Supply keeper to pay oracles rewards
+	modulePerms  perms.ModulePermissions
 }
 
 // IsNominee checks is nominee exist in the keeper params.
@@ -42,14 +44,16 @@ func NewKeeper(
 	storeKey sdk.StoreKey,
 	paramStore params.Subspace,
 	vmKeeper common_vm.VMStorage,
+	supplyKeeper supply.Keeper,
 	permsRequesters ...perms.RequestModulePermissions,
 ) Keeper {
 	k := Keeper{
-		cdc:         cdc,
-		storeKey:    storeKey,
-		paramstore:  paramStore.WithKeyTable(types.ParamKeyTable()),
-		vmKeeper:    vmKeeper,
-		modulePerms: types.NewModulePerms(),
+		cdc:          cdc,
+		storeKey:     storeKey,
+		paramstore:   paramStore.WithKeyTable(types.ParamKeyTable()),
+		vmKeeper:     vmKeeper,
+		supplyKeeper: supplyKeeper,
+		modulePerms:  types.NewModulePerms(),
 	}
 	for _, requester := range permsRequesters {
 		k.modulePerms.AutoAddRequester(requester)
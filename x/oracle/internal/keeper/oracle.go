@@ -87,6 +87,9 @@ func (k Keeper) SetOracles(ctx sdk.Context, nominee string, assetCode dnTypes.As
 	for _, a := range assets {
 		if assetCode == a.AssetCode {
 			a.Oracles = addresses
+			if err := a.ValidateBasic(); err != nil {
+				return err
+			}
 			found = true
 		}
 		updateAssets = append(updateAssets, a)
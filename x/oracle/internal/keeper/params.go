@@ -10,7 +10,7 @@ import (
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 	k.modulePerms.AutoCheck(types.PermRead)
 
-	return types.NewParams(k.GetAssetParams(ctx), k.GetNomineeParams(ctx), k.GetPostPriceParams(ctx))
+	return types.NewParams(k.GetAssetParams(ctx), k.GetNomineeParams(ctx), k.GetPostPriceParams(ctx), k.GetAggregationParams(ctx), k.GetRawPricesParams(ctx), k.GetRewardParams(ctx))
 }
 
 // SetParams updates params in the store.
@@ -49,3 +49,33 @@ func (k Keeper) GetPostPriceParams(ctx sdk.Context) types.PostPriceParams {
 
 	return params
 }
+
+// GetAggregationParams get CurrentPrice aggregation params from store.
+func (k Keeper) GetAggregationParams(ctx sdk.Context) types.AggregationParams {
+	k.modulePerms.AutoCheck(types.PermRead)
+
+	params := types.AggregationParams{}
+	k.paramstore.Get(ctx, types.KeyAggregation, &params)
+
+	return params
+}
+
+// GetRawPricesParams get RawPrices storage params from store.
+func (k Keeper) GetRawPricesParams(ctx sdk.Context) types.RawPricesParams {
+	k.modulePerms.AutoCheck(types.PermRead)
+
+	params := types.RawPricesParams{}
+	k.paramstore.Get(ctx, types.KeyRawPrices, &params)
+
+	return params
+}
+
+// GetRewardParams get oracles rewards and jailing params from store.
+func (k Keeper) GetRewardParams(ctx sdk.Context) types.RewardParams {
+	k.modulePerms.AutoCheck(types.PermRead)
+
+	params := types.RewardParams{}
+	k.paramstore.Get(ctx, types.KeyRewards, &params)
+
+	return params
+}
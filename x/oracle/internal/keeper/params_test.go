@@ -5,6 +5,7 @@ package keeper
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
 	dnTypes "github.com/dfinance/dnode/helpers/types"
@@ -28,10 +29,20 @@ func TestOracleKeeper_Params(t *testing.T) {
 
 	postPriceMock := types.PostPriceParams{ReceivedAtDiffInS: 100}
 
+	aggregationMock := types.AggregationParams{MadThreshold: sdk.NewDecWithPrec(25, 1), TwapWindow: 5}
+
+	rawPricesMock := types.RawPricesParams{RetentionBlocks: 10, PruneLimit: 2}
+
+	rewardsMock := types.DefaultRewardParams()
+	rewardsMock.EpochReward = sdk.NewCoins(sdk.NewInt64Coin("xfi", 10))
+
 	paramsMock := types.Params{
-		Assets:    assetsMock,
-		Nominees:  nomineesMock,
-		PostPrice: postPriceMock,
+		Assets:      assetsMock,
+		Nominees:    nomineesMock,
+		PostPrice:   postPriceMock,
+		Aggregation: aggregationMock,
+		RawPrices:   rawPricesMock,
+		Rewards:     rewardsMock,
 	}
 
 	keeper.SetParams(ctx, paramsMock)
@@ -56,6 +67,24 @@ func TestOracleKeeper_Params(t *testing.T) {
 		require.Equal(t, priceParam, postPriceMock)
 	}
 
+	// check GetAggregationParams
+	{
+		aggregationParams := keeper.GetAggregationParams(ctx)
+		require.Equal(t, aggregationParams, aggregationMock)
+	}
+
+	// check GetRawPricesParams
+	{
+		rawPricesParams := keeper.GetRawPricesParams(ctx)
+		require.Equal(t, rawPricesParams, rawPricesMock)
+	}
+
+	// check GetRewardParams
+	{
+		rewardParams := keeper.GetRewardParams(ctx)
+		require.Equal(t, rewardParams.String(), rewardsMock.String())
+	}
+
 	// check GetAllParams
 	{
 		params := keeper.GetParams(ctx)
@@ -63,5 +92,7 @@ func TestOracleKeeper_Params(t *testing.T) {
 		require.Equal(t, params.Assets[0].Oracles, types.Oracles(types.Oracles(nil)))
 		require.Equal(t, params.Nominees, nomineesMock)
 		require.Equal(t, params.PostPrice, postPriceMock)
+		require.Equal(t, params.Aggregation, aggregationMock)
+		require.Equal(t, params.RawPrices, rawPricesMock)
 	}
 }
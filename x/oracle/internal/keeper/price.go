@@ -2,7 +2,6 @@ package keeper
 
 import (
 	"fmt"
-	"sort"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -57,69 +56,53 @@ func (k Keeper) addCurrentPrice(ctx sdk.Context, currentPrice types.CurrentPrice
 	store.Set(types.GetCurrentPriceKey(currentPrice.AssetCode), bz)
 }
 
-// SetCurrentPrices updates the price of an asset to the median of all valid oracle inputs and cleans up previous inputs.
+// SetCurrentPrices updates the price of an asset aggregating all valid oracle inputs (algorithm depends on asset aggregation mode).
+// Price is not updated if the asset quorum is not reached.
 func (k Keeper) SetCurrentPrices(ctx sdk.Context) error {
 	k.modulePerms.AutoCheck(types.PermWrite)
 
 	assets := k.GetAssetParams(ctx)
+	aggregationParams := k.GetAggregationParams(ctx)
 
 	updatesCnt := 0
 	for _, v := range assets {
 		assetCode := v.AssetCode
-		rawPrices := k.GetRawPrices(ctx, assetCode, ctx.BlockHeight())
-
-		l := len(rawPrices)
-		var medianPrice sdk.Int
-		var medianReceivedAt time.Time
-		// TODO make threshold for acceptance (ie. require 51% of oracles to have posted valid prices
-		if l == 0 {
-			// Error if there are no valid prices in the raw oracle
-			//return types.ErrNoValidPrice(k.codespace)
-			medianPrice = sdk.ZeroInt()
-		} else if l == 1 {
-			// Return immediately if there's only one price
-			medianPrice, medianReceivedAt = rawPrices[0].Price, rawPrices[0].ReceivedAt
-		} else {
-			// sort the prices
-			sort.Slice(rawPrices, func(i, j int) bool {
-				return rawPrices[i].Price.LT(rawPrices[j].Price)
-			})
-			// If there's an even number of prices
-			if l%2 == 0 {
-				// TODO make sure this is safe.
-				// Since it's a price and not a balance, division with precision loss is OK.
-				price1 := rawPrices[l/2-1].Price
-				price2 := rawPrices[l/2].Price
-				sum := price1.Add(price2)
-				divsor := sdk.NewInt(2)
-				medianPrice = sum.Quo(divsor)
-				medianReceivedAt = ctx.BlockTime().UTC()
-			} else {
-				// integer division, so we'll get an integer back, rounded down
-				medianPrice, medianReceivedAt = rawPrices[l/2].Price, rawPrices[l/2].ReceivedAt
-			}
+
+		// check enough oracles have posted prices within the current block
+		quorum := k.checkPriceQuorum(ctx, v)
+		if quorum.Posted == 0 {
+			continue
 		}
+		k.setPriceQuorum(ctx, assetCode, quorum)
 
-		// check if there is no rawPrices or medianPrice is invalid
-		if medianPrice.IsZero() {
+		if !quorum.Reached {
+			ctx.EventManager().EmitEvent(types.NewPriceQuorumStaleEvent(assetCode, quorum))
 			continue
 		}
 
-		// check new price for the asset appeared, no need to update after every block
-		oldPrice := k.GetCurrentPrice(ctx, assetCode)
-		if oldPrice.AssetCode != "" && oldPrice.Price.Equal(medianPrice) {
+		aggPrice, aggReceivedAt := k.aggregatePrice(ctx, v, aggregationParams)
+
+		// check if there is no rawPrices or aggPrice is invalid
+		if aggPrice.IsZero() {
 			continue
 		}
 
-		// set the new price for the asset
+		// set the new price for the asset (receivedAt / updatedAt are refreshed even if the price is not changed to keep it fresh)
+		oldPrice := k.GetCurrentPrice(ctx, assetCode)
 		newPrice := types.CurrentPrice{
 			AssetCode:  assetCode,
-			Price:      medianPrice,
-			ReceivedAt: medianReceivedAt,
+			Price:      aggPrice,
+			ReceivedAt: aggReceivedAt,
+			UpdatedAt:  ctx.BlockTime(),
 		}
 
 		k.addCurrentPrice(ctx, newPrice)
 
+		// check new price for the asset appeared (or the stale one was removed from VM), no need to update after every block
+		if oldPrice.AssetCode != "" && oldPrice.Price.Equal(aggPrice) && !oldPrice.Stale {
+			continue
+		}
+
 		// save price to VM storage
 		priceVmAccessPath, priceVmValue := types.NewResPriceStorageValuesPanic(newPrice.AssetCode, newPrice.Price)
 		k.vmKeeper.SetValue(ctx, priceVmAccessPath, priceVmValue)
@@ -136,6 +119,76 @@ func (k Keeper) SetCurrentPrices(ctx sdk.Context) error {
 	return nil
 }
 
+// CheckPricesStaleness marks CurrentPrices older than the asset max age (Asset.MaxPriceAgeInS) as stale.
+// Stale price is removed from the VM storage, so VM scripts can't use it until a new price is set.
+func (k Keeper) CheckPricesStaleness(ctx sdk.Context) {
+	k.modulePerms.AutoCheck(types.PermWrite)
+
+	staleCnt := 0
+	for _, asset := range k.GetAssetParams(ctx) {
+		price := k.GetCurrentPrice(ctx, asset.AssetCode)
+		if price.AssetCode == "" || price.Stale || !asset.IsPriceStale(price, ctx.BlockTime()) {
+			continue
+		}
+
+		price.Stale = true
+		k.addCurrentPrice(ctx, price)
+
+		// remove price from VM storage
+		priceVmAccessPath, err := types.GetAssetCodePath(price.AssetCode)
+		if err != nil {
+			panic(err)
+		}
+		k.vmKeeper.DelValue(ctx, priceVmAccessPath)
+
+		// emit event
+		staleCnt++
+		ctx.EventManager().EmitEvent(types.NewPriceMaxAgeStaleEvent(price))
+	}
+
+	if staleCnt > 0 {
+		ctx.EventManager().EmitEvent(dnTypes.NewModuleNameEvent(types.ModuleName))
+	}
+}
+
+// GetPriceQuorum returns the last CurrentPrice update quorum status for a specific asset.
+func (k Keeper) GetPriceQuorum(ctx sdk.Context, assetCode dnTypes.AssetCode) types.PriceQuorum {
+	k.modulePerms.AutoCheck(types.PermRead)
+
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetPriceQuorumKey(assetCode))
+	if bz == nil {
+		return types.PriceQuorum{}
+	}
+
+	var quorum types.PriceQuorum
+	k.cdc.MustUnmarshalBinaryBare(bz, &quorum)
+
+	return quorum
+}
+
+// setPriceQuorum sets the CurrentPrice update quorum status for a specific asset.
+func (k Keeper) setPriceQuorum(ctx sdk.Context, assetCode dnTypes.AssetCode, quorum types.PriceQuorum) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetPriceQuorumKey(assetCode), k.cdc.MustMarshalBinaryBare(quorum))
+}
+
+// checkPriceQuorum compares the number of prices posted within the current block to the asset quorum.
+// Only asset oracles are allowed to post prices (checked by the handler), so every RawPrice is counted.
+// Jailed asset oracles are excluded from the quorum fraction base (refer to Asset.QuorumRequired).
+func (k Keeper) checkPriceQuorum(ctx sdk.Context, asset types.Asset) types.PriceQuorum {
+	posted := len(k.GetRawPrices(ctx, asset.AssetCode, ctx.BlockHeight()))
+
+	activeOracles := 0
+	for _, oracle := range asset.Oracles {
+		if stats, _ := k.GetOracleStats(ctx, oracle.Address); !stats.IsJailed(ctx.BlockHeight()) {
+			activeOracles++
+		}
+	}
+
+	return types.NewPriceQuorum(ctx.BlockHeight(), uint32(posted), asset.QuorumRequired(activeOracles))
+}
+
 // GetRawPrices fetches the set of all prices posted by oracles for an asset and specific blockHeight.
 func (k Keeper) GetRawPrices(ctx sdk.Context, assetCode dnTypes.AssetCode, blockHeight int64) []types.PostedPrice {
 	k.modulePerms.AutoCheck(types.PermRead)
@@ -207,6 +260,9 @@ func (k Keeper) ValidatePostPrice(ctx sdk.Context, msg types.MsgPostPrice) error
 	if err != nil {
 		return sdkErrors.Wrap(types.ErrInvalidOracle, msg.From.String())
 	}
+	if stats, _ := k.GetOracleStats(ctx, msg.From); stats.IsJailed(ctx.BlockHeight()) {
+		return sdkErrors.Wrapf(types.ErrOracleJailed, "%s: until block %d", msg.From, stats.JailedUntil)
+	}
 
 	return nil
 }
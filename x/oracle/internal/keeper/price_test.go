@@ -125,6 +125,237 @@ func TestOracleKeeper_GetRawPrice(t *testing.T) {
 	}
 }
 
+// Check SetCurrentPrices method with the asset quorum.
+func TestOracleKeeper_SetCurrentPrices_Quorum(t *testing.T) {
+	t.Parallel()
+
+	input := NewTestInput(t)
+	keeper := input.keeper
+	ctx := input.ctx.WithBlockTime(time.Now().UTC())
+
+	asset, found := keeper.GetAsset(ctx, input.stdAssetCode)
+	require.True(t, found)
+	for i := 0; i < 4; i++ {
+		asset.Oracles = append(asset.Oracles, types.NewOracle(input.addresses[i]))
+	}
+	asset.QuorumFraction = sdk.NewDecWithPrec(51, 2)
+	require.NoError(t, keeper.SetAsset(ctx, input.stdNominee, asset))
+
+	postPrices := func(ctx sdk.Context, prices ...int64) {
+		for i, price := range prices {
+			_, err := keeper.SetPrice(ctx, input.addresses[i], input.stdAssetCode, sdk.NewInt(price), ctx.BlockTime())
+			require.NoError(t, err)
+		}
+	}
+
+	checkStaleEvent := func(ctx sdk.Context, expected bool) {
+		found := false
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == types.EventTypePriceStale {
+				found = true
+			}
+		}
+		require.Equal(t, expected, found)
+	}
+
+	// quorum is reached: 3 of 4 oracles (0.51 fraction)
+	{
+		ctx := ctx.WithBlockHeight(1).WithEventManager(sdk.NewEventManager())
+		postPrices(ctx, 100, 101, 102)
+		require.NoError(t, keeper.SetCurrentPrices(ctx))
+
+		price := keeper.GetCurrentPrice(ctx, input.stdAssetCode)
+		require.True(t, price.Price.Equal(sdk.NewInt(101)), "price: %s", price.Price)
+		require.Equal(t, types.NewPriceQuorum(1, 3, 3), keeper.GetPriceQuorum(ctx, input.stdAssetCode))
+		checkStaleEvent(ctx, false)
+	}
+
+	// quorum is not reached: price is kept
+	{
+		ctx := ctx.WithBlockHeight(2).WithEventManager(sdk.NewEventManager())
+		postPrices(ctx, 200, 201)
+		require.NoError(t, keeper.SetCurrentPrices(ctx))
+
+		price := keeper.GetCurrentPrice(ctx, input.stdAssetCode)
+		require.True(t, price.Price.Equal(sdk.NewInt(101)), "price: %s", price.Price)
+		quorum := keeper.GetPriceQuorum(ctx, input.stdAssetCode)
+		require.Equal(t, types.NewPriceQuorum(2, 2, 3), quorum)
+		require.False(t, quorum.Reached)
+		checkStaleEvent(ctx, true)
+	}
+
+	// absolute quorum is greater than the fraction one
+	{
+		asset.QuorumMin = 4
+		require.NoError(t, keeper.SetAsset(ctx, input.stdNominee, asset))
+
+		ctx := ctx.WithBlockHeight(3).WithEventManager(sdk.NewEventManager())
+		postPrices(ctx, 300, 301, 302)
+		require.NoError(t, keeper.SetCurrentPrices(ctx))
+
+		price := keeper.GetCurrentPrice(ctx, input.stdAssetCode)
+		require.True(t, price.Price.Equal(sdk.NewInt(101)), "price: %s", price.Price)
+		require.Equal(t, types.NewPriceQuorum(3, 3, 4), keeper.GetPriceQuorum(ctx, input.stdAssetCode))
+		checkStaleEvent(ctx, true)
+	}
+
+	// no prices posted: quorum status is not changed
+	{
+		ctx := ctx.WithBlockHeight(4).WithEventManager(sdk.NewEventManager())
+		require.NoError(t, keeper.SetCurrentPrices(ctx))
+
+		require.Equal(t, types.NewPriceQuorum(3, 3, 4), keeper.GetPriceQuorum(ctx, input.stdAssetCode))
+		checkStaleEvent(ctx, false)
+	}
+
+	// jailed oracles are excluded from the fraction quorum: 2 of 2 not jailed oracles
+	{
+		asset.QuorumMin = 0
+		require.NoError(t, keeper.SetAsset(ctx, input.stdNominee, asset))
+		for _, idx := range []int{2, 3} {
+			stats := types.NewOracleStats(input.addresses[idx])
+			stats.JailedUntil = 10
+			keeper.setOracleStats(ctx, stats)
+		}
+
+		ctx := ctx.WithBlockHeight(5).WithEventManager(sdk.NewEventManager())
+		postPrices(ctx, 500, 502)
+		require.NoError(t, keeper.SetCurrentPrices(ctx))
+
+		price := keeper.GetCurrentPrice(ctx, input.stdAssetCode)
+		require.True(t, price.Price.Equal(sdk.NewInt(501)), "price: %s", price.Price)
+		require.Equal(t, types.NewPriceQuorum(5, 2, 2), keeper.GetPriceQuorum(ctx, input.stdAssetCode))
+	}
+
+	// absolute quorum can't exceed the number of oracles
+	{
+		asset.QuorumMin = 2
+		require.NoError(t, keeper.SetAsset(ctx, input.stdNominee, asset))
+		require.Error(t, keeper.SetOracles(ctx, input.stdNominee, input.stdAssetCode, asset.Oracles[:1]))
+		require.NoError(t, keeper.SetOracles(ctx, input.stdNominee, input.stdAssetCode, asset.Oracles[:2]))
+	}
+}
+
+// Check CheckPricesStaleness method and stale price refresh.
+func TestOracleKeeper_CheckPricesStaleness(t *testing.T) {
+	t.Parallel()
+
+	input := NewTestInput(t)
+	keeper := input.keeper
+	startTime := time.Now().UTC()
+
+	asset, found := keeper.GetAsset(input.ctx, input.stdAssetCode)
+	require.True(t, found)
+	asset.MaxPriceAgeInS = 60
+	require.NoError(t, keeper.SetAsset(input.ctx, input.stdNominee, asset))
+
+	vmPath, err := types.GetAssetCodePath(input.stdAssetCode)
+	require.NoError(t, err)
+
+	newBlockCtx := func(height int64, blockTimeOffset time.Duration) sdk.Context {
+		return input.ctx.WithBlockHeight(height).WithBlockTime(startTime.Add(blockTimeOffset)).WithEventManager(sdk.NewEventManager())
+	}
+
+	postPrice := func(ctx sdk.Context, price int64) {
+		_, err := keeper.SetPrice(ctx, input.addresses[0], input.stdAssetCode, sdk.NewInt(price), ctx.BlockTime())
+		require.NoError(t, err)
+		require.NoError(t, keeper.SetCurrentPrices(ctx))
+		keeper.CheckPricesStaleness(ctx)
+	}
+
+	checkStaleEvent := func(ctx sdk.Context, expected bool) {
+		found := false
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == types.EventTypePriceStale {
+				found = true
+			}
+		}
+		require.Equal(t, expected, found)
+	}
+
+	// fresh price
+	{
+		ctx := newBlockCtx(1, 0)
+		postPrice(ctx, 100)
+
+		price := keeper.GetCurrentPrice(ctx, input.stdAssetCode)
+		require.False(t, price.Stale)
+		require.True(t, input.vmStorage.HasValue(ctx, vmPath))
+	}
+
+	// price is not too old yet
+	{
+		ctx := newBlockCtx(2, 60*time.Second)
+		keeper.CheckPricesStaleness(ctx)
+
+		price := keeper.GetCurrentPrice(ctx, input.stdAssetCode)
+		require.False(t, price.Stale)
+		require.True(t, input.vmStorage.HasValue(ctx, vmPath))
+		checkStaleEvent(ctx, false)
+	}
+
+	// unchanged price refreshes receivedAt
+	{
+		ctx := newBlockCtx(3, 50*time.Second)
+		postPrice(ctx, 100)
+
+		price := keeper.GetCurrentPrice(ctx, input.stdAssetCode)
+		require.Equal(t, ctx.BlockTime(), price.ReceivedAt)
+	}
+
+	// price is stale: removed from VM
+	{
+		ctx := newBlockCtx(4, 111*time.Second)
+		keeper.CheckPricesStaleness(ctx)
+
+		price := keeper.GetCurrentPrice(ctx, input.stdAssetCode)
+		require.True(t, price.Stale)
+		require.True(t, price.Price.Equal(sdk.NewInt(100)))
+		require.False(t, input.vmStorage.HasValue(ctx, vmPath))
+		checkStaleEvent(ctx, true)
+	}
+
+	// stale price is not marked twice
+	{
+		ctx := newBlockCtx(5, 120*time.Second)
+		keeper.CheckPricesStaleness(ctx)
+		checkStaleEvent(ctx, false)
+	}
+
+	// the same price is posted: price is fresh and returned to VM
+	{
+		ctx := newBlockCtx(6, 130*time.Second)
+		postPrice(ctx, 100)
+
+		price := keeper.GetCurrentPrice(ctx, input.stdAssetCode)
+		require.False(t, price.Stale)
+		require.True(t, input.vmStorage.HasValue(ctx, vmPath))
+	}
+
+	// oracle receivedAt is older than the max age (within ReceivedAtDiffInS): price age is measured from the update block
+	{
+		ctx := newBlockCtx(7, 300*time.Second)
+		_, err := keeper.SetPrice(ctx, input.addresses[0], input.stdAssetCode, sdk.NewInt(200), ctx.BlockTime().Add(-120*time.Second))
+		require.NoError(t, err)
+		require.NoError(t, keeper.SetCurrentPrices(ctx))
+		keeper.CheckPricesStaleness(ctx)
+
+		price := keeper.GetCurrentPrice(ctx, input.stdAssetCode)
+		require.True(t, price.Price.Equal(sdk.NewInt(200)))
+		require.Equal(t, ctx.BlockTime().Add(-120*time.Second), price.ReceivedAt)
+		require.Equal(t, ctx.BlockTime(), price.UpdatedAt)
+		require.False(t, price.Stale)
+		require.True(t, input.vmStorage.HasValue(ctx, vmPath))
+		checkStaleEvent(ctx, false)
+
+		// the next block: price is still fresh
+		ctx = newBlockCtx(8, 305*time.Second)
+		keeper.CheckPricesStaleness(ctx)
+		require.False(t, keeper.GetCurrentPrice(ctx, input.stdAssetCode).Stale)
+		checkStaleEvent(ctx, false)
+	}
+}
+
 // Check CurrentPrice method and finding average price for different numbers of oracles.
 func TestOracleKeeper_CurrentPrice(t *testing.T) {
 	t.Parallel()
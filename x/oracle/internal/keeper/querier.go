@@ -20,23 +20,30 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return queryCurrentPrice(ctx, path[1:], req, keeper)
 		case types.QueryRawPrices:
 			return queryRawPrices(ctx, path[1:], req, keeper)
+		case types.QueryRawPricesRange:
+			return queryRawPricesRange(ctx, path[1:], req, keeper)
 		case types.QueryAssets:
 			return queryAssets(ctx, req, keeper)
+		case types.QueryOracleStats:
+			return queryOracleStats(ctx, path[1:], req, keeper)
+		case types.QueryOracleStatsList:
+			return queryOracleStatsList(ctx, req, keeper)
 		default:
 			return nil, sdkErrors.Wrap(sdkErrors.ErrUnknownRequest, "unknown oracle query endpoint")
 		}
 	}
 }
 
-// queryCurrentPrice handles currentPrice query. Takes an [assetCode] and returns CurrentPrice for that asset.
+// queryCurrentPrice handles currentPrice query. Takes an [assetCode] and returns CurrentPrice with the quorum status for that asset.
 func queryCurrentPrice(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
 	assetCode := dnTypes.AssetCode(path[0])
 	if _, found := keeper.GetAsset(ctx, assetCode); !found {
 		return []byte{}, sdkErrors.Wrap(sdkErrors.ErrUnknownRequest, "asset not found")
 	}
 	currentPrice := keeper.GetCurrentPrice(ctx, assetCode)
+	quorum := keeper.GetPriceQuorum(ctx, assetCode)
 
-	bz, err := codec.MarshalJSONIndent(keeper.cdc, currentPrice)
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, types.NewQueryPriceResp(currentPrice, quorum))
 	if err != nil {
 		return nil, sdkErrors.Wrapf(types.ErrInternal, "currentPrice marshal: %v", err)
 	}
@@ -65,6 +72,44 @@ func queryRawPrices(ctx sdk.Context, path []string, req abci.RequestQuery, keepe
 	return bz, nil
 }
 
+// queryRawPricesRange handles rawPrices range query. Takes an [assetCode], [fromHeight] and [toHeight],
+// then returns the raw []PostedPrice for that asset per block. Range must be within the RawPrices retention window.
+func queryRawPricesRange(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	assetCode := dnTypes.AssetCode(path[0])
+	if _, found := keeper.GetAsset(ctx, assetCode); !found {
+		return []byte{}, sdkErrors.Wrap(sdkErrors.ErrUnknownRequest, "asset not found")
+	}
+
+	fromHeight, err := strconv.ParseInt(path[1], 10, 64)
+	if err != nil {
+		return []byte{}, sdkErrors.Wrapf(sdkErrors.ErrUnknownRequest, "invalid fromHeight: %v", err)
+	}
+	toHeight, err := strconv.ParseInt(path[2], 10, 64)
+	if err != nil {
+		return []byte{}, sdkErrors.Wrapf(sdkErrors.ErrUnknownRequest, "invalid toHeight: %v", err)
+	}
+
+	if fromHeight < 0 || fromHeight > toHeight {
+		return []byte{}, sdkErrors.Wrapf(types.ErrInvalidHeight, "range [%d, %d]: invalid", fromHeight, toHeight)
+	}
+	if toHeight > ctx.BlockHeight() {
+		return []byte{}, sdkErrors.Wrapf(types.ErrInvalidHeight, "toHeight %d: greater than the current one %d", toHeight, ctx.BlockHeight())
+	}
+	if retention := keeper.GetRawPricesParams(ctx).RetentionBlocks; retention > 0 {
+		if minHeight := ctx.BlockHeight() - int64(retention) + 1; fromHeight < minHeight {
+			return []byte{}, sdkErrors.Wrapf(types.ErrInvalidHeight, "fromHeight %d: outside of the retention window (min %d)", fromHeight, minHeight)
+		}
+	}
+
+	blocksPrices := keeper.GetRawPricesRange(ctx, assetCode, fromHeight, toHeight)
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, types.QueryRawPricesRangeResp(blocksPrices))
+	if err != nil {
+		return nil, sdkErrors.Wrapf(types.ErrInternal, "rawPrices marshal: %v", err)
+	}
+
+	return bz, nil
+}
+
 // queryAssets handles assets query, returns []Assets in the oracle system.
 func queryAssets(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
 	assets := keeper.GetAssetParams(ctx)
@@ -72,3 +117,35 @@ func queryAssets(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte,
 
 	return bz, nil
 }
+
+// queryOracleStats handles oracle stats query. Takes an [oracleAddress] and returns OracleStats for that oracle.
+func queryOracleStats(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	address, err := sdk.AccAddressFromBech32(path[0])
+	if err != nil {
+		return []byte{}, sdkErrors.Wrapf(sdkErrors.ErrInvalidAddress, "oracle address: %v", err)
+	}
+
+	stats, found := keeper.GetOracleStats(ctx, address)
+	if !found {
+		return []byte{}, sdkErrors.Wrap(types.ErrInvalidOracle, "oracle stats not found")
+	}
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, stats)
+	if err != nil {
+		return nil, sdkErrors.Wrapf(types.ErrInternal, "oracleStats marshal: %v", err)
+	}
+
+	return bz, nil
+}
+
+// queryOracleStatsList handles oracles stats list query, returns []OracleStats for all oracles.
+func queryOracleStatsList(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	statsList := keeper.GetOracleStatsList(ctx)
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, statsList)
+	if err != nil {
+		return nil, sdkErrors.Wrapf(types.ErrInternal, "oracleStats marshal: %v", err)
+	}
+
+	return bz, nil
+}
@@ -4,7 +4,9 @@ package keeper
 
 import (
 	"testing"
+	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
 
@@ -24,6 +26,23 @@ func TestOracleKeeper_QueryCurrentPrice(t *testing.T) {
 		require.NoError(t, err)
 	}
 
+	// get current price with quorum status
+	{
+		ctx := ctx.WithBlockHeight(1).WithBlockTime(time.Now().UTC())
+		_, err := keeper.SetPrice(ctx, input.addresses[0], input.stdAssetCode, sdk.NewInt(100), ctx.BlockTime())
+		require.NoError(t, err)
+		require.NoError(t, keeper.SetCurrentPrices(ctx))
+
+		bz, err := queryCurrentPrice(ctx, []string{input.stdAssetCode.String()}, abci.RequestQuery{}, keeper)
+		require.NoError(t, err)
+
+		var resp types.QueryPriceResp
+		require.NoError(t, input.cdc.UnmarshalJSON(bz, &resp))
+		require.Equal(t, input.stdAssetCode, resp.AssetCode)
+		require.True(t, resp.Price.Equal(sdk.NewInt(100)))
+		require.Equal(t, types.NewPriceQuorum(1, 1, 1), resp.Quorum)
+	}
+
 	// wrong asset code
 	{
 		_, err := queryCurrentPrice(ctx, []string{"wrong_asset"}, abci.RequestQuery{}, keeper)
@@ -86,6 +105,74 @@ func TestOracleKeeper_QueryRawPrices(t *testing.T) {
 	}
 }
 
+// Check querier method queryRawPricesRange.
+func TestOracleKeeper_QueryRawPricesRange(t *testing.T) {
+	t.Parallel()
+
+	input := NewTestInput(t)
+	keeper := input.keeper
+
+	params := keeper.GetParams(input.ctx)
+	params.RawPrices = types.RawPricesParams{RetentionBlocks: 5, PruneLimit: 10}
+	keeper.SetParams(input.ctx, params)
+
+	now := time.Now().UTC()
+	for height := int64(1); height <= 10; height++ {
+		ctx := input.ctx.WithBlockHeight(height).WithBlockTime(now)
+		_, err := keeper.SetPrice(ctx, input.addresses[0], input.stdAssetCode, sdk.NewInt(height), now)
+		require.NoError(t, err)
+	}
+	ctx := input.ctx.WithBlockHeight(10)
+
+	// ok
+	{
+		bz, err := queryRawPricesRange(ctx, []string{input.stdAssetCode.String(), "6", "8"}, abci.RequestQuery{}, keeper)
+		require.NoError(t, err)
+
+		var resp types.QueryRawPricesRangeResp
+		require.NoError(t, input.cdc.UnmarshalJSON(bz, &resp))
+		require.Len(t, resp, 3)
+		for i, blockPrices := range resp {
+			require.EqualValues(t, 6+i, blockPrices.BlockHeight)
+			require.Len(t, blockPrices.RawPrices, 1)
+			require.True(t, blockPrices.RawPrices[0].Price.Equal(sdk.NewInt(blockPrices.BlockHeight)))
+		}
+	}
+
+	// outside of the retention window
+	{
+		_, err := queryRawPricesRange(ctx, []string{input.stdAssetCode.String(), "5", "8"}, abci.RequestQuery{}, keeper)
+		require.True(t, types.ErrInvalidHeight.Is(err))
+	}
+
+	// toHeight in future
+	{
+		_, err := queryRawPricesRange(ctx, []string{input.stdAssetCode.String(), "6", "11"}, abci.RequestQuery{}, keeper)
+		require.True(t, types.ErrInvalidHeight.Is(err))
+	}
+
+	// invalid range
+	{
+		_, err := queryRawPricesRange(ctx, []string{input.stdAssetCode.String(), "8", "6"}, abci.RequestQuery{}, keeper)
+		require.True(t, types.ErrInvalidHeight.Is(err))
+	}
+
+	// wrong heights
+	{
+		_, err := queryRawPricesRange(ctx, []string{input.stdAssetCode.String(), "a", "6"}, abci.RequestQuery{}, keeper)
+		require.Error(t, err)
+
+		_, err = queryRawPricesRange(ctx, []string{input.stdAssetCode.String(), "6", "b"}, abci.RequestQuery{}, keeper)
+		require.Error(t, err)
+	}
+
+	// wrong asset code
+	{
+		_, err := queryRawPricesRange(ctx, []string{"wrong_asset", "6", "8"}, abci.RequestQuery{}, keeper)
+		require.Error(t, err)
+	}
+}
+
 // Check querier method queryAssets.
 func TestOracleKeeper_QueryAssets(t *testing.T) {
 	t.Parallel()
@@ -105,3 +192,49 @@ func TestOracleKeeper_QueryAssets(t *testing.T) {
 		require.Equal(t, assets[0].AssetCode, input.stdAssets[0].AssetCode)
 	}
 }
+
+// Check querier methods queryOracleStats and queryOracleStatsList.
+func TestOracleKeeper_QueryOracleStats(t *testing.T) {
+	t.Parallel()
+
+	input := NewTestInput(t)
+	keeper, cdc := input.keeper, input.cdc
+	setTestOracles(t, input, 2)
+
+	ctx := input.ctx.WithBlockHeight(1).WithBlockTime(time.Now().UTC())
+	postTestPrices(t, input, ctx, int64Ptr(100))
+	keeper.UpdateOracleStats(ctx)
+
+	// oracle stats ok
+	{
+		bz, err := queryOracleStats(ctx, []string{input.addresses[1].String()}, abci.RequestQuery{}, keeper)
+		require.NoError(t, err)
+
+		var stats types.OracleStats
+		require.NoError(t, cdc.UnmarshalJSON(bz, &stats))
+		require.True(t, stats.Address.Equals(input.addresses[1]))
+		require.EqualValues(t, 1, stats.Total.Misses)
+	}
+
+	// oracle stats list ok
+	{
+		bz, err := queryOracleStatsList(ctx, abci.RequestQuery{}, keeper)
+		require.NoError(t, err)
+
+		var statsList types.OracleStatsList
+		require.NoError(t, cdc.UnmarshalJSON(bz, &statsList))
+		require.Len(t, statsList, 2)
+	}
+
+	// fail: invalid address
+	{
+		_, err := queryOracleStats(ctx, []string{"invalid"}, abci.RequestQuery{}, keeper)
+		require.Error(t, err)
+	}
+
+	// fail: stats not found
+	{
+		_, err := queryOracleStats(ctx, []string{input.addresses[5].String()}, abci.RequestQuery{}, keeper)
+		require.True(t, types.ErrInvalidOracle.Is(err))
+	}
+}
@@ -0,0 +1,87 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	dnTypes "github.com/dfinance/dnode/helpers/types"
+	"github.com/dfinance/dnode/x/oracle/internal/types"
+)
+
+// GetRawPricesRange fetches all prices posted by oracles for an asset within [fromHeight, toHeight] blockHeights range.
+// Blocks without prices are skipped.
+func (k Keeper) GetRawPricesRange(ctx sdk.Context, assetCode dnTypes.AssetCode, fromHeight, toHeight int64) []types.BlockRawPrices {
+	k.modulePerms.AutoCheck(types.PermRead)
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.GetRawPricesKey(assetCode, fromHeight), types.GetRawPricesKey(assetCode, toHeight+1))
+	defer iterator.Close()
+
+	blocksPrices := make([]types.BlockRawPrices, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var prices []types.PostedPrice
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &prices)
+
+		blocksPrices = append(blocksPrices, types.BlockRawPrices{
+			BlockHeight: types.GetBlockHeightFromRawPricesKey(iterator.Key()),
+			RawPrices:   prices,
+		})
+	}
+
+	return blocksPrices
+}
+
+// PruneRawPrices removes RawPrices posted before the retention window (RawPricesParams.RetentionBlocks).
+// All stored assets are pruned (including ones removed from params), assets are read from the storage
+// skipping to the next asset prefix once the asset outdated RawPrices are collected.
+// Number of removed per block RawPrices records is limited by RawPricesParams.PruneLimit,
+// leftovers are removed on the next calls.
+func (k Keeper) PruneRawPrices(ctx sdk.Context) {
+	k.modulePerms.AutoCheck(types.PermWrite)
+
+	params := k.GetRawPricesParams(ctx)
+	if params.RetentionBlocks == 0 {
+		return
+	}
+
+	// blocks with height LTE cutoffHeight are removed
+	cutoffHeight := ctx.BlockHeight() - int64(params.RetentionBlocks)
+	if cutoffHeight < 0 {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	limit := int(params.PruneLimit)
+
+	keys := make([][]byte, 0)
+	startKey, endKey := types.GetRawPricesPrefix(), sdk.PrefixEndBytes(types.GetRawPricesPrefix())
+	for len(keys) < limit {
+		assetCode, found := k.getNextRawPricesAsset(store, startKey, endKey)
+		if !found {
+			break
+		}
+
+		iterator := store.Iterator(types.GetRawPricesAssetPrefix(assetCode), types.GetRawPricesKey(assetCode, cutoffHeight+1))
+		for ; iterator.Valid() && len(keys) < limit; iterator.Next() {
+			keys = append(keys, iterator.Key())
+		}
+		iterator.Close()
+
+		startKey = sdk.PrefixEndBytes(types.GetRawPricesAssetPrefix(assetCode))
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// getNextRawPricesAsset returns the first assetCode with RawPrices stored within [startKey, endKey) range.
+func (k Keeper) getNextRawPricesAsset(store sdk.KVStore, startKey, endKey []byte) (dnTypes.AssetCode, bool) {
+	iterator := store.Iterator(startKey, endKey)
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return "", false
+	}
+
+	return types.GetAssetCodeFromRawPricesKey(iterator.Key()), true
+}
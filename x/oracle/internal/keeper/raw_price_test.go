@@ -0,0 +1,144 @@
+// +build unit
+
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	dnTypes "github.com/dfinance/dnode/helpers/types"
+	"github.com/dfinance/dnode/x/oracle/internal/types"
+)
+
+// Check GetRawPricesRange method.
+func TestOracleKeeper_GetRawPricesRange(t *testing.T) {
+	t.Parallel()
+
+	input := NewTestInput(t)
+	keeper := input.keeper
+
+	// prices are posted every second block
+	now := time.Now().UTC()
+	for height := int64(1); height <= 10; height += 2 {
+		ctx := input.ctx.WithBlockHeight(height).WithBlockTime(now)
+		for i := 0; i < 2; i++ {
+			_, err := keeper.SetPrice(ctx, input.addresses[i], input.stdAssetCode, sdk.NewInt(height*10+int64(i)), now)
+			require.NoError(t, err)
+		}
+	}
+	ctx := input.ctx.WithBlockHeight(10)
+
+	// full range
+	{
+		blocksPrices := keeper.GetRawPricesRange(ctx, input.stdAssetCode, 0, 10)
+		require.Len(t, blocksPrices, 5)
+		for i, blockPrices := range blocksPrices {
+			require.EqualValues(t, 1+2*i, blockPrices.BlockHeight)
+			require.Equal(t, keeper.GetRawPrices(ctx, input.stdAssetCode, blockPrices.BlockHeight), blockPrices.RawPrices)
+		}
+	}
+
+	// range bounds are inclusive
+	{
+		blocksPrices := keeper.GetRawPricesRange(ctx, input.stdAssetCode, 3, 7)
+		require.Len(t, blocksPrices, 3)
+		require.EqualValues(t, 3, blocksPrices[0].BlockHeight)
+		require.EqualValues(t, 7, blocksPrices[2].BlockHeight)
+	}
+
+	// empty range
+	{
+		require.Empty(t, keeper.GetRawPricesRange(ctx, input.stdAssetCode, 4, 4))
+	}
+
+	// other asset
+	{
+		require.Empty(t, keeper.GetRawPricesRange(ctx, dnTypes.AssetCode("eth_xfi"), 0, 10))
+	}
+}
+
+// Check PruneRawPrices method with prune limit.
+func TestOracleKeeper_PruneRawPrices(t *testing.T) {
+	t.Parallel()
+
+	input := NewTestInput(t)
+	keeper := input.keeper
+
+	secondAssetCode := dnTypes.AssetCode("eth_xfi")
+	require.NoError(t, keeper.AddAsset(input.ctx, input.stdNominee, types.NewAsset(secondAssetCode, types.Oracles{}, true)))
+
+	params := keeper.GetParams(input.ctx)
+	params.RawPrices = types.RawPricesParams{RetentionBlocks: 5, PruneLimit: 3}
+	keeper.SetParams(input.ctx, params)
+
+	now := time.Now().UTC()
+	for height := int64(1); height <= 10; height++ {
+		ctx := input.ctx.WithBlockHeight(height).WithBlockTime(now)
+		for _, assetCode := range []dnTypes.AssetCode{input.stdAssetCode, secondAssetCode} {
+			_, err := keeper.SetPrice(ctx, input.addresses[0], assetCode, sdk.NewInt(height), now)
+			require.NoError(t, err)
+		}
+	}
+
+	getHeights := func(ctx sdk.Context, assetCode dnTypes.AssetCode) []int64 {
+		heights := make([]int64, 0)
+		for _, blockPrices := range keeper.GetRawPricesRange(ctx, assetCode, 0, 10) {
+			heights = append(heights, blockPrices.BlockHeight)
+		}
+
+		return heights
+	}
+
+	// retention window is not passed yet
+	{
+		ctx := input.ctx.WithBlockHeight(5)
+		keeper.PruneRawPrices(ctx)
+		require.Len(t, getHeights(ctx, input.stdAssetCode), 10)
+		require.Len(t, getHeights(ctx, secondAssetCode), 10)
+	}
+
+	// heights [1, 5] should be removed, limited by 3 records per call
+	{
+		ctx := input.ctx.WithBlockHeight(10)
+
+		keeper.PruneRawPrices(ctx)
+		require.Equal(t, []int64{4, 5, 6, 7, 8, 9, 10}, getHeights(ctx, input.stdAssetCode))
+		require.Len(t, getHeights(ctx, secondAssetCode), 10)
+
+		keeper.PruneRawPrices(ctx)
+		require.Equal(t, []int64{6, 7, 8, 9, 10}, getHeights(ctx, input.stdAssetCode))
+		require.Equal(t, []int64{2, 3, 4, 5, 6, 7, 8, 9, 10}, getHeights(ctx, secondAssetCode))
+
+		keeper.PruneRawPrices(ctx)
+		keeper.PruneRawPrices(ctx)
+		require.Equal(t, []int64{6, 7, 8, 9, 10}, getHeights(ctx, input.stdAssetCode))
+		require.Equal(t, []int64{6, 7, 8, 9, 10}, getHeights(ctx, secondAssetCode))
+	}
+
+	// pruning disabled
+	{
+		params.RawPrices.RetentionBlocks = 0
+		keeper.SetParams(input.ctx, params)
+
+		ctx := input.ctx.WithBlockHeight(20)
+		keeper.PruneRawPrices(ctx)
+		require.Len(t, getHeights(ctx, input.stdAssetCode), 5)
+	}
+
+	// asset removed from params is pruned too
+	{
+		params.RawPrices = types.RawPricesParams{RetentionBlocks: 5, PruneLimit: 10}
+		params.Assets = types.Assets{params.Assets[0]}
+		keeper.SetParams(input.ctx, params)
+		require.Equal(t, input.stdAssetCode, keeper.GetAssetParams(input.ctx)[0].AssetCode)
+		require.Len(t, keeper.GetAssetParams(input.ctx), 1)
+
+		ctx := input.ctx.WithBlockHeight(20)
+		keeper.PruneRawPrices(ctx)
+		require.Empty(t, getHeights(ctx, input.stdAssetCode))
+		require.Empty(t, getHeights(ctx, secondAssetCode))
+	}
+}
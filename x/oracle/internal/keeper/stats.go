@@ -0,0 +1,225 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	dnTypes "github.com/dfinance/dnode/helpers/types"
+	"github.com/dfinance/dnode/x/oracle/internal/types"
+)
+
+// GetOracleStats returns stats for specific oracle address.
+func (k Keeper) GetOracleStats(ctx sdk.Context, address sdk.AccAddress) (types.OracleStats, bool) {
+	k.modulePerms.AutoCheck(types.PermRead)
+
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetOracleStatsKey(address))
+	if bz == nil {
+		return types.NewOracleStats(address), false
+	}
+
+	var stats types.OracleStats
+	k.cdc.MustUnmarshalBinaryBare(bz, &stats)
+
+	return stats, true
+}
+
+// GetOracleStatsList returns all oracles stats sorted by address.
+func (k Keeper) GetOracleStatsList(ctx sdk.Context) types.OracleStatsList {
+	k.modulePerms.AutoCheck(types.PermRead)
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetOracleStatsPrefix())
+	defer iterator.Close()
+
+	statsList := types.OracleStatsList{}
+	for ; iterator.Valid(); iterator.Next() {
+		var stats types.OracleStats
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &stats)
+		statsList = append(statsList, stats)
+	}
+
+	return statsList
+}
+
+// setOracleStats sets stats for specific oracle.
+func (k Keeper) setOracleStats(ctx sdk.Context, stats types.OracleStats) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetOracleStatsKey(stats.Address), k.cdc.MustMarshalBinaryBare(stats))
+}
+
+// UpdateOracleStats updates asset oracles stats with prices posted within the current block.
+// Oracle posted price deviation is measured relative to the block median.
+// Miss is counted only if other asset oracles have posted prices, jailed oracles are skipped.
+func (k Keeper) UpdateOracleStats(ctx sdk.Context) {
+	k.modulePerms.AutoCheck(types.PermWrite)
+
+	maxDeviation := k.GetRewardParams(ctx).MaxDeviation
+	for _, asset := range k.GetAssetParams(ctx) {
+		rawPrices := k.GetRawPrices(ctx, asset.AssetCode, ctx.BlockHeight())
+		if len(rawPrices) == 0 {
+			continue
+		}
+
+		prices := make([]sdk.Int, 0, len(rawPrices))
+		postedPrices := make(map[string]sdk.Int, len(rawPrices))
+		for _, rawPrice := range rawPrices {
+			prices = append(prices, rawPrice.Price)
+			postedPrices[rawPrice.OracleAddress.String()] = rawPrice.Price
+		}
+		median := medianInt(prices)
+
+		for _, oracle := range asset.Oracles {
+			stats, _ := k.GetOracleStats(ctx, oracle.Address)
+			if stats.IsJailed(ctx.BlockHeight()) {
+				continue
+			}
+
+			if price, ok := postedPrices[oracle.Address.String()]; ok {
+				deviation := priceDeviation(price, median)
+				stats.Total = stats.Total.AddPost(deviation, maxDeviation)
+				stats.Epoch = stats.Epoch.AddPost(deviation, maxDeviation)
+			} else {
+				stats.Total = stats.Total.AddMiss()
+				stats.Epoch = stats.Epoch.AddMiss()
+			}
+
+			k.setOracleStats(ctx, stats)
+		}
+	}
+}
+
+// ProcessRewardsEpoch jails inaccurate oracles and pays epoch rewards at the end of the epoch (RewardParams.EpochBlocks).
+// Epoch reward is split between not jailed oracles proportionally to the number of epoch accurate posts,
+// the reward is capped by the source module account balance.
+// Oracles epoch counters are reset afterwards.
+func (k Keeper) ProcessRewardsEpoch(ctx sdk.Context) {
+	k.modulePerms.AutoCheck(types.PermWrite)
+
+	params := k.GetRewardParams(ctx)
+	if params.EpochBlocks == 0 || ctx.BlockHeight()%int64(params.EpochBlocks) != 0 {
+		return
+	}
+
+	statsList := k.GetOracleStatsList(ctx)
+
+	eventsCnt := 0
+
+	// jail oracles and collect reward weights
+	weights := make([]sdk.Int, len(statsList))
+	totalWeight := sdk.ZeroInt()
+	for i := range statsList {
+		stats := &statsList[i]
+
+		weights[i] = sdk.ZeroInt()
+		if stats.IsJailed(ctx.BlockHeight()) {
+			continue
+		}
+
+		if reason := getJailReason(params, stats.Epoch); reason != "" {
+			stats.JailedUntil = ctx.BlockHeight() + int64(params.JailBlocks)
+			eventsCnt++
+			ctx.EventManager().EmitEvent(types.NewJailEvent(*stats, reason))
+			continue
+		}
+
+		weights[i] = sdk.NewIntFromUint64(stats.Epoch.AccuratePosts())
+		totalWeight = totalWeight.Add(weights[i])
+	}
+
+	// pay rewards
+	rewardPool := sdk.NewCoins()
+	if totalWeight.IsPositive() && !params.EpochReward.IsZero() {
+		rewardPool = k.getEpochRewardPool(ctx, params)
+	}
+
+	for i, stats := range statsList {
+		if weights[i].IsPositive() && !rewardPool.IsZero() {
+			reward := sdk.NewCoins()
+			for _, coin := range rewardPool {
+				if amount := coin.Amount.Mul(weights[i]).Quo(totalWeight); amount.IsPositive() {
+					reward = reward.Add(sdk.NewCoin(coin.Denom, amount))
+				}
+			}
+
+			if !reward.IsZero() {
+				if err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, params.Source.ModuleAccountName(), stats.Address, reward); err != nil {
+					panic(fmt.Errorf("oracle %s: reward transfer: %w", stats.Address, err))
+				}
+
+				stats.Rewards = stats.Rewards.Add(reward...)
+				eventsCnt++
+				ctx.EventManager().EmitEvent(types.NewRewardEvent(stats.Address, reward))
+			}
+		}
+
+		stats.Epoch = types.NewOracleCounters()
+		k.setOracleStats(ctx, stats)
+	}
+
+	if eventsCnt > 0 {
+		ctx.EventManager().EmitEvent(dnTypes.NewModuleNameEvent(types.ModuleName))
+	}
+}
+
+// FundRewardPool transfers coins from the depositor account to the oracle module account (rewards pool).
+func (k Keeper) FundRewardPool(ctx sdk.Context, depositor sdk.AccAddress, amount sdk.Coins) error {
+	k.modulePerms.AutoCheck(types.PermWrite)
+
+	if err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, depositor, types.ModuleName, amount); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(types.NewFundRewardPoolEvent(depositor, amount))
+	ctx.EventManager().EmitEvent(dnTypes.NewModuleNameEvent(types.ModuleName))
+
+	return nil
+}
+
+// getEpochRewardPool returns the epoch reward capped by the source module account balance.
+func (k Keeper) getEpochRewardPool(ctx sdk.Context, params types.RewardParams) sdk.Coins {
+	moduleName := params.Source.ModuleAccountName()
+	moduleAcc := k.supplyKeeper.GetModuleAccount(ctx, moduleName)
+	if moduleAcc == nil {
+		panic(fmt.Sprintf("module account %q: not registered", moduleName))
+	}
+	balance := moduleAcc.GetCoins()
+
+	pool := sdk.NewCoins()
+	for _, coin := range params.EpochReward {
+		amount := sdk.MinInt(coin.Amount, balance.AmountOf(coin.Denom))
+		if amount.IsPositive() {
+			pool = pool.Add(sdk.NewCoin(coin.Denom, amount))
+		}
+	}
+
+	return pool
+}
+
+// getJailReason checks oracle epoch counters against jailing limits, returns empty string if oracle shouldn't be jailed.
+func getJailReason(params types.RewardParams, counters types.OracleCounters) string {
+	if params.JailMissRate.IsPositive() && counters.MissRate().GT(params.JailMissRate) {
+		return types.JailReasonMisses
+	}
+
+	if params.JailDeviationRate.IsPositive() && counters.DeviationRate().GT(params.JailDeviationRate) {
+		return types.JailReasonDeviations
+	}
+
+	return ""
+}
+
+// priceDeviation returns price relative deviation from the median.
+func priceDeviation(price, median sdk.Int) sdk.Dec {
+	if !median.IsPositive() {
+		return sdk.ZeroDec()
+	}
+
+	deviation := price.Sub(median)
+	if deviation.IsNegative() {
+		deviation = deviation.Neg()
+	}
+
+	return deviation.ToDec().QuoInt(median)
+}
@@ -0,0 +1,230 @@
+// +build unit
+
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dfinance/dnode/x/oracle/internal/types"
+)
+
+// setTestOracles sets first {count} test addresses as oracles for the std asset.
+func setTestOracles(t *testing.T, input TestInput, count int) {
+	oracles := make(types.Oracles, 0, count)
+	for i := 0; i < count; i++ {
+		oracles = append(oracles, types.NewOracle(input.addresses[i]))
+	}
+
+	require.NoError(t, input.keeper.SetOracles(input.ctx, input.stdNominee, input.stdAssetCode, oracles))
+}
+
+// postTestPrices posts prices for the std asset by test addresses ({nil} price is skipped).
+func postTestPrices(t *testing.T, input TestInput, ctx sdk.Context, prices ...*int64) {
+	for i, price := range prices {
+		if price == nil {
+			continue
+		}
+
+		_, err := input.keeper.SetPrice(ctx, input.addresses[i], input.stdAssetCode, sdk.NewInt(*price), ctx.BlockTime())
+		require.NoError(t, err)
+	}
+}
+
+func int64Ptr(v int64) *int64 {
+	return &v
+}
+
+// Check UpdateOracleStats method.
+func TestOracleKeeper_UpdateOracleStats(t *testing.T) {
+	t.Parallel()
+
+	input := NewTestInput(t)
+	keeper := input.keeper
+	setTestOracles(t, input, 3)
+
+	now := time.Now().UTC()
+	checkCounters := func(counters types.OracleCounters, posts, misses, deviations uint64) {
+		require.Equal(t, posts, counters.Posts, "posts")
+		require.Equal(t, misses, counters.Misses, "misses")
+		require.Equal(t, deviations, counters.Deviations, "deviations")
+	}
+
+	// block 1: third oracle price deviates from the median
+	{
+		ctx := input.ctx.WithBlockHeight(1).WithBlockTime(now)
+		postTestPrices(t, input, ctx, int64Ptr(100), int64Ptr(100), int64Ptr(120))
+		keeper.UpdateOracleStats(ctx)
+	}
+
+	// block 2: third oracle misses the price
+	{
+		ctx := input.ctx.WithBlockHeight(2).WithBlockTime(now.Add(5 * time.Second))
+		postTestPrices(t, input, ctx, int64Ptr(99), int64Ptr(101))
+		keeper.UpdateOracleStats(ctx)
+	}
+
+	// block 3: no prices posted, misses are not counted
+	{
+		ctx := input.ctx.WithBlockHeight(3).WithBlockTime(now.Add(10 * time.Second))
+		keeper.UpdateOracleStats(ctx)
+	}
+
+	// check stats
+	{
+		stats, found := keeper.GetOracleStats(input.ctx, input.addresses[0])
+		require.True(t, found)
+		checkCounters(stats.Total, 2, 0, 0)
+		checkCounters(stats.Epoch, 2, 0, 0)
+		require.True(t, stats.Total.DeviationSum.Equal(sdk.NewDecWithPrec(1, 2)), "deviationSum: %s", stats.Total.DeviationSum)
+
+		stats, found = keeper.GetOracleStats(input.ctx, input.addresses[2])
+		require.True(t, found)
+		checkCounters(stats.Total, 1, 1, 1)
+		checkCounters(stats.Epoch, 1, 1, 1)
+		require.True(t, stats.Total.AvgDeviation().Equal(sdk.NewDecWithPrec(2, 1)), "avgDeviation: %s", stats.Total.AvgDeviation())
+		require.True(t, stats.Total.MissRate().Equal(sdk.NewDecWithPrec(5, 1)), "missRate: %s", stats.Total.MissRate())
+
+		require.Len(t, keeper.GetOracleStatsList(input.ctx), 3)
+	}
+
+	// not an asset oracle: stats are not tracked
+	{
+		ctx := input.ctx.WithBlockHeight(4).WithBlockTime(now.Add(15 * time.Second))
+		postTestPrices(t, input, ctx, nil, nil, nil, int64Ptr(100))
+		keeper.UpdateOracleStats(ctx)
+
+		_, found := keeper.GetOracleStats(ctx, input.addresses[3])
+		require.False(t, found)
+	}
+}
+
+// Check ProcessRewardsEpoch method: rewards distribution and oracles jailing.
+func TestOracleKeeper_ProcessRewardsEpoch(t *testing.T) {
+	t.Parallel()
+
+	input := NewTestInput(t)
+	keeper := input.keeper
+	setTestOracles(t, input, 3)
+
+	params := keeper.GetParams(input.ctx)
+	params.Rewards.EpochBlocks = 2
+	params.Rewards.EpochReward = sdk.NewCoins(sdk.NewInt64Coin("xfi", 100))
+	params.Rewards.JailDeviationRate = sdk.NewDecWithPrec(5, 1)
+	params.Rewards.JailBlocks = 10
+	keeper.SetParams(input.ctx, params)
+
+	// fund the rewards pool
+	poolAcc := input.supplyKeeper.GetModuleAccount(input.ctx, types.ModuleName)
+	_, err := input.bankKeeper.AddCoins(input.ctx, poolAcc.GetAddress(), sdk.NewCoins(sdk.NewInt64Coin("xfi", 150)))
+	require.NoError(t, err)
+
+	now := time.Now().UTC()
+	endBlock := func(height int64, prices ...*int64) sdk.Context {
+		ctx := input.ctx.WithBlockHeight(height).WithBlockTime(now.Add(time.Duration(height) * time.Second))
+		postTestPrices(t, input, ctx, prices...)
+		keeper.UpdateOracleStats(ctx)
+		keeper.ProcessRewardsEpoch(ctx)
+
+		return ctx
+	}
+
+	checkBalance := func(ctx sdk.Context, idx int, amount int64) {
+		acc := input.accountKeeper.GetAccount(ctx, input.addresses[idx])
+		require.NotNil(t, acc)
+		require.True(t, acc.GetCoins().AmountOf("xfi").Equal(sdk.NewInt(amount)), "oracle [%d] balance: %s", idx, acc.GetCoins())
+	}
+
+	// epoch is not finished
+	{
+		ctx := endBlock(1, int64Ptr(100), int64Ptr(100), int64Ptr(120))
+
+		stats, _ := keeper.GetOracleStats(ctx, input.addresses[0])
+		require.EqualValues(t, 1, stats.Epoch.Posts)
+		require.True(t, stats.Rewards.IsZero())
+	}
+
+	// epoch 1: third oracle is jailed, reward is split between the first two
+	{
+		ctx := endBlock(2, int64Ptr(100), int64Ptr(100))
+		checkBalance(ctx, 0, 50)
+		checkBalance(ctx, 1, 50)
+
+		stats, _ := keeper.GetOracleStats(ctx, input.addresses[0])
+		require.EqualValues(t, 0, stats.Epoch.Posts)
+		require.EqualValues(t, 2, stats.Total.Posts)
+		require.True(t, stats.Rewards.IsEqual(sdk.NewCoins(sdk.NewInt64Coin("xfi", 50))))
+
+		stats, _ = keeper.GetOracleStats(ctx, input.addresses[2])
+		require.EqualValues(t, 12, stats.JailedUntil)
+		require.True(t, stats.IsJailed(ctx.BlockHeight()))
+		require.True(t, stats.Rewards.IsZero())
+	}
+
+	// jailed oracle can't post prices
+	{
+		ctx := input.ctx.WithBlockHeight(3).WithBlockTime(now.Add(3 * time.Second))
+		msg := types.NewMsgPostPrice(input.addresses[2], input.stdAssetCode, sdk.NewInt(100), ctx.BlockTime())
+		require.True(t, types.ErrOracleJailed.Is(keeper.ValidatePostPrice(ctx, msg)))
+	}
+
+	// epoch 2: reward is capped by the pool balance, misses of the jailed oracle are not counted
+	{
+		endBlock(3, int64Ptr(100), int64Ptr(100))
+		ctx := endBlock(4, int64Ptr(100))
+		checkBalance(ctx, 0, 50+33)
+		checkBalance(ctx, 1, 50+16)
+
+		stats, _ := keeper.GetOracleStats(ctx, input.addresses[2])
+		require.EqualValues(t, 1, stats.Total.Misses)
+	}
+
+	// pool leftover is too small to be split: nothing is paid
+	{
+		ctx := endBlock(6, int64Ptr(100), int64Ptr(100))
+		checkBalance(ctx, 0, 50+33)
+		checkBalance(ctx, 1, 50+16)
+	}
+
+	// jail is over
+	{
+		ctx := input.ctx.WithBlockHeight(12).WithBlockTime(now.Add(12 * time.Second))
+		msg := types.NewMsgPostPrice(input.addresses[2], input.stdAssetCode, sdk.NewInt(100), ctx.BlockTime())
+		require.NoError(t, keeper.ValidatePostPrice(ctx, msg))
+	}
+}
+
+// Check FundRewardPool method: coins transfer from the depositor account to the rewards pool.
+func TestOracleKeeper_FundRewardPool(t *testing.T) {
+	t.Parallel()
+
+	input := NewTestInput(t)
+	keeper := input.keeper
+
+	depositor := input.addresses[0]
+	acc := input.accountKeeper.NewAccountWithAddress(input.ctx, depositor)
+	require.NoError(t, acc.SetCoins(sdk.NewCoins(sdk.NewInt64Coin("xfi", 100))))
+	input.accountKeeper.SetAccount(input.ctx, acc)
+
+	getPoolBalance := func() sdk.Coins {
+		return input.supplyKeeper.GetModuleAccount(input.ctx, types.ModuleName).GetCoins()
+	}
+
+	// ok
+	{
+		require.NoError(t, keeper.FundRewardPool(input.ctx, depositor, sdk.NewCoins(sdk.NewInt64Coin("xfi", 60))))
+		require.True(t, getPoolBalance().IsEqual(sdk.NewCoins(sdk.NewInt64Coin("xfi", 60))))
+
+		acc := input.accountKeeper.GetAccount(input.ctx, depositor)
+		require.True(t, acc.GetCoins().IsEqual(sdk.NewCoins(sdk.NewInt64Coin("xfi", 40))))
+	}
+
+	// fail: insufficient funds
+	{
+		require.Error(t, keeper.FundRewardPool(input.ctx, depositor, sdk.NewCoins(sdk.NewInt64Coin("xfi", 50))))
+		require.True(t, getPoolBalance().IsEqual(sdk.NewCoins(sdk.NewInt64Coin("xfi", 60))))
+	}
+}
@@ -0,0 +1,80 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Enum type to define how the asset's CurrentPrice is aggregated from oracles RawPrices.
+type AggregationMode string
+
+const (
+	// Median of the current block RawPrices
+	AggregationMedian AggregationMode = "median"
+	// Median of the current block RawPrices with outliers rejected by the median absolute deviation (MAD)
+	AggregationMedianMAD AggregationMode = "median_mad"
+	// Time-weighted average of per block medians over the last AggregationParams.TwapWindow blocks
+	AggregationTWAP AggregationMode = "twap"
+)
+
+// IsValid validates enum.
+func (m AggregationMode) IsValid() bool {
+	switch m {
+	case AggregationMedian, AggregationMedianMAD, AggregationTWAP:
+		return true
+	}
+
+	return false
+}
+
+// String returns string enum representation.
+func (m AggregationMode) String() string {
+	return string(m)
+}
+
+// NewAggregationModeRaw creates a new AggregationMode object without checks (empty value is converted to median).
+func NewAggregationModeRaw(str string) AggregationMode {
+	if str == "" {
+		return AggregationMedian
+	}
+
+	return AggregationMode(str)
+}
+
+// AggregationParams RawPrices aggregation configuration params.
+type AggregationParams struct {
+	// RawPrice is rejected if its deviation from the median exceeds MadThreshold * MAD (median_mad mode)
+	MadThreshold sdk.Dec `json:"mad_threshold" yaml:"mad_threshold"`
+	// Number of last blocks used to compute the time-weighted average price (twap mode)
+	TwapWindow uint32 `json:"twap_window" yaml:"twap_window"`
+}
+
+// Validate ensure that aggregation params have valid values.
+func (p AggregationParams) Validate() error {
+	if p.MadThreshold.IsNil() || !p.MadThreshold.IsPositive() {
+		return fmt.Errorf("madThreshold: should be positive")
+	}
+
+	if p.TwapWindow == 0 {
+		return fmt.Errorf("twapWindow: should be greater than 0")
+	}
+
+	return nil
+}
+
+func (p AggregationParams) String() string {
+	return fmt.Sprintf("Aggregation params:\n"+
+		"  MadThreshold: %s\n"+
+		"  TwapWindow: %d",
+		p.MadThreshold, p.TwapWindow,
+	)
+}
+
+// DefaultAggregationParams returns default aggregation params.
+func DefaultAggregationParams() AggregationParams {
+	return AggregationParams{
+		MadThreshold: sdk.NewDec(3),
+		TwapWindow:   10,
+	}
+}
@@ -3,7 +3,9 @@ package types
 import (
 	"fmt"
 	"strings"
+	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	dnTypes "github.com/dfinance/dnode/helpers/types"
@@ -17,14 +19,26 @@ type Asset struct {
 	Oracles Oracles `json:"oracles" yaml:"oracles"`
 	// Not used ATM
 	Active bool `json:"active" yaml:"active"`
+	// CurrentPrice aggregation mode (empty value is handled as median)
+	Aggregation AggregationMode `json:"aggregation,omitempty" yaml:"aggregation,omitempty" example:"median"`
+	// Min number of Oracles required to post prices within a block to update the CurrentPrice (0 - not used), jailed Oracles are counted
+	QuorumMin uint32 `json:"quorum_min,omitempty" yaml:"quorum_min,omitempty" example:"2"`
+	// Min fraction of not jailed Oracles required to post prices within a block to update the CurrentPrice (0 - not used)
+	QuorumFraction sdk.Dec `json:"quorum_fraction,omitempty" yaml:"quorum_fraction,omitempty" swaggertype:"string" example:"0.51"`
+	// Max CurrentPrice age, older price is marked as stale and removed from the VM storage (0 - not used) [sec]
+	MaxPriceAgeInS uint32 `json:"max_price_age_in_s,omitempty" yaml:"max_price_age_in_s,omitempty" example:"600"`
 }
 
 func (a Asset) String() string {
 	return fmt.Sprintf("Asset:\n"+
 		"  AssetCode: %s\n"+
 		"  Oracles: %s\n"+
-		"  Active: %v",
-		a.AssetCode, a.Oracles, a.Active)
+		"  Active: %v\n"+
+		"  Aggregation: %s\n"+
+		"  QuorumMin: %d\n"+
+		"  QuorumFraction: %s\n"+
+		"  MaxPriceAgeInS: %d",
+		a.AssetCode, a.Oracles, a.Active, a.AggregationMode(), a.QuorumMin, a.QuorumFraction, a.MaxPriceAgeInS)
 }
 
 // ValidateBasic does a simple validation check that doesn't require access to any other information.
@@ -37,6 +51,14 @@ func (a Asset) ValidateBasic() error {
 		return sdkErrors.Wrap(ErrInternal, "invalid TokenRecord: missing Oracles")
 	}
 
+	if !a.AggregationMode().IsValid() {
+		return sdkErrors.Wrapf(ErrInternal, "invalid aggregation: value (%s)", a.Aggregation)
+	}
+
+	if err := a.validateQuorum(); err != nil {
+		return sdkErrors.Wrapf(ErrInternal, "invalid quorum: %v", err)
+	}
+
 	return nil
 }
 
@@ -49,6 +71,59 @@ func NewAsset(assetCode dnTypes.AssetCode, oracles Oracles, active bool) Asset {
 	}
 }
 
+// AggregationMode returns asset CurrentPrice aggregation mode (empty value is converted to median).
+func (a Asset) AggregationMode() AggregationMode {
+	return NewAggregationModeRaw(a.Aggregation.String())
+}
+
+// QuorumRequired returns min number of Oracles required to post prices within a block to update the CurrentPrice.
+// The greatest of QuorumMin and QuorumFraction is used (at least one price is always required).
+// Jailed Oracles can't post prices, so QuorumFraction is applied to the number of not jailed ones ({activeOracles}),
+// while QuorumMin is an absolute floor: price isn't updated if too many Oracles are jailed to reach it.
+func (a Asset) QuorumRequired(activeOracles int) uint32 {
+	required := a.QuorumMin
+	if !a.QuorumFraction.IsNil() && a.QuorumFraction.IsPositive() {
+		fractionRequired := a.QuorumFraction.MulInt64(int64(activeOracles)).Ceil().TruncateInt64()
+		if uint32(fractionRequired) > required {
+			required = uint32(fractionRequired)
+		}
+	}
+
+	if required == 0 {
+		required = 1
+	}
+
+	return required
+}
+
+// IsPriceStale checks if the CurrentPrice is too old comparing to the current block time.
+// Price age is measured from the block it was updated within, as oracles' ReceivedAt might lag behind the block time.
+func (a Asset) IsPriceStale(price CurrentPrice, blockTime time.Time) bool {
+	if a.MaxPriceAgeInS == 0 {
+		return false
+	}
+
+	return blockTime.Sub(price.GetUpdatedAt()) > time.Duration(a.MaxPriceAgeInS)*time.Second
+}
+
+// validateQuorum checks quorum params.
+func (a Asset) validateQuorum() error {
+	if int(a.QuorumMin) > len(a.Oracles) {
+		return fmt.Errorf("quorumMin: should be LTE the number of oracles (%d)", len(a.Oracles))
+	}
+
+	if !a.QuorumFraction.IsNil() {
+		if a.QuorumFraction.IsNegative() {
+			return fmt.Errorf("quorumFraction: should be non-negative")
+		}
+		if a.QuorumFraction.GT(sdk.OneDec()) {
+			return fmt.Errorf("quorumFraction: should be LTE 1.0")
+		}
+	}
+
+	return nil
+}
+
 // Assets slice type for oracle.
 type Assets []Asset
 
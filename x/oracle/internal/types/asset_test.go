@@ -4,6 +4,7 @@ package types
 
 import (
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
@@ -46,4 +47,123 @@ func TestOracleTypes_ValidateAsset(t *testing.T) {
 		a := NewAsset("dn_eth", oracles, true)
 		require.NoError(t, a.ValidateBasic())
 	}
+
+	// check aggregation modes
+	{
+		a := NewAsset("dn_eth", oracles, true)
+		require.Equal(t, AggregationMedian, a.AggregationMode())
+
+		for _, mode := range []AggregationMode{AggregationMedian, AggregationMedianMAD, AggregationTWAP} {
+			a.Aggregation = mode
+			require.NoError(t, a.ValidateBasic())
+			require.Equal(t, mode, a.AggregationMode())
+		}
+
+		a.Aggregation = "mean"
+		require.Error(t, a.ValidateBasic())
+	}
+
+	// check quorum
+	{
+		a := NewAsset("dn_eth", oracles, true)
+
+		a.QuorumFraction = sdk.NewDecWithPrec(-1, 1)
+		require.Error(t, a.ValidateBasic())
+
+		a.QuorumFraction = sdk.NewDecWithPrec(11, 1)
+		require.Error(t, a.ValidateBasic())
+
+		a.QuorumFraction = sdk.OneDec()
+		require.NoError(t, a.ValidateBasic())
+
+		a.QuorumMin = uint32(len(oracles))
+		require.NoError(t, a.ValidateBasic())
+
+		a.QuorumMin = uint32(len(oracles)) + 1
+		require.Error(t, a.ValidateBasic())
+	}
+}
+
+// Check Asset CurrentPrice staleness check.
+func TestOracleTypes_AssetIsPriceStale(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	price := CurrentPrice{AssetCode: "dn_eth", Price: sdk.OneInt(), ReceivedAt: now}
+	a := NewAsset("dn_eth", Oracles{}, true)
+
+	// max age not set
+	{
+		require.False(t, a.IsPriceStale(price, now.Add(24*time.Hour)))
+	}
+
+	// max age set
+	{
+		a.MaxPriceAgeInS = 60
+		require.False(t, a.IsPriceStale(price, now))
+		require.False(t, a.IsPriceStale(price, now.Add(60*time.Second)))
+		require.True(t, a.IsPriceStale(price, now.Add(61*time.Second)))
+	}
+
+	// update block time is used if set
+	{
+		a.MaxPriceAgeInS = 60
+		price := price
+		price.UpdatedAt = now.Add(120 * time.Second)
+		require.False(t, a.IsPriceStale(price, now.Add(180*time.Second)))
+		require.True(t, a.IsPriceStale(price, now.Add(181*time.Second)))
+	}
+}
+
+// Check Asset quorum required number of oracles.
+func TestOracleTypes_AssetQuorumRequired(t *testing.T) {
+	t.Parallel()
+
+	oracles := make(Oracles, 0, 5)
+	for i := 0; i < 5; i++ {
+		oracles = append(oracles, Oracle{Address: sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())})
+	}
+
+	// not set
+	{
+		a := NewAsset("dn_eth", oracles, true)
+		require.EqualValues(t, 1, a.QuorumRequired(len(oracles)))
+	}
+
+	// absolute
+	{
+		a := NewAsset("dn_eth", oracles, true)
+		a.QuorumMin = 2
+		require.EqualValues(t, 2, a.QuorumRequired(len(oracles)))
+	}
+
+	// fraction (rounded up)
+	{
+		a := NewAsset("dn_eth", oracles, true)
+		a.QuorumFraction = sdk.NewDecWithPrec(51, 2)
+		require.EqualValues(t, 3, a.QuorumRequired(len(oracles)))
+
+		a.QuorumFraction = sdk.NewDecWithPrec(1, 2)
+		require.EqualValues(t, 1, a.QuorumRequired(len(oracles)))
+	}
+
+	// the greatest one is used
+	{
+		a := NewAsset("dn_eth", oracles, true)
+		a.QuorumMin, a.QuorumFraction = 4, sdk.NewDecWithPrec(51, 2)
+		require.EqualValues(t, 4, a.QuorumRequired(len(oracles)))
+
+		a.QuorumMin, a.QuorumFraction = 2, sdk.NewDecWithPrec(51, 2)
+		require.EqualValues(t, 3, a.QuorumRequired(len(oracles)))
+	}
+
+	// jailed oracles: fraction is applied to not jailed ones, min is kept
+	{
+		a := NewAsset("dn_eth", oracles, true)
+		a.QuorumFraction = sdk.NewDecWithPrec(51, 2)
+		require.EqualValues(t, 2, a.QuorumRequired(2))
+
+		a.QuorumMin = 3
+		require.EqualValues(t, 3, a.QuorumRequired(2))
+	}
 }
@@ -13,6 +13,7 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgSetOracles{}, "oracle/MsgSetOracles", nil)
 	cdc.RegisterConcrete(MsgAddAsset{}, "oracle/MsgAddAsset", nil)
 	cdc.RegisterConcrete(MsgSetAsset{}, "oracle/MsgSetAsset", nil)
+	cdc.RegisterConcrete(MsgFundRewardPool{}, "oracle/MsgFundRewardPool", nil)
 }
 
 func init() {
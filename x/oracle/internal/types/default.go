@@ -2,7 +2,9 @@ package types
 
 import (
 	"bytes"
-	"strconv"
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/dfinance/dnode/helpers/types"
 )
@@ -19,21 +21,51 @@ var (
 	ModuleKey       = []byte(ModuleName)
 	RawPriceKey     = []byte("raw")
 	CurrentPriceKey = []byte("currentprice")
+	PriceQuorumKey  = []byte("pricequorum")
+	OracleStatsKey  = []byte("oraclestats")
 )
 
 // GetRawPricesKey Get a key to store PostedPrices for specific assetCode and blockHeight.
+// BlockHeight is big endian encoded to keep keys sorted by height.
 func GetRawPricesKey(assetCode types.AssetCode, blockHeight int64) []byte {
+	return append(GetRawPricesAssetPrefix(assetCode), sdk.Uint64ToBigEndian(uint64(blockHeight))...)
+}
+
+// GetRawPricesAssetPrefix Get a prefix to iterate over PostedPrices for specific assetCode.
+func GetRawPricesAssetPrefix(assetCode types.AssetCode) []byte {
 	return bytes.Join(
 		[][]byte{
 			ModuleKey,
 			RawPriceKey,
 			[]byte(assetCode),
-			[]byte(strconv.FormatInt(blockHeight, 10)),
+			{},
+		},
+		KeyDelimiter,
+	)
+}
+
+// GetRawPricesPrefix Get a prefix to iterate over PostedPrices for all assetCodes.
+func GetRawPricesPrefix() []byte {
+	return bytes.Join(
+		[][]byte{
+			ModuleKey,
+			RawPriceKey,
+			{},
 		},
 		KeyDelimiter,
 	)
 }
 
+// GetAssetCodeFromRawPricesKey parses assetCode from the key built with GetRawPricesKey.
+func GetAssetCodeFromRawPricesKey(key []byte) types.AssetCode {
+	return types.AssetCode(key[len(GetRawPricesPrefix()) : len(key)-len(KeyDelimiter)-8])
+}
+
+// GetBlockHeightFromRawPricesKey parses blockHeight from the key built with GetRawPricesKey.
+func GetBlockHeightFromRawPricesKey(key []byte) int64 {
+	return int64(binary.BigEndian.Uint64(key[len(key)-8:]))
+}
+
 // GetCurrentPricePrefix Get a prefix for store CurrentPrice.
 func GetCurrentPricePrefix() []byte {
 	return bytes.Join(
@@ -55,3 +87,32 @@ func GetCurrentPriceKey(assetCode types.AssetCode) []byte {
 		KeyDelimiter,
 	)
 }
+
+// GetPriceQuorumKey Get a key to store PriceQuorum for specific assetCode.
+func GetPriceQuorumKey(assetCode types.AssetCode) []byte {
+	return bytes.Join(
+		[][]byte{
+			ModuleKey,
+			PriceQuorumKey,
+			[]byte(assetCode),
+		},
+		KeyDelimiter,
+	)
+}
+
+// GetOracleStatsPrefix Get a prefix to iterate over OracleStats.
+func GetOracleStatsPrefix() []byte {
+	return bytes.Join(
+		[][]byte{
+			ModuleKey,
+			OracleStatsKey,
+			{},
+		},
+		KeyDelimiter,
+	)
+}
+
+// GetOracleStatsKey Get a key to store OracleStats for specific oracle address.
+func GetOracleStatsKey(address sdk.AccAddress) []byte {
+	return append(GetOracleStatsPrefix(), address...)
+}
@@ -13,4 +13,6 @@ var (
 	ErrInvalidOracle     = sdkErrors.Register(ModuleName, 5, "oracle not found or not authorized")
 	ErrInvalidReceivedAt = sdkErrors.Register(ModuleName, 6, "invalid receivedAt")
 	ErrExistingAsset     = sdkErrors.Register(ModuleName, 7, "asset code already exists")
+	ErrInvalidHeight     = sdkErrors.Register(ModuleName, 8, "invalid block height")
+	ErrOracleJailed      = sdkErrors.Register(ModuleName, 9, "oracle is jailed")
 )
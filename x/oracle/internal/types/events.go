@@ -4,15 +4,35 @@ import (
 	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	dnTypes "github.com/dfinance/dnode/helpers/types"
 )
 
 const (
-	EventTypeAddAsset = ModuleName + ".add_asset"
-	EventTypePrice    = ModuleName + ".price"
+	EventTypeAddAsset   = ModuleName + ".add_asset"
+	EventTypePrice      = ModuleName + ".price"
+	EventTypePriceStale = ModuleName + ".price_stale"
+	EventTypeReward     = ModuleName + ".reward"
+	EventTypeJail       = ModuleName + ".jail"
+	EventTypeFundPool   = ModuleName + ".fund_reward_pool"
+	//
+	AttributeAssetCode      = "asset_code"
+	AttributePrice          = "price"
+	AttributeReceivedAt     = "received_at"
+	AttributeQuorumPosted   = "quorum_posted"
+	AttributeQuorumRequired = "quorum_required"
+	AttributeStaleReason    = "reason"
+	AttributeOracle         = "oracle"
+	AttributeAmount         = "amount"
+	AttributeJailedUntil    = "jailed_until"
+	AttributeJailReason     = "reason"
+	AttributeDepositor      = "depositor"
+	//
+	StaleReasonQuorum = "quorum"
+	StaleReasonMaxAge = "max_age"
 	//
-	AttributeAssetCode  = "asset_code"
-	AttributePrice      = "price"
-	AttributeReceivedAt = "received_at"
+	JailReasonMisses     = "misses"
+	JailReasonDeviations = "deviations"
 )
 
 // NewAssetAddedEvent creates an Event on asset creation.
@@ -30,3 +50,47 @@ func NewPriceEvent(price CurrentPrice) sdk.Event {
 		sdk.NewAttribute(AttributeReceivedAt, strconv.FormatInt(price.ReceivedAt.Unix(), 10)),
 	)
 }
+
+// NewPriceQuorumStaleEvent creates an Event on price update skip due to not reached quorum.
+func NewPriceQuorumStaleEvent(assetCode dnTypes.AssetCode, quorum PriceQuorum) sdk.Event {
+	return sdk.NewEvent(EventTypePriceStale,
+		sdk.NewAttribute(AttributeAssetCode, assetCode.String()),
+		sdk.NewAttribute(AttributeStaleReason, StaleReasonQuorum),
+		sdk.NewAttribute(AttributeQuorumPosted, strconv.FormatUint(uint64(quorum.Posted), 10)),
+		sdk.NewAttribute(AttributeQuorumRequired, strconv.FormatUint(uint64(quorum.Required), 10)),
+	)
+}
+
+// NewPriceMaxAgeStaleEvent creates an Event on price marked as stale due to its age.
+func NewPriceMaxAgeStaleEvent(price CurrentPrice) sdk.Event {
+	return sdk.NewEvent(EventTypePriceStale,
+		sdk.NewAttribute(AttributeAssetCode, price.AssetCode.String()),
+		sdk.NewAttribute(AttributeStaleReason, StaleReasonMaxAge),
+		sdk.NewAttribute(AttributeReceivedAt, strconv.FormatInt(price.ReceivedAt.Unix(), 10)),
+	)
+}
+
+// NewRewardEvent creates an Event on oracle epoch reward payment.
+func NewRewardEvent(oracle sdk.AccAddress, amount sdk.Coins) sdk.Event {
+	return sdk.NewEvent(EventTypeReward,
+		sdk.NewAttribute(AttributeOracle, oracle.String()),
+		sdk.NewAttribute(AttributeAmount, amount.String()),
+	)
+}
+
+// NewFundRewardPoolEvent creates an Event on rewards pool funding.
+func NewFundRewardPoolEvent(depositor sdk.AccAddress, amount sdk.Coins) sdk.Event {
+	return sdk.NewEvent(EventTypeFundPool,
+		sdk.NewAttribute(AttributeDepositor, depositor.String()),
+		sdk.NewAttribute(AttributeAmount, amount.String()),
+	)
+}
+
+// NewJailEvent creates an Event on oracle jailing.
+func NewJailEvent(stats OracleStats, reason string) sdk.Event {
+	return sdk.NewEvent(EventTypeJail,
+		sdk.NewAttribute(AttributeOracle, stats.Address.String()),
+		sdk.NewAttribute(AttributeJailedUntil, strconv.FormatInt(stats.JailedUntil, 10)),
+		sdk.NewAttribute(AttributeJailReason, reason),
+	)
+}
@@ -8,8 +8,9 @@ import (
 
 // GenesisState oracle state that must be provided at genesis.
 type GenesisState struct {
-	Params        Params        `json:"asset_params" yaml:"asset_params"`
-	CurrentPrices CurrentPrices `json:"current_prices" yaml:"current_prices"`
+	Params        Params          `json:"asset_params" yaml:"asset_params"`
+	CurrentPrices CurrentPrices   `json:"current_prices" yaml:"current_prices"`
+	OracleStats   OracleStatsList `json:"oracle_stats" yaml:"oracle_stats"`
 }
 
 // Validate checks that genesis state is valid.
@@ -27,6 +28,9 @@ func (gs GenesisState) Validate(blockTime time.Time) error {
 		if !blockTime.IsZero() && cPrice.ReceivedAt.After(blockTime) {
 			return fmt.Errorf("current_price[%d]: received_at after block time", i)
 		}
+		if !blockTime.IsZero() && cPrice.UpdatedAt.After(blockTime) {
+			return fmt.Errorf("current_price[%d]: updated_at after block time", i)
+		}
 
 		if assets[cPrice.AssetCode.String()] {
 			return fmt.Errorf("asset_code[%d]: duplicated %q", i, cPrice.AssetCode.String())
@@ -35,6 +39,19 @@ func (gs GenesisState) Validate(blockTime time.Time) error {
 		assets[cPrice.AssetCode.String()] = true
 	}
 
+	oracles := make(map[string]bool, len(gs.OracleStats))
+	for i, stats := range gs.OracleStats {
+		if err := stats.Valid(); err != nil {
+			return fmt.Errorf("oracle_stats[%d]: %w", i, err)
+		}
+
+		if oracles[stats.Address.String()] {
+			return fmt.Errorf("oracle_stats[%d]: address duplicated %q", i, stats.Address.String())
+		}
+
+		oracles[stats.Address.String()] = true
+	}
+
 	return nil
 }
 
@@ -43,6 +60,7 @@ func DefaultGenesisState() GenesisState {
 	return GenesisState{
 		Params:        DefaultParams(),
 		CurrentPrices: CurrentPrices{},
+		OracleStats:   OracleStatsList{},
 	}
 }
 
@@ -6,6 +6,7 @@ import (
 	"testing"
 	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -63,4 +64,24 @@ func TestOracles_Genesis_Valid(t *testing.T) {
 
 		require.Nil(t, err)
 	}
+	// oracle stats
+	{
+		state := getTestGenesisState()
+		state.OracleStats = OracleStatsList{NewOracleStats(sdk.AccAddress("oracle"))}
+		require.NoError(t, state.Validate(time.Time{}))
+
+		// duplicated address
+		state.OracleStats = append(state.OracleStats, state.OracleStats[0])
+		err := state.Validate(time.Time{})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "oracle_stats")
+		require.Contains(t, err.Error(), "duplicated")
+
+		// invalid counters
+		state.OracleStats = OracleStatsList{NewOracleStats(sdk.AccAddress("oracle"))}
+		state.OracleStats[0].Epoch.Deviations = 1
+		err = state.Validate(time.Time{})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "deviations")
+	}
 }
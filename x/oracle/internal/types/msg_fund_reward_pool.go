@@ -0,0 +1,52 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Client message to fund the oracles rewards pool (oracle module account).
+// Oracle module account is a blocked bank address, so the pool can't be funded with a regular send.
+type MsgFundRewardPool struct {
+	// Depositor address
+	Depositor sdk.AccAddress `json:"depositor" yaml:"depositor"`
+	// Coins transferred to the pool
+	Amount sdk.Coins `json:"amount" yaml:"amount"`
+}
+
+// Implements sdk.Msg interface.
+func (msg MsgFundRewardPool) Route() string { return RouterKey }
+
+// Implements sdk.Msg interface.
+func (msg MsgFundRewardPool) Type() string { return "fund_reward_pool" }
+
+// Implements sdk.Msg interface.
+func (msg MsgFundRewardPool) ValidateBasic() error {
+	if msg.Depositor.Empty() {
+		return sdkErrors.Wrap(sdkErrors.ErrInvalidAddress, "empty depositor address")
+	}
+
+	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return sdkErrors.Wrap(sdkErrors.ErrInvalidCoins, msg.Amount.String())
+	}
+
+	return nil
+}
+
+// Implements sdk.Msg interface.
+func (msg MsgFundRewardPool) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// Implements sdk.Msg interface.
+func (msg MsgFundRewardPool) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Depositor}
+}
+
+// NewMsgFundRewardPool creates a new FundRewardPool message.
+func NewMsgFundRewardPool(depositor sdk.AccAddress, amount sdk.Coins) MsgFundRewardPool {
+	return MsgFundRewardPool{
+		Depositor: depositor,
+		Amount:    amount,
+	}
+}
@@ -0,0 +1,52 @@
+// +build unit
+
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+// Check MsgFundRewardPool validate basic.
+func TestOracleMsg_FundRewardPool(t *testing.T) {
+	t.Parallel()
+
+	depositor := sdk.AccAddress([]byte("someName"))
+	amount := sdk.NewCoins(sdk.NewInt64Coin("xfi", 100))
+
+	t.Run("GetSign", func(t *testing.T) {
+		target := NewMsgFundRewardPool(depositor, amount)
+		require.Equal(t, "fund_reward_pool", target.Type())
+		require.Equal(t, RouterKey, target.Route())
+		require.True(t, len(target.GetSignBytes()) > 0)
+		require.Equal(t, []sdk.AccAddress{depositor}, target.GetSigners())
+	})
+
+	t.Run("ValidateBasic", func(t *testing.T) {
+		// ok
+		{
+			msg := NewMsgFundRewardPool(depositor, amount)
+			require.NoError(t, msg.ValidateBasic())
+		}
+
+		// fail: empty depositor
+		{
+			msg := NewMsgFundRewardPool(sdk.AccAddress{}, amount)
+			require.Error(t, msg.ValidateBasic())
+		}
+
+		// fail: empty amount
+		{
+			msg := NewMsgFundRewardPool(depositor, sdk.NewCoins())
+			require.Error(t, msg.ValidateBasic())
+		}
+
+		// fail: invalid amount
+		{
+			msg := NewMsgFundRewardPool(depositor, sdk.Coins{sdk.Coin{Denom: "xfi", Amount: sdk.NewInt(-1)}})
+			require.Error(t, msg.ValidateBasic())
+		}
+	})
+}
@@ -8,9 +8,12 @@ import (
 )
 
 var (
-	KeyAssets    = []byte("oracleassets")
-	KeyNominees  = []byte("oraclenominees")
-	KeyPostPrice = []byte("oraclepostprice")
+	KeyAssets      = []byte("oracleassets")
+	KeyNominees    = []byte("oraclenominees")
+	KeyPostPrice   = []byte("oraclepostprice")
+	KeyAggregation = []byte("oracleaggregation")
+	KeyRawPrices   = []byte("oraclerawprices")
+	KeyRewards     = []byte("oraclerewards")
 )
 
 // Params defines keeper params.
@@ -21,6 +24,12 @@ type Params struct {
 	Nominees []string `json:"nominees" yaml:"nominees"`
 	// PostPrice params
 	PostPrice PostPriceParams `json:"post_price" yaml:"post_price"`
+	// CurrentPrice aggregation params
+	Aggregation AggregationParams `json:"aggregation" yaml:"aggregation"`
+	// RawPrices storage params
+	RawPrices RawPricesParams `json:"raw_prices" yaml:"raw_prices"`
+	// Oracles rewards and jailing params
+	Rewards RewardParams `json:"rewards" yaml:"rewards"`
 }
 
 // Implements subspace.ParamSet interface.
@@ -33,6 +42,9 @@ func (p Params) ParamSetPairs() params.ParamSetPairs {
 		{Key: KeyAssets, Value: &p.Assets, ValidatorFn: nilPairValidatorFunc},
 		{Key: KeyNominees, Value: &p.Nominees, ValidatorFn: nilPairValidatorFunc},
 		{Key: KeyPostPrice, Value: &p.PostPrice, ValidatorFn: nilPairValidatorFunc},
+		{Key: KeyAggregation, Value: &p.Aggregation, ValidatorFn: nilPairValidatorFunc},
+		{Key: KeyRawPrices, Value: &p.RawPrices, ValidatorFn: nilPairValidatorFunc},
+		{Key: KeyRewards, Value: &p.Rewards, ValidatorFn: nilPairValidatorFunc},
 	}
 }
 
@@ -42,6 +54,12 @@ func (p Params) Validate() error {
 		if err := asset.AssetCode.Validate(); err != nil {
 			return fmt.Errorf("invalid asset %q: %w", asset.String(), err)
 		}
+		if !asset.AggregationMode().IsValid() {
+			return fmt.Errorf("invalid asset %q: unknown aggregation mode %q", asset.AssetCode, asset.Aggregation)
+		}
+		if err := asset.validateQuorum(); err != nil {
+			return fmt.Errorf("invalid asset %q: %w", asset.AssetCode, err)
+		}
 	}
 
 	for i, nominee := range p.Nominees {
@@ -50,6 +68,21 @@ func (p Params) Validate() error {
 		}
 	}
 
+	if err := p.Aggregation.Validate(); err != nil {
+		return fmt.Errorf("invalid aggregation params: %w", err)
+	}
+
+	if err := p.RawPrices.Validate(); err != nil {
+		return fmt.Errorf("invalid rawPrices params: %w", err)
+	}
+	if p.RawPrices.RetentionBlocks > 0 && p.RawPrices.RetentionBlocks < p.Aggregation.TwapWindow {
+		return fmt.Errorf("invalid rawPrices params: retentionBlocks should be GTE aggregation twapWindow")
+	}
+
+	if err := p.Rewards.Validate(); err != nil {
+		return fmt.Errorf("invalid rewards params: %w", err)
+	}
+
 	return nil
 }
 
@@ -63,17 +96,23 @@ func (p Params) String() string {
 	for i, n := range p.Nominees {
 		out.WriteString(fmt.Sprintf("Nominee [%d]: %s\n", i, n))
 	}
-	out.WriteString(p.PostPrice.String())
+	out.WriteString(p.PostPrice.String() + "\n")
+	out.WriteString(p.Aggregation.String() + "\n")
+	out.WriteString(p.RawPrices.String() + "\n")
+	out.WriteString(p.Rewards.String())
 
 	return strings.TrimSpace(out.String())
 }
 
 // NewParams creates a new AssetParams object.
-func NewParams(assets []Asset, nominees []string, postPrice PostPriceParams) Params {
+func NewParams(assets []Asset, nominees []string, postPrice PostPriceParams, aggregation AggregationParams, rawPrices RawPricesParams, rewards RewardParams) Params {
 	return Params{
-		Assets:    assets,
-		Nominees:  nominees,
-		PostPrice: postPrice,
+		Assets:      assets,
+		Nominees:    nominees,
+		PostPrice:   postPrice,
+		Aggregation: aggregation,
+		RawPrices:   rawPrices,
+		Rewards:     rewards,
 	}
 }
 
@@ -81,7 +120,10 @@ func NewParams(assets []Asset, nominees []string, postPrice PostPriceParams) Par
 func DefaultParams() Params {
 	return NewParams(Assets{}, []string{}, PostPriceParams{
 		ReceivedAtDiffInS: 60 * 60,
-	})
+	}, DefaultAggregationParams(), RawPricesParams{
+		RetentionBlocks: 1000,
+		PruneLimit:      100,
+	}, DefaultRewardParams())
 }
 
 // ParamKeyTable Key declaration for parameters.
@@ -101,3 +143,28 @@ func (p PostPriceParams) String() string {
 		p.ReceivedAtDiffInS,
 	)
 }
+
+// RawPricesParams RawPrices storage configuration params.
+type RawPricesParams struct {
+	// Number of last blocks RawPrices are kept for, older ones are pruned (0 - pruning is disabled)
+	RetentionBlocks uint32 `json:"retention_blocks" yaml:"retention_blocks"`
+	// Max number of per block RawPrices records pruned within a block
+	PruneLimit uint32 `json:"prune_limit" yaml:"prune_limit"`
+}
+
+// Validate ensure that rawPrices params have valid values.
+func (p RawPricesParams) Validate() error {
+	if p.RetentionBlocks > 0 && p.PruneLimit == 0 {
+		return fmt.Errorf("pruneLimit: should be greater than 0 if pruning is enabled")
+	}
+
+	return nil
+}
+
+func (p RawPricesParams) String() string {
+	return fmt.Sprintf("RawPrices params:\n"+
+		"  RetentionBlocks: %d\n"+
+		"  PruneLimit: %d",
+		p.RetentionBlocks, p.PruneLimit,
+	)
+}
@@ -16,9 +16,12 @@ func TestOracle_Params_Valid(t *testing.T) {
 	oracles := []Oracle{NewOracle(sdk.AccAddress([]byte("oracle")))}
 	asset := NewAsset("btc_xfi", oracles, true)
 
+	aggregation := DefaultAggregationParams()
+	rewards := DefaultRewardParams()
+
 	// ok
 	{
-		params := Params{Assets: []Asset{asset}, Nominees: []string{"nominee"}}
+		params := Params{Assets: []Asset{asset}, Nominees: []string{"nominee"}, Aggregation: aggregation, Rewards: rewards}
 		require.NoError(t, params.Validate())
 	}
 
@@ -33,4 +36,88 @@ func TestOracle_Params_Valid(t *testing.T) {
 		params := Params{Assets: []Asset{NewAsset("xfi", oracles, true)}, Nominees: []string{""}}
 		require.Error(t, params.Validate())
 	}
+
+	// fail asset aggregation mode
+	{
+		invalidAsset := asset
+		invalidAsset.Aggregation = "mean"
+		params := Params{Assets: []Asset{invalidAsset}, Nominees: []string{"nominee"}, Aggregation: aggregation}
+		require.Error(t, params.Validate())
+	}
+
+	// fail asset quorum
+	{
+		invalidAsset := asset
+		invalidAsset.QuorumFraction = sdk.NewDec(2)
+		params := Params{Assets: []Asset{invalidAsset}, Nominees: []string{"nominee"}, Aggregation: aggregation}
+		require.Error(t, params.Validate())
+	}
+
+	// fail aggregation params: madThreshold
+	{
+		params := Params{Assets: []Asset{asset}, Nominees: []string{"nominee"}, Aggregation: AggregationParams{MadThreshold: sdk.ZeroDec(), TwapWindow: 1}}
+		require.Error(t, params.Validate())
+
+		params.Aggregation.MadThreshold = sdk.Dec{}
+		require.Error(t, params.Validate())
+	}
+
+	// rawPrices params
+	{
+		params := Params{Assets: []Asset{asset}, Nominees: []string{"nominee"}, Aggregation: aggregation, Rewards: rewards}
+
+		// pruning disabled
+		params.RawPrices = RawPricesParams{}
+		require.NoError(t, params.Validate())
+
+		// ok
+		params.RawPrices = RawPricesParams{RetentionBlocks: aggregation.TwapWindow, PruneLimit: 1}
+		require.NoError(t, params.Validate())
+
+		// fail: pruneLimit
+		params.RawPrices = RawPricesParams{RetentionBlocks: aggregation.TwapWindow, PruneLimit: 0}
+		require.Error(t, params.Validate())
+
+		// fail: retention window is less than the TWAP one
+		params.RawPrices = RawPricesParams{RetentionBlocks: aggregation.TwapWindow - 1, PruneLimit: 1}
+		require.Error(t, params.Validate())
+	}
+
+	// rewards params
+	{
+		params := Params{Assets: []Asset{asset}, Nominees: []string{"nominee"}, Aggregation: aggregation, Rewards: rewards}
+
+		// ok: fee collector source with jailing enabled
+		params.Rewards.Source = RewardSourceFeeCollector
+		params.Rewards.EpochReward = sdk.NewCoins(sdk.NewInt64Coin("xfi", 100))
+		params.Rewards.JailMissRate = sdk.NewDecWithPrec(5, 1)
+		require.NoError(t, params.Validate())
+
+		// fail: unknown source
+		params.Rewards = rewards
+		params.Rewards.Source = "treasury"
+		require.Error(t, params.Validate())
+
+		// fail: maxDeviation
+		params.Rewards = rewards
+		params.Rewards.MaxDeviation = sdk.NewDec(-1)
+		require.Error(t, params.Validate())
+
+		// fail: jail rate out of range
+		params.Rewards = rewards
+		params.Rewards.JailDeviationRate = sdk.NewDecWithPrec(11, 1)
+		require.Error(t, params.Validate())
+
+		// fail: jailing enabled without the jail period
+		params.Rewards = rewards
+		params.Rewards.JailMissRate = sdk.NewDecWithPrec(5, 1)
+		params.Rewards.JailBlocks = 0
+		require.Error(t, params.Validate())
+	}
+
+	// fail aggregation params: twapWindow
+	{
+		params := Params{Assets: []Asset{asset}, Nominees: []string{"nominee"}, Aggregation: AggregationParams{MadThreshold: sdk.OneDec(), TwapWindow: 0}}
+		require.Error(t, params.Validate())
+	}
 }
@@ -12,6 +12,8 @@ import (
 
 const (
 	PriceBytesLimit = 8
+	// Price value decimals (1.0 with 8 decimals -> 100000000)
+	PriceDecimals = 8
 )
 
 // CurrentPrice contains meta of the current price for the particular asset.
@@ -22,6 +24,24 @@ type CurrentPrice struct {
 	Price sdk.Int `json:"price" yaml:"price" swaggertype:"string" example:"1000"`
 	// UNIX Timestamp price createdAt [sec]
 	ReceivedAt time.Time `json:"received_at" yaml:"received_at" format:"RFC 3339" example:"2020-03-27T13:45:15.293426Z"`
+	// Block time the price was last updated at (used for the Asset.MaxPriceAgeInS check)
+	UpdatedAt time.Time `json:"updated_at" yaml:"updated_at" format:"RFC 3339" example:"2020-03-27T13:45:15.293426Z"`
+	// Price is too old (Asset.MaxPriceAgeInS) and is removed from the VM storage
+	Stale bool `json:"stale,omitempty" yaml:"stale,omitempty"`
+}
+
+// GetUpdatedAt returns the price update block time (ReceivedAt is used if not set).
+func (cp CurrentPrice) GetUpdatedAt() time.Time {
+	if cp.UpdatedAt.IsZero() {
+		return cp.ReceivedAt
+	}
+
+	return cp.UpdatedAt
+}
+
+// GetPriceDec returns the price converted to sdk.Dec using PriceDecimals.
+func (cp CurrentPrice) GetPriceDec() sdk.Dec {
+	return sdk.NewDecFromIntWithPrec(cp.Price, PriceDecimals)
 }
 
 // Valid checks that CurrentPrice is valid (used for genesis ops).
@@ -45,13 +65,48 @@ func (cp CurrentPrice) String() string {
 	return fmt.Sprintf("CurrentPrice:\n"+
 		"AssetCode: %s\n"+
 		"Price: %s\n"+
-		"ReceivedAt: %s",
-		cp.AssetCode, cp.Price, cp.ReceivedAt,
+		"ReceivedAt: %s\n"+
+		"UpdatedAt: %s\n"+
+		"Stale: %v",
+		cp.AssetCode, cp.Price, cp.ReceivedAt, cp.GetUpdatedAt(), cp.Stale,
 	)
 }
 
 type CurrentPrices []CurrentPrice
 
+// PriceQuorum contains the CurrentPrice update quorum status for the particular asset.
+// Status is updated on every block oracles have posted prices within.
+type PriceQuorum struct {
+	// Block height oracles have posted prices within
+	BlockHeight int64 `json:"block_height" yaml:"block_height" example:"100"`
+	// Number of oracles posted prices within the block
+	Posted uint32 `json:"posted" yaml:"posted" example:"3"`
+	// Min number of oracles required to update the CurrentPrice
+	Required uint32 `json:"required" yaml:"required" example:"2"`
+	// Quorum was reached and the CurrentPrice was updated
+	Reached bool `json:"reached" yaml:"reached"`
+}
+
+func (q PriceQuorum) String() string {
+	return fmt.Sprintf("PriceQuorum:\n"+
+		"BlockHeight: %d\n"+
+		"Posted: %d\n"+
+		"Required: %d\n"+
+		"Reached: %v",
+		q.BlockHeight, q.Posted, q.Required, q.Reached,
+	)
+}
+
+// NewPriceQuorum creates a new PriceQuorum object.
+func NewPriceQuorum(blockHeight int64, posted, required uint32) PriceQuorum {
+	return PriceQuorum{
+		BlockHeight: blockHeight,
+		Posted:      posted,
+		Required:    required,
+		Reached:     posted >= required,
+	}
+}
+
 // PostedPrice contains price for an asset posted by a specific oracle.
 type PostedPrice struct {
 	// Asset code
@@ -77,6 +132,27 @@ func (pp PostedPrice) String() string {
 	)
 }
 
+// BlockRawPrices contains prices for an asset posted by oracles within a specific block.
+type BlockRawPrices struct {
+	// Block height prices were posted within
+	BlockHeight int64 `json:"block_height" yaml:"block_height" example:"100"`
+	// Posted prices
+	RawPrices []PostedPrice `json:"raw_prices" yaml:"raw_prices"`
+}
+
+func (p BlockRawPrices) String() string {
+	strBuilder := strings.Builder{}
+	strBuilder.WriteString(fmt.Sprintf("BlockHeight: %d\n", p.BlockHeight))
+	for i, rawPrice := range p.RawPrices {
+		strBuilder.WriteString(rawPrice.String())
+		if i < len(p.RawPrices)-1 {
+			strBuilder.WriteString("\n")
+		}
+	}
+
+	return strBuilder.String()
+}
+
 // PendingPriceAsset contains info about the asset which price is still to be determined.
 type PendingPriceAsset struct {
 	AssetCode string `json:"asset_code"`
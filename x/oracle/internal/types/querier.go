@@ -1,15 +1,60 @@
 package types
 
 import (
+	"fmt"
 	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	dnTypes "github.com/dfinance/dnode/helpers/types"
 )
 
 const (
-	QueryPrice     = "price"
-	QueryRawPrices = "rawprices"
-	QueryAssets    = "assets"
+	QueryPrice           = "price"
+	QueryRawPrices       = "rawprices"
+	QueryRawPricesRange  = "rawpricesrange"
+	QueryAssets          = "assets"
+	QueryOracleStats     = "oraclestats"
+	QueryOracleStatsList = "oraclestatslist"
 )
 
+// Client response for currentPrice request.
+type QueryPriceResp struct {
+	// Asset code
+	AssetCode dnTypes.AssetCode `json:"asset_code" yaml:"asset_code" example:"btc_xfi"`
+	// Price
+	Price sdk.Int `json:"price" yaml:"price" swaggertype:"string" example:"1000"`
+	// UNIX Timestamp price createdAt [sec]
+	ReceivedAt time.Time `json:"received_at" yaml:"received_at" format:"RFC 3339" example:"2020-03-27T13:45:15.293426Z"`
+	// Price is too old and is removed from the VM storage
+	Stale bool `json:"stale" yaml:"stale"`
+	// Last CurrentPrice update quorum status
+	Quorum PriceQuorum `json:"quorum" yaml:"quorum"`
+}
+
+func (r QueryPriceResp) String() string {
+	return fmt.Sprintf("CurrentPrice:\n"+
+		"AssetCode: %s\n"+
+		"Price: %s\n"+
+		"ReceivedAt: %s\n"+
+		"Stale: %v\n"+
+		"%s",
+		r.AssetCode, r.Price, r.ReceivedAt, r.Stale, r.Quorum,
+	)
+}
+
+// NewQueryPriceResp creates a new QueryPriceResp object.
+func NewQueryPriceResp(price CurrentPrice, quorum PriceQuorum) QueryPriceResp {
+	return QueryPriceResp{
+		AssetCode:  price.AssetCode,
+		Price:      price.Price,
+		ReceivedAt: price.ReceivedAt,
+		Stale:      price.Stale,
+		Quorum:     quorum,
+	}
+}
+
 // Client response for rawPrices request.
 type QueryRawPricesResp []PostedPrice
 
@@ -25,6 +70,21 @@ func (n QueryRawPricesResp) String() string {
 	return strBuilder.String()
 }
 
+// Client response for rawPrices range request.
+type QueryRawPricesRangeResp []BlockRawPrices
+
+func (n QueryRawPricesRangeResp) String() string {
+	strBuilder := strings.Builder{}
+	for i, v := range n {
+		strBuilder.WriteString(v.String())
+		if i < len(n)-1 {
+			strBuilder.WriteString("\n")
+		}
+	}
+
+	return strBuilder.String()
+}
+
 // Client response for all assets request.
 type QueryAssetsResp []string
 
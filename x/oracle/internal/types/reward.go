@@ -0,0 +1,122 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+)
+
+// Enum type to define the module account oracles epoch rewards are paid from.
+type RewardSource string
+
+const (
+	// Oracle module account (rewards pool)
+	RewardSourceModule RewardSource = "module"
+	// Transaction fees collector module account
+	RewardSourceFeeCollector RewardSource = "fee_collector"
+)
+
+// IsValid validates enum.
+func (s RewardSource) IsValid() bool {
+	switch s {
+	case RewardSourceModule, RewardSourceFeeCollector:
+		return true
+	}
+
+	return false
+}
+
+// String returns string enum representation.
+func (s RewardSource) String() string {
+	return string(s)
+}
+
+// ModuleAccountName returns the source module account name.
+func (s RewardSource) ModuleAccountName() string {
+	if s == RewardSourceFeeCollector {
+		return auth.FeeCollectorName
+	}
+
+	return ModuleName
+}
+
+// RewardParams oracles rewards and jailing configuration params.
+type RewardParams struct {
+	// Epoch length, rewards are paid and oracles are jailed at the end of the epoch (0 - disabled) [blocks]
+	EpochBlocks uint32 `json:"epoch_blocks" yaml:"epoch_blocks"`
+	// Coins distributed between oracles proportionally to accurate posts number per epoch (capped by the source balance)
+	EpochReward sdk.Coins `json:"epoch_reward" yaml:"epoch_reward"`
+	// Module account rewards are paid from
+	Source RewardSource `json:"source" yaml:"source"`
+	// RawPrice is accurate if its relative deviation from the block median is LTE MaxDeviation
+	MaxDeviation sdk.Dec `json:"max_deviation" yaml:"max_deviation"`
+	// Oracle is jailed if its epoch misses rate exceeds the limit (0 - disabled)
+	JailMissRate sdk.Dec `json:"jail_miss_rate" yaml:"jail_miss_rate"`
+	// Oracle is jailed if its epoch inaccurate posts rate exceeds the limit (0 - disabled)
+	JailDeviationRate sdk.Dec `json:"jail_deviation_rate" yaml:"jail_deviation_rate"`
+	// Number of blocks jailed oracle can't post prices for
+	JailBlocks uint32 `json:"jail_blocks" yaml:"jail_blocks"`
+}
+
+// IsJailingEnabled checks if any of jailing conditions is enabled.
+func (p RewardParams) IsJailingEnabled() bool {
+	return p.JailMissRate.IsPositive() || p.JailDeviationRate.IsPositive()
+}
+
+// Validate ensure that reward params have valid values.
+func (p RewardParams) Validate() error {
+	if !p.EpochReward.IsValid() {
+		return fmt.Errorf("epochReward: invalid coins: %s", p.EpochReward)
+	}
+
+	if !p.Source.IsValid() {
+		return fmt.Errorf("source: unknown %q", p.Source)
+	}
+
+	if p.MaxDeviation.IsNil() || p.MaxDeviation.IsNegative() {
+		return fmt.Errorf("maxDeviation: should be GTE 0")
+	}
+
+	isRateValid := func(rate sdk.Dec) bool {
+		return !rate.IsNil() && !rate.IsNegative() && rate.LTE(sdk.OneDec())
+	}
+	if !isRateValid(p.JailMissRate) {
+		return fmt.Errorf("jailMissRate: should be in [0, 1] range")
+	}
+	if !isRateValid(p.JailDeviationRate) {
+		return fmt.Errorf("jailDeviationRate: should be in [0, 1] range")
+	}
+
+	if p.IsJailingEnabled() && p.JailBlocks == 0 {
+		return fmt.Errorf("jailBlocks: should be greater than 0 if jailing is enabled")
+	}
+
+	return nil
+}
+
+func (p RewardParams) String() string {
+	return fmt.Sprintf("Reward params:\n"+
+		"  EpochBlocks: %d\n"+
+		"  EpochReward: %s\n"+
+		"  Source: %s\n"+
+		"  MaxDeviation: %s\n"+
+		"  JailMissRate: %s\n"+
+		"  JailDeviationRate: %s\n"+
+		"  JailBlocks: %d",
+		p.EpochBlocks, p.EpochReward, p.Source, p.MaxDeviation, p.JailMissRate, p.JailDeviationRate, p.JailBlocks,
+	)
+}
+
+// DefaultRewardParams returns default reward params (no rewards are paid and jailing is disabled).
+func DefaultRewardParams() RewardParams {
+	return RewardParams{
+		EpochBlocks:       1000,
+		EpochReward:       sdk.NewCoins(),
+		Source:            RewardSourceModule,
+		MaxDeviation:      sdk.NewDecWithPrec(5, 2),
+		JailMissRate:      sdk.ZeroDec(),
+		JailDeviationRate: sdk.ZeroDec(),
+		JailBlocks:        1000,
+	}
+}
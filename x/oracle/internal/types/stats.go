@@ -0,0 +1,174 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// OracleCounters contains oracle posted prices counters.
+type OracleCounters struct {
+	// Number of prices posted (per asset per block)
+	Posts uint64 `json:"posts" yaml:"posts" example:"100"`
+	// Number of blocks oracle hasn't posted an asset price while other asset oracles did
+	Misses uint64 `json:"misses" yaml:"misses" example:"5"`
+	// Number of posted prices which deviation from the block median exceeds RewardParams.MaxDeviation
+	Deviations uint64 `json:"deviations" yaml:"deviations" example:"1"`
+	// Sum of posted prices relative deviations from the block median
+	DeviationSum sdk.Dec `json:"deviation_sum" yaml:"deviation_sum" swaggertype:"string" example:"0.25"`
+}
+
+// Valid checks that OracleCounters is valid (used for genesis ops).
+func (c OracleCounters) Valid() error {
+	if c.DeviationSum.IsNil() || c.DeviationSum.IsNegative() {
+		return fmt.Errorf("deviation_sum: nil or negative")
+	}
+	if c.Deviations > c.Posts {
+		return fmt.Errorf("deviations: GT posts")
+	}
+
+	return nil
+}
+
+// AddPost returns counters updated with a posted price relative deviation from the block median.
+func (c OracleCounters) AddPost(deviation, maxDeviation sdk.Dec) OracleCounters {
+	c.Posts++
+	c.DeviationSum = c.DeviationSum.Add(deviation)
+	if deviation.GT(maxDeviation) {
+		c.Deviations++
+	}
+
+	return c
+}
+
+// AddMiss returns counters updated with a missed price.
+func (c OracleCounters) AddMiss() OracleCounters {
+	c.Misses++
+
+	return c
+}
+
+// AccuratePosts returns the number of posted prices within the RewardParams.MaxDeviation.
+func (c OracleCounters) AccuratePosts() uint64 {
+	return c.Posts - c.Deviations
+}
+
+// AvgDeviation returns the average posted price relative deviation from the block median.
+func (c OracleCounters) AvgDeviation() sdk.Dec {
+	if c.Posts == 0 {
+		return sdk.ZeroDec()
+	}
+
+	return c.DeviationSum.QuoInt64(int64(c.Posts))
+}
+
+// MissRate returns misses to expected posts ratio.
+func (c OracleCounters) MissRate() sdk.Dec {
+	if c.Posts+c.Misses == 0 {
+		return sdk.ZeroDec()
+	}
+
+	return sdk.NewDec(int64(c.Misses)).QuoInt64(int64(c.Posts + c.Misses))
+}
+
+// DeviationRate returns inaccurate posts to all posts ratio.
+func (c OracleCounters) DeviationRate() sdk.Dec {
+	if c.Posts == 0 {
+		return sdk.ZeroDec()
+	}
+
+	return sdk.NewDec(int64(c.Deviations)).QuoInt64(int64(c.Posts))
+}
+
+func (c OracleCounters) String() string {
+	return fmt.Sprintf("Posts: %d\n"+
+		"Misses: %d\n"+
+		"Deviations: %d\n"+
+		"AvgDeviation: %s",
+		c.Posts, c.Misses, c.Deviations, c.AvgDeviation(),
+	)
+}
+
+// NewOracleCounters creates an empty OracleCounters object.
+func NewOracleCounters() OracleCounters {
+	return OracleCounters{
+		DeviationSum: sdk.ZeroDec(),
+	}
+}
+
+// OracleStats contains oracle prices accuracy stats, rewards and jailing status.
+type OracleStats struct {
+	// Oracle address
+	Address sdk.AccAddress `json:"address" yaml:"address" swaggertype:"string" format:"bech32" example:"wallet13jyjuz3kkdvqw8u4qfkwd94emdl3vx394kn07h"`
+	// Counters for the whole oracle lifetime
+	Total OracleCounters `json:"total" yaml:"total"`
+	// Counters for the current epoch (reset at the end of the epoch)
+	Epoch OracleCounters `json:"epoch" yaml:"epoch"`
+	// Total rewards paid to the oracle
+	Rewards sdk.Coins `json:"rewards" yaml:"rewards" swaggertype:"string" example:"100xfi"`
+	// Block height oracle is jailed until (exclusive)
+	JailedUntil int64 `json:"jailed_until" yaml:"jailed_until" example:"2000"`
+}
+
+// IsJailed checks if oracle is jailed at the specified block height.
+func (s OracleStats) IsJailed(blockHeight int64) bool {
+	return blockHeight < s.JailedUntil
+}
+
+// Valid checks that OracleStats is valid (used for genesis ops).
+func (s OracleStats) Valid() error {
+	if s.Address.Empty() {
+		return fmt.Errorf("address: empty")
+	}
+	if err := s.Total.Valid(); err != nil {
+		return fmt.Errorf("total: %w", err)
+	}
+	if err := s.Epoch.Valid(); err != nil {
+		return fmt.Errorf("epoch: %w", err)
+	}
+	if !s.Rewards.IsValid() {
+		return fmt.Errorf("rewards: invalid coins: %s", s.Rewards)
+	}
+	if s.JailedUntil < 0 {
+		return fmt.Errorf("jailed_until: negative")
+	}
+
+	return nil
+}
+
+func (s OracleStats) String() string {
+	return fmt.Sprintf("OracleStats:\n"+
+		"Address: %s\n"+
+		"Total:\n%s\n"+
+		"Epoch:\n%s\n"+
+		"Rewards: %s\n"+
+		"JailedUntil: %d",
+		s.Address, s.Total, s.Epoch, s.Rewards, s.JailedUntil,
+	)
+}
+
+// NewOracleStats creates an empty OracleStats object.
+func NewOracleStats(address sdk.AccAddress) OracleStats {
+	return OracleStats{
+		Address: address,
+		Total:   NewOracleCounters(),
+		Epoch:   NewOracleCounters(),
+		Rewards: sdk.NewCoins(),
+	}
+}
+
+// OracleStatsList slice type for oracle stats.
+type OracleStatsList []OracleStats
+
+func (list OracleStatsList) String() string {
+	strBuilder := strings.Builder{}
+	for i, stats := range list {
+		strBuilder.WriteString(stats.String())
+		if i < len(list)-1 {
+			strBuilder.WriteString("\n")
+		}
+	}
+
+	return strBuilder.String()
+}
@@ -0,0 +1,79 @@
+// +build unit
+
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+// Check OracleCounters update and rates methods.
+func TestOracleTypes_OracleCounters(t *testing.T) {
+	t.Parallel()
+
+	maxDeviation := sdk.NewDecWithPrec(5, 2)
+
+	// empty counters
+	{
+		counters := NewOracleCounters()
+		require.NoError(t, counters.Valid())
+		require.True(t, counters.AvgDeviation().IsZero())
+		require.True(t, counters.MissRate().IsZero())
+		require.True(t, counters.DeviationRate().IsZero())
+	}
+
+	// ok
+	{
+		counters := NewOracleCounters().
+			AddPost(sdk.NewDecWithPrec(1, 2), maxDeviation).
+			AddPost(sdk.NewDecWithPrec(5, 2), maxDeviation).
+			AddPost(sdk.NewDecWithPrec(30, 2), maxDeviation).
+			AddMiss()
+		require.NoError(t, counters.Valid())
+
+		require.EqualValues(t, 3, counters.Posts)
+		require.EqualValues(t, 1, counters.Misses)
+		require.EqualValues(t, 1, counters.Deviations)
+		require.EqualValues(t, 2, counters.AccuratePosts())
+		require.True(t, counters.AvgDeviation().Equal(sdk.NewDecWithPrec(12, 2)), "avgDeviation: %s", counters.AvgDeviation())
+		require.True(t, counters.MissRate().Equal(sdk.NewDecWithPrec(25, 2)), "missRate: %s", counters.MissRate())
+		require.True(t, counters.DeviationRate().Equal(sdk.OneDec().QuoInt64(3)), "deviationRate: %s", counters.DeviationRate())
+	}
+
+	// fail: nil deviationSum
+	{
+		counters := OracleCounters{}
+		require.Error(t, counters.Valid())
+	}
+}
+
+// Check OracleStats jailing and validation.
+func TestOracleTypes_OracleStats(t *testing.T) {
+	t.Parallel()
+
+	// ok
+	{
+		stats := NewOracleStats(sdk.AccAddress("oracle"))
+		require.NoError(t, stats.Valid())
+		require.False(t, stats.IsJailed(0))
+
+		stats.JailedUntil = 10
+		require.True(t, stats.IsJailed(9))
+		require.False(t, stats.IsJailed(10))
+	}
+
+	// fail: empty address
+	{
+		stats := NewOracleStats(sdk.AccAddress{})
+		require.Error(t, stats.Valid())
+	}
+
+	// fail: negative jailedUntil
+	{
+		stats := NewOracleStats(sdk.AccAddress("oracle"))
+		stats.JailedUntil = -1
+		require.Error(t, stats.Valid())
+	}
+}
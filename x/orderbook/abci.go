@@ -1,38 +1,100 @@
 package orderbook
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	dnTypes "github.com/dfinance/dnode/helpers/types"
-	"github.com/dfinance/dnode/x/orders"
+	"github.com/dfinance/dnode/x/markets"
 )
 
-// EndBlocker iterates over Orders module orders, processes them and returns back to the Order module.
+// EndBlocker reads Orders module orders market by market, processes them and returns back to the Order module.
+// Orders of markets with status that doesn't allow matching (post-only, halted, delisted) are skipped.
+// Self-trade prevention adjustments (canceled / decremented orders) are applied before the market order fills
+// (even if market orders weren't matched or the market circuit breaker has tripped).
+// Market matching is skipped if the clearance price breaches the market circuit breaker deviation band,
+// market might also be switched to post-only for the configured number of blocks (resumed at the end of the last one).
+// Immediate (ioc/fok) orders leftovers are revoked (refunded) after all order fills are processed.
+// Stop orders are triggered using the updated clearance / oracle prices and join the next block matching.
+// Candles out of the retention period are pruned.
 func EndBlocker(ctx sdk.Context, k Keeper) []abci.ValidatorUpdate {
-	iterator := k.GetOrderIterator(ctx)
-	defer iterator.Close()
-
+	immediateOrderIDs := make([]dnTypes.ID, 0)
+	matcherMarkets := make(map[string]markets.Market)
 	matcherPool := NewMatcherPool(k.GetLogger(ctx))
-	for ; iterator.Valid(); iterator.Next() {
-		order := orders.Order{}
-		ModuleCdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &order)
+	for _, market := range k.GetMarkets(ctx) {
+		marketOrders, err := k.GetMarketOrders(ctx, market.ID)
+		if err != nil {
+			panic(fmt.Errorf("reading market %q orders: %w", market.ID, err))
+		}
+
+		matchingAllowed := market.GetStatus().AllowsMatching()
+		if matchingAllowed {
+			matcherMarkets[market.ID.String()] = market
+		}
 
-		if err := matcherPool.AddOrder(order); err != nil {
-			panic(err)
+		for _, order := range marketOrders {
+			if order.GetTimeInForce().IsImmediate() {
+				immediateOrderIDs = append(immediateOrderIDs, order.ID)
+			}
+
+			if !matchingAllowed {
+				continue
+			}
+
+			if err := matcherPool.AddOrder(order); err != nil {
+				panic(err)
+			}
 		}
 	}
 
-	resultCnt := 0
+	resultCnt, haltedCnt := 0, 0
 	for _, result := range matcherPool.Process() {
-		k.ProcessOrderFills(ctx, result.OrderFills)
-		k.SetHistoryItem(ctx, NewHistoryItem(ctx, result))
+		if err := k.ApplySelfTradeAdjustments(ctx, result.MarketID, result.SelfTradeAdjustments); err != nil {
+			panic(fmt.Errorf("market %q: applying self-trade adjustments: %w", result.MarketID, err))
+		}
+
+		if len(result.OrderFills) > 0 {
+			market := matcherMarkets[result.MarketID.String()]
+			if refPrice, breached := k.CheckCircuitBreaker(ctx, market, result.ClearanceState.Price); breached {
+				resumeHeight, err := k.HaltMarket(ctx, market)
+				if err != nil {
+					panic(fmt.Errorf("market %q: halting: %w", result.MarketID, err))
+				}
+
+				k.GetLogger(ctx).Info(fmt.Sprintf("Market %q circuit breaker: clearance price %s / reference price %s", result.MarketID, result.ClearanceState.Price, refPrice))
+				ctx.EventManager().EmitEvent(NewCircuitBreakerEvent(result.MarketID, result.ClearanceState.Price, refPrice, resumeHeight))
+				haltedCnt++
+				continue
+			}
+		}
+
+		if len(result.OrderFills) == 0 {
+			continue
+		}
+
+		fees := k.ProcessOrderFills(ctx, result.OrderFills)
+		k.SetHistoryItem(ctx, NewHistoryItem(ctx, result, fees))
 
 		resultCnt++
 		ctx.EventManager().EmitEvent(NewClearanceEvent(result))
 	}
 
-	if resultCnt > 0 {
+	for _, orderID := range immediateOrderIDs {
+		if err := k.RevokeOrderLeftover(ctx, orderID); err != nil {
+			k.GetLogger(ctx).Error(fmt.Sprintf("Revoking immediate order %q leftover: %v", orderID, err))
+		}
+	}
+
+	triggeredCnt := k.TriggerStopOrders(ctx)
+	resumedCnt := k.ResumeMarkets(ctx)
+
+	if prunedCnt := k.PruneCandles(ctx); prunedCnt > 0 {
+		k.GetLogger(ctx).Debug(fmt.Sprintf("Candles pruned: %d", prunedCnt))
+	}
+
+	if resultCnt > 0 || triggeredCnt > 0 || haltedCnt > 0 || resumedCnt > 0 {
 		ctx.EventManager().EmitEvent(dnTypes.NewModuleNameEvent(ModuleName))
 	}
 
@@ -6,20 +6,57 @@ import (
 )
 
 type (
-	Keeper       = keeper.Keeper
-	GenesisState = types.GenesisState
-	HistoryItem  = types.HistoryItem
-	HistoryItems = types.HistoryItems
+	Keeper         = keeper.Keeper
+	GenesisState   = types.GenesisState
+	HistoryItem    = types.HistoryItem
+	HistoryItems   = types.HistoryItems
+	Candle         = types.Candle
+	CandleInterval = types.CandleInterval
+	Candles        = types.Candles
+	Params         = types.Params
+	// Self-trade prevention
+	SelfTradeAdjustment  = types.SelfTradeAdjustment
+	SelfTradeAdjustments = types.SelfTradeAdjustments
+	// Circuit breaker
+	MarketHalt  = types.MarketHalt
+	MarketHalts = types.MarketHalts
+	// Querier types
+	MarketReq  = types.MarketReq
+	HistoryReq = types.HistoryReq
+	CandlesReq = types.CandlesReq
+	Depth      = types.Depth
+	Simulation = types.Simulation
 )
 
 const (
 	ModuleName = types.ModuleName
 	StoreKey   = types.StoreKey
+	// Default params subspace name
+	DefaultParamspace = types.DefaultParamspace
+	// Candle intervals
+	CandleInterval1m = types.CandleInterval1m
+	CandleInterval5m = types.CandleInterval5m
+	CandleInterval1h = types.CandleInterval1h
+	CandleInterval1d = types.CandleInterval1d
+	// Querier endpoints
+	QueryDepth     = types.QueryDepth
+	QueryClearance = types.QueryClearance
+	QuerySimulate  = types.QuerySimulate
+	QueryHistory   = types.QueryHistory
+	QueryCandles   = types.QueryCandles
 	// Event types, attribute types and values
-	EventTypeClearance = types.EventTypeClearance
+	EventTypeClearance           = types.EventTypeClearance
+	EventTypeSelfTradePrevention = types.EventTypeSelfTradePrevention
+	EventTypeCircuitBreaker      = types.EventTypeCircuitBreaker
 	//
-	AttributeMarketId = types.AttributeMarketId
-	AttributePrice    = types.AttributePrice
+	AttributeMarketId         = types.AttributeMarketId
+	AttributePrice            = types.AttributePrice
+	AttributeOrderId          = types.AttributeOrderId
+	AttributeOwner            = types.AttributeOwner
+	AttributeQuantityCanceled = types.AttributeQuantityCanceled
+	AttributeQuantityLeft     = types.AttributeQuantityLeft
+	AttributeReferencePrice   = types.AttributeReferencePrice
+	AttributeResumeHeight     = types.AttributeResumeHeight
 )
 
 var (
@@ -27,12 +64,19 @@ var (
 	ModuleCdc            = types.ModuleCdc
 	AvailablePermissions = types.AvailablePermissions
 	DefaultGenesisState  = types.DefaultGenesisState
+	DefaultParams        = types.DefaultParams
 	// function aliases
-	RegisterCodec     = types.RegisterCodec
-	NewHistoryItem    = types.NewHistoryItem
-	NewClearanceEvent = types.NewClearanceEvent
-	NewKeeper         = keeper.NewKeeper
-	NewMatcherPool    = keeper.NewMatcherPool
+	RegisterCodec               = types.RegisterCodec
+	NewHistoryItem              = types.NewHistoryItem
+	NewClearanceEvent           = types.NewClearanceEvent
+	NewSelfTradePreventionEvent = types.NewSelfTradePreventionEvent
+	NewCircuitBreakerEvent      = types.NewCircuitBreakerEvent
+	NewKeeper                   = keeper.NewKeeper
+	NewMatcherPool              = keeper.NewMatcherPool
+	NewQuerier                  = keeper.NewQuerier
+	RegisterInvariants          = keeper.RegisterInvariants
 	// perms requests
-	RequestOrdersPerms = types.RequestOrdersPerms
+	RequestOrdersPerms  = types.RequestOrdersPerms
+	RequestOraclePerms  = types.RequestOraclePerms
+	RequestMarketsPerms = types.RequestMarketsPerms
 )
@@ -0,0 +1,272 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/spf13/cobra"
+
+	"github.com/dfinance/dnode/helpers"
+	"github.com/dfinance/dnode/x/orderbook/internal/types"
+)
+
+// GetCmdDepth returns query command that returns market bid / ask aggregated depth.
+func GetCmdDepth(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "depth [marketID]",
+		Example: "depth 0",
+		Short:   "Get market bid / ask aggregated depth",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCLIContext().WithCodec(cdc)
+
+			// parse inputs
+			marketID, err := helpers.ParseDnIDParam("marketID", args[0], helpers.ParamTypeCliArg)
+			if err != nil {
+				return err
+			}
+
+			// prepare request
+			req := types.MarketReq{
+				MarketID: marketID,
+			}
+
+			bz, err := ctx.Codec.MarshalJSON(req)
+			if err != nil {
+				return err
+			}
+
+			// query and parse the result
+			res, _, err := ctx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryDepth), bz)
+			if err != nil {
+				return err
+			}
+
+			var out types.Depth
+			cdc.MustUnmarshalJSON(res, &out)
+
+			return ctx.PrintOutput(out)
+		},
+	}
+	helpers.BuildCmdHelp(cmd, []string{
+		"market ID [uint]",
+	})
+
+	return cmd
+}
+
+// GetCmdClearance returns query command that returns market last clearance state.
+func GetCmdClearance(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "clearance [marketID]",
+		Example: "clearance 0",
+		Short:   "Get market last clearance state (history item)",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCLIContext().WithCodec(cdc)
+
+			// parse inputs
+			marketID, err := helpers.ParseDnIDParam("marketID", args[0], helpers.ParamTypeCliArg)
+			if err != nil {
+				return err
+			}
+
+			// prepare request
+			req := types.MarketReq{
+				MarketID: marketID,
+			}
+
+			bz, err := ctx.Codec.MarshalJSON(req)
+			if err != nil {
+				return err
+			}
+
+			// query and parse the result
+			res, _, err := ctx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryClearance), bz)
+			if err != nil {
+				return err
+			}
+
+			var out types.HistoryItem
+			cdc.MustUnmarshalJSON(res, &out)
+
+			return ctx.PrintOutput(out)
+		},
+	}
+	helpers.BuildCmdHelp(cmd, []string{
+		"market ID [uint]",
+	})
+
+	return cmd
+}
+
+// GetCmdSimulate returns query command that returns matching simulation result for current market orders.
+func GetCmdSimulate(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "simulate [marketID]",
+		Example: "simulate 0",
+		Short:   "Simulate market orders matching (matcher result and supply-demand curves)",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCLIContext().WithCodec(cdc)
+
+			// parse inputs
+			marketID, err := helpers.ParseDnIDParam("marketID", args[0], helpers.ParamTypeCliArg)
+			if err != nil {
+				return err
+			}
+
+			// prepare request
+			req := types.MarketReq{
+				MarketID: marketID,
+			}
+
+			bz, err := ctx.Codec.MarshalJSON(req)
+			if err != nil {
+				return err
+			}
+
+			// query and parse the result
+			res, _, err := ctx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QuerySimulate), bz)
+			if err != nil {
+				return err
+			}
+
+			var out types.Simulation
+			cdc.MustUnmarshalJSON(res, &out)
+
+			return ctx.PrintOutput(out)
+		},
+	}
+	helpers.BuildCmdHelp(cmd, []string{
+		"market ID [uint]",
+	})
+
+	return cmd
+}
+
+// GetCmdHistory returns query command that returns market history items within block height range.
+func GetCmdHistory(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "history [marketID] [startHeight] [endHeight]",
+		Example: "history 0 1 100",
+		Short:   "Get market history items within block height range",
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCLIContext().WithCodec(cdc)
+
+			// parse inputs
+			marketID, err := helpers.ParseDnIDParam("marketID", args[0], helpers.ParamTypeCliArg)
+			if err != nil {
+				return err
+			}
+
+			startHeight, err := helpers.ParseInt64Param("startHeight", args[1], helpers.ParamTypeCliArg)
+			if err != nil {
+				return err
+			}
+
+			endHeight, err := helpers.ParseInt64Param("endHeight", args[2], helpers.ParamTypeCliArg)
+			if err != nil {
+				return err
+			}
+
+			// prepare request
+			req := types.HistoryReq{
+				MarketID:    marketID,
+				StartHeight: startHeight,
+				EndHeight:   endHeight,
+			}
+
+			bz, err := ctx.Codec.MarshalJSON(req)
+			if err != nil {
+				return err
+			}
+
+			// query and parse the result
+			res, _, err := ctx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryHistory), bz)
+			if err != nil {
+				return err
+			}
+
+			var out types.HistoryItems
+			cdc.MustUnmarshalJSON(res, &out)
+
+			return ctx.PrintOutput(out)
+		},
+	}
+	helpers.BuildCmdHelp(cmd, []string{
+		"market ID [uint]",
+		"block height range start (inclusive) [int]",
+		"block height range end (inclusive) [int]",
+	})
+
+	return cmd
+}
+
+// GetCmdCandles returns query command that returns market OHLCV candles within start time range.
+func GetCmdCandles(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "candles [marketID] [interval] [startTime] [endTime]",
+		Example: "candles 0 1h 1600000000 1600086400",
+		Short:   "Get market OHLCV candles within start time range",
+		Args:    cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCLIContext().WithCodec(cdc)
+
+			// parse inputs
+			marketID, err := helpers.ParseDnIDParam("marketID", args[0], helpers.ParamTypeCliArg)
+			if err != nil {
+				return err
+			}
+
+			interval := types.CandleInterval(args[1])
+			if err := interval.Validate(); err != nil {
+				return fmt.Errorf("%s argument %q parse error: %w", "interval", args[1], err)
+			}
+
+			startTime, err := helpers.ParseInt64Param("startTime", args[2], helpers.ParamTypeCliArg)
+			if err != nil {
+				return err
+			}
+
+			endTime, err := helpers.ParseInt64Param("endTime", args[3], helpers.ParamTypeCliArg)
+			if err != nil {
+				return err
+			}
+
+			// prepare request
+			req := types.CandlesReq{
+				MarketID:  marketID,
+				Interval:  interval,
+				StartTime: startTime,
+				EndTime:   endTime,
+			}
+
+			bz, err := ctx.Codec.MarshalJSON(req)
+			if err != nil {
+				return err
+			}
+
+			// query and parse the result
+			res, _, err := ctx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryCandles), bz)
+			if err != nil {
+				return err
+			}
+
+			var out types.Candles
+			cdc.MustUnmarshalJSON(res, &out)
+
+			return ctx.PrintOutput(out)
+		},
+	}
+	helpers.BuildCmdHelp(cmd, []string{
+		"market ID [uint]",
+		"candle interval [1m/5m/1h/1d]",
+		"candle start time range start UNIX timestamp in seconds (inclusive) [int]",
+		"candle start time range end UNIX timestamp in seconds (inclusive) [int]",
+	})
+
+	return cmd
+}
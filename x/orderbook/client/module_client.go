@@ -0,0 +1,28 @@
+package client
+
+import (
+	sdkClient "github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+	amino "github.com/tendermint/go-amino"
+
+	"github.com/dfinance/dnode/x/orderbook/client/cli"
+	"github.com/dfinance/dnode/x/orderbook/internal/types"
+)
+
+// GetQueryCmd returns module query commands.
+func GetQueryCmd(cdc *amino.Codec) *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:   types.ModuleName,
+		Short: "Querying commands for the orderbook module",
+	}
+
+	queryCmd.AddCommand(sdkClient.GetCommands(
+		cli.GetCmdDepth(types.ModuleName, cdc),
+		cli.GetCmdClearance(types.ModuleName, cdc),
+		cli.GetCmdSimulate(types.ModuleName, cdc),
+		cli.GetCmdHistory(types.ModuleName, cdc),
+		cli.GetCmdCandles(types.ModuleName, cdc),
+	)...)
+
+	return queryCmd
+}
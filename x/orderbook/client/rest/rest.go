@@ -0,0 +1,240 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/gorilla/mux"
+
+	"github.com/dfinance/dnode/helpers"
+	"github.com/dfinance/dnode/x/orderbook/internal/types"
+)
+
+const (
+	MarketID    = "marketID"
+	StartHeight = "startHeight"
+	EndHeight   = "endHeight"
+	Interval    = "interval"
+	StartTime   = "startTime"
+	EndTime     = "endTime"
+)
+
+// RegisterRoutes adds endpoint to REST router.
+func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	r.HandleFunc(fmt.Sprintf("/%s/depth/{%s}", types.ModuleName, MarketID), getDepth(cliCtx)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/clearance/{%s}", types.ModuleName, MarketID), getClearance(cliCtx)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/simulate/{%s}", types.ModuleName, MarketID), getSimulation(cliCtx)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/history/{%s}", types.ModuleName, MarketID), getHistory(cliCtx)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/candles/{%s}", types.ModuleName, MarketID), getCandles(cliCtx)).Methods("GET")
+}
+
+// GetDepth godoc
+// @Tags OrderBook
+// @Summary Get market depth
+// @Description Get market bid / ask aggregated depth levels
+// @ID orderbookGetDepth
+// @Accept  json
+// @Produce json
+// @Param marketID path string true "marketID"
+// @Success 200 {object} OrderBookRespGetDepth
+// @Failure 400 {object} rest.ErrorResponse "Returned if the request doesn't have valid query/path params"
+// @Failure 500 {object} rest.ErrorResponse "Returned on server error"
+// @Router /orderbook/depth/{marketID} [get]
+func getDepth(cliCtx context.CLIContext) http.HandlerFunc {
+	return getMarketQueryHandler(cliCtx, types.QueryDepth)
+}
+
+// GetClearance godoc
+// @Tags OrderBook
+// @Summary Get market last clearance
+// @Description Get market last clearance state (HistoryItem object)
+// @ID orderbookGetClearance
+// @Accept  json
+// @Produce json
+// @Param marketID path string true "marketID"
+// @Success 200 {object} OrderBookRespGetClearance
+// @Failure 400 {object} rest.ErrorResponse "Returned if the request doesn't have valid query/path params"
+// @Failure 500 {object} rest.ErrorResponse "Returned on server error"
+// @Router /orderbook/clearance/{marketID} [get]
+func getClearance(cliCtx context.CLIContext) http.HandlerFunc {
+	return getMarketQueryHandler(cliCtx, types.QueryClearance)
+}
+
+// GetSimulation godoc
+// @Tags OrderBook
+// @Summary Simulate market matching
+// @Description Get matcher result and supply-demand curves for current market orders (state is not changed)
+// @ID orderbookGetSimulation
+// @Accept  json
+// @Produce json
+// @Param marketID path string true "marketID"
+// @Success 200 {object} OrderBookRespGetSimulation
+// @Failure 400 {object} rest.ErrorResponse "Returned if the request doesn't have valid query/path params"
+// @Failure 500 {object} rest.ErrorResponse "Returned on server error"
+// @Router /orderbook/simulate/{marketID} [get]
+func getSimulation(cliCtx context.CLIContext) http.HandlerFunc {
+	return getMarketQueryHandler(cliCtx, types.QuerySimulate)
+}
+
+// GetHistory godoc
+// @Tags OrderBook
+// @Summary Get market history
+// @Description Get array of HistoryItem objects within block height range
+// @ID orderbookGetHistory
+// @Accept  json
+// @Produce json
+// @Param marketID path string true "marketID"
+// @Param startHeight query int true "block height range start (inclusive)"
+// @Param endHeight query int true "block height range end (inclusive)"
+// @Success 200 {object} OrderBookRespGetHistory
+// @Failure 400 {object} rest.ErrorResponse "Returned if the request doesn't have valid query/path params"
+// @Failure 500 {object} rest.ErrorResponse "Returned on server error"
+// @Router /orderbook/history/{marketID} [get]
+func getHistory(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// parse inputs
+		vars := mux.Vars(r)
+		marketID, err := helpers.ParseDnIDParam(MarketID, vars[MarketID], helpers.ParamTypeRestPath)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		startHeight, err := helpers.ParseInt64Param(StartHeight, r.URL.Query().Get(StartHeight), helpers.ParamTypeRestQuery)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		endHeight, err := helpers.ParseInt64Param(EndHeight, r.URL.Query().Get(EndHeight), helpers.ParamTypeRestQuery)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// prepare request
+		req := types.HistoryReq{
+			MarketID:    marketID,
+			StartHeight: startHeight,
+			EndHeight:   endHeight,
+		}
+
+		bz, err := cliCtx.Codec.MarshalJSON(req)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		// query and parse the result
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.ModuleName, types.QueryHistory), bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// GetCandles godoc
+// @Tags OrderBook
+// @Summary Get market candles
+// @Description Get array of OHLCV Candle objects for the interval within start time range
+// @ID orderbookGetCandles
+// @Accept  json
+// @Produce json
+// @Param marketID path string true "marketID"
+// @Param interval query string true "candle interval (1m/5m/1h/1d)"
+// @Param startTime query int true "candle start time range start UNIX timestamp [s] (inclusive)"
+// @Param endTime query int true "candle start time range end UNIX timestamp [s] (inclusive)"
+// @Success 200 {object} OrderBookRespGetCandles
+// @Failure 400 {object} rest.ErrorResponse "Returned if the request doesn't have valid query/path params"
+// @Failure 500 {object} rest.ErrorResponse "Returned on server error"
+// @Router /orderbook/candles/{marketID} [get]
+func getCandles(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// parse inputs
+		vars := mux.Vars(r)
+		marketID, err := helpers.ParseDnIDParam(MarketID, vars[MarketID], helpers.ParamTypeRestPath)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		interval := types.CandleInterval(r.URL.Query().Get(Interval))
+		if err := interval.Validate(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("%s param parsing: %v", Interval, err))
+			return
+		}
+
+		startTime, err := helpers.ParseInt64Param(StartTime, r.URL.Query().Get(StartTime), helpers.ParamTypeRestQuery)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		endTime, err := helpers.ParseInt64Param(EndTime, r.URL.Query().Get(EndTime), helpers.ParamTypeRestQuery)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// prepare request
+		req := types.CandlesReq{
+			MarketID:  marketID,
+			Interval:  interval,
+			StartTime: startTime,
+			EndTime:   endTime,
+		}
+
+		bz, err := cliCtx.Codec.MarshalJSON(req)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		// query and parse the result
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.ModuleName, types.QueryCandles), bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// getMarketQueryHandler builds handler for the query endpoint with marketID path param.
+func getMarketQueryHandler(cliCtx context.CLIContext, queryEndpoint string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// parse inputs
+		vars := mux.Vars(r)
+		marketID, err := helpers.ParseDnIDParam(MarketID, vars[MarketID], helpers.ParamTypeRestPath)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// prepare request
+		req := types.MarketReq{
+			MarketID: marketID,
+		}
+
+		bz, err := cliCtx.Codec.MarshalJSON(req)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		// query and parse the result
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.ModuleName, queryEndpoint), bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
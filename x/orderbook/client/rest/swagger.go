@@ -0,0 +1,33 @@
+package rest
+
+import (
+	"github.com/dfinance/dnode/x/orderbook/internal/types"
+)
+
+//nolint:deadcode,unused
+type (
+	OrderBookRespGetDepth struct {
+		Height int64       `json:"height"`
+		Result types.Depth `json:"result"`
+	}
+
+	OrderBookRespGetClearance struct {
+		Height int64             `json:"height"`
+		Result types.HistoryItem `json:"result"`
+	}
+
+	OrderBookRespGetSimulation struct {
+		Height int64            `json:"height"`
+		Result types.Simulation `json:"result"`
+	}
+
+	OrderBookRespGetHistory struct {
+		Height int64              `json:"height"`
+		Result types.HistoryItems `json:"result"`
+	}
+
+	OrderBookRespGetCandles struct {
+		Height int64         `json:"height"`
+		Result types.Candles `json:"result"`
+	}
+)
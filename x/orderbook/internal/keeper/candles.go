@@ -0,0 +1,143 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	dnTypes "github.com/dfinance/dnode/helpers/types"
+	"github.com/dfinance/dnode/x/orderbook/internal/types"
+)
+
+// GetCandles returns market candles for the interval with start time within [startTime:endTime] range.
+func (k Keeper) GetCandles(ctx sdk.Context, marketID dnTypes.ID, interval types.CandleInterval, startTime, endTime int64) (types.Candles, error) {
+	k.modulePerms.AutoCheck(types.PermHistoryRead)
+
+	if err := interval.Validate(); err != nil {
+		return nil, sdkErrors.Wrap(types.ErrWrongCandle, err.Error())
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	startKey := types.GetCandleKey(marketID, interval, startTime)
+	endKey := types.GetCandleKey(marketID, interval, endTime+1)
+
+	iterator := store.Iterator(startKey, endKey)
+	defer iterator.Close()
+
+	candles := types.Candles{}
+	for ; iterator.Valid(); iterator.Next() {
+		candle := types.Candle{}
+		if err := k.cdc.UnmarshalBinaryLengthPrefixed(iterator.Value(), &candle); err != nil {
+			return types.Candles{}, sdkErrors.Wrap(types.ErrInternal, "candle unmarshal")
+		}
+
+		candles = append(candles, candle)
+	}
+
+	return candles, nil
+}
+
+// GetCandlesList return all candles.
+func (k Keeper) GetCandlesList(ctx sdk.Context) types.Candles {
+	k.modulePerms.AutoCheck(types.PermHistoryRead)
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.CandleKeyPrefix)
+	defer iterator.Close()
+
+	candles := types.Candles{}
+	for ; iterator.Valid(); iterator.Next() {
+		candle := types.Candle{}
+		if err := k.cdc.UnmarshalBinaryLengthPrefixed(iterator.Value(), &candle); err != nil {
+			panic(fmt.Errorf("candle unmarshal: %w", err))
+		}
+
+		candles = append(candles, candle)
+	}
+
+	return candles
+}
+
+// PruneCandles removes candles with end time older than the retention period param.
+// Returns number of candles removed.
+func (k Keeper) PruneCandles(ctx sdk.Context) int {
+	k.modulePerms.AutoCheck(types.PermHistoryWrite)
+
+	retention := k.GetParams(ctx).CandleRetention
+	if retention == 0 {
+		return 0
+	}
+	cutoffTime := ctx.BlockTime().Add(-retention).Unix()
+
+	store := ctx.KVStore(k.storeKey)
+	prunedCnt := 0
+	for _, market := range k.GetMarkets(ctx) {
+		for _, interval := range types.CandleIntervals {
+			bucketLen := int64(interval.Duration() / time.Second)
+
+			// candles are sorted by start time, so iteration stops at the first one within the retention period
+			keysToDelete := make([][]byte, 0)
+			iterator := sdk.KVStorePrefixIterator(store, types.GetCandlesPrefix(market.ID, interval))
+			for ; iterator.Valid(); iterator.Next() {
+				candle := types.Candle{}
+				k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &candle)
+				if candle.StartTime+bucketLen > cutoffTime {
+					break
+				}
+
+				keysToDelete = append(keysToDelete, iterator.Key())
+			}
+			iterator.Close()
+
+			for _, key := range keysToDelete {
+				store.Delete(key)
+			}
+			prunedCnt += len(keysToDelete)
+		}
+	}
+
+	return prunedCnt
+}
+
+// updateCandles updates market candles of all intervals with history item clearance results.
+// History items without matched volume are skipped as there were no trades.
+func (k Keeper) updateCandles(ctx sdk.Context, item types.HistoryItem) {
+	if item.MatchedBidVolume.IsZero() {
+		return
+	}
+
+	for _, interval := range types.CandleIntervals {
+		candle, found := k.getCandle(ctx, item.MarketID, interval, interval.StartTime(item.Timestamp))
+		if !found {
+			candle = types.NewCandle(interval, item)
+		} else {
+			candle.Update(item)
+		}
+
+		k.setCandle(ctx, candle)
+	}
+}
+
+// getCandle gets market candle by interval and start time.
+func (k Keeper) getCandle(ctx sdk.Context, marketID dnTypes.ID, interval types.CandleInterval, startTime int64) (types.Candle, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetCandleKey(marketID, interval, startTime))
+	if bz == nil {
+		return types.Candle{}, false
+	}
+
+	candle := types.Candle{}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &candle)
+
+	return candle, true
+}
+
+// setCandle sets candle to the storage.
+func (k Keeper) setCandle(ctx sdk.Context, candle types.Candle) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetCandleKey(candle.MarketID, candle.Interval, candle.StartTime)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(candle)
+	store.Set(key, bz)
+}
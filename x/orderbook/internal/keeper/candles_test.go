@@ -0,0 +1,172 @@
+// +build unit
+
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/dfinance/dnode/helpers/perms"
+	"github.com/dfinance/dnode/x/markets"
+	marketsClient "github.com/dfinance/dnode/x/markets/client"
+	"github.com/dfinance/dnode/x/orderbook/internal/types"
+)
+
+func TestOBKeeper_Candles(t *testing.T) {
+	input := NewTestInput(t)
+	keeper := input.keeper
+
+	// test keeper with extra permissions to create a market
+	marketKeeper := markets.NewKeeper(
+		input.cdc,
+		input.keyMarkets,
+		params.NewSubspace(input.cdc, input.keyParams, input.tKeyParams, markets.DefaultParamspace),
+		input.ccsKeeper,
+		func() (moduleName string, modulePerms perms.Permissions) {
+			return types.ModuleName, perms.Permissions{marketsClient.PermCreate, marketsClient.PermRead}
+		},
+	)
+	market, err := marketKeeper.Add(input.ctx, input.baseBtcDenom, input.quoteDenom)
+	require.NoError(t, err)
+
+	newItem := func(blockHeight, timestamp int64, price, volume uint64) types.HistoryItem {
+		item := NewMockHistoryItem(market.ID, blockHeight)
+		item.Timestamp = timestamp
+		item.ClearancePrice = sdk.NewUint(price)
+		item.MatchedBidVolume = sdk.NewUint(volume)
+
+		return item
+	}
+
+	// 2020-09-14 00:00:00 UTC
+	dayStart := int64(1600041600)
+
+	// two 1m buckets within one 5m bucket, one more 5m bucket within the same hour
+	keeper.SetHistoryItem(input.ctx, newItem(1, dayStart+5, 100, 10))
+	keeper.SetHistoryItem(input.ctx, newItem(2, dayStart+30, 120, 20))
+	keeper.SetHistoryItem(input.ctx, newItem(3, dayStart+70, 90, 30))
+	keeper.SetHistoryItem(input.ctx, newItem(4, dayStart+400, 110, 40))
+	// no matched volume: skipped
+	keeper.SetHistoryItem(input.ctx, newItem(5, dayStart+410, 500, 0))
+
+	checkCandle := func(candle types.Candle, interval types.CandleInterval, startTime int64, open, high, low, close, volume uint64) {
+		require.True(t, candle.MarketID.Equal(market.ID))
+		require.Equal(t, interval, candle.Interval)
+		require.Equal(t, startTime, candle.StartTime)
+		require.Equal(t, sdk.NewUint(open).String(), candle.Open.String(), "open")
+		require.Equal(t, sdk.NewUint(high).String(), candle.High.String(), "high")
+		require.Equal(t, sdk.NewUint(low).String(), candle.Low.String(), "low")
+		require.Equal(t, sdk.NewUint(close).String(), candle.Close.String(), "close")
+		require.Equal(t, sdk.NewUint(volume).String(), candle.Volume.String(), "volume")
+	}
+
+	// check candles
+	{
+		candles, err := keeper.GetCandles(input.ctx, market.ID, types.CandleInterval1m, 0, dayStart+3600)
+		require.NoError(t, err)
+		require.Len(t, candles, 3)
+		checkCandle(candles[0], types.CandleInterval1m, dayStart, 100, 120, 100, 120, 30)
+		checkCandle(candles[1], types.CandleInterval1m, dayStart+60, 90, 90, 90, 90, 30)
+		checkCandle(candles[2], types.CandleInterval1m, dayStart+360, 110, 110, 110, 110, 40)
+
+		candles, err = keeper.GetCandles(input.ctx, market.ID, types.CandleInterval5m, 0, dayStart+3600)
+		require.NoError(t, err)
+		require.Len(t, candles, 2)
+		checkCandle(candles[0], types.CandleInterval5m, dayStart, 100, 120, 90, 90, 60)
+		checkCandle(candles[1], types.CandleInterval5m, dayStart+300, 110, 110, 110, 110, 40)
+
+		candles, err = keeper.GetCandles(input.ctx, market.ID, types.CandleInterval1h, 0, dayStart+3600)
+		require.NoError(t, err)
+		require.Len(t, candles, 1)
+		checkCandle(candles[0], types.CandleInterval1h, dayStart, 100, 120, 90, 110, 100)
+
+		candles, err = keeper.GetCandles(input.ctx, market.ID, types.CandleInterval1d, 0, dayStart)
+		require.NoError(t, err)
+		require.Len(t, candles, 1)
+		checkCandle(candles[0], types.CandleInterval1d, dayStart, 100, 120, 90, 110, 100)
+	}
+
+	// check time range
+	{
+		candles, err := keeper.GetCandles(input.ctx, market.ID, types.CandleInterval1m, dayStart+60, dayStart+360)
+		require.NoError(t, err)
+		require.Len(t, candles, 2)
+		require.Equal(t, dayStart+60, candles[0].StartTime)
+		require.Equal(t, dayStart+360, candles[1].StartTime)
+
+		candles, err = keeper.GetCandles(input.ctx, market.ID, types.CandleInterval1m, dayStart+1, dayStart+59)
+		require.NoError(t, err)
+		require.Len(t, candles, 0)
+
+		_, err = keeper.GetCandles(input.ctx, market.ID, "2m", 0, dayStart)
+		require.Error(t, err)
+	}
+
+	// check querier
+	{
+		querier := NewQuerier(keeper)
+		res, err := querier(input.ctx, []string{types.QueryCandles}, abci.RequestQuery{
+			Data: input.cdc.MustMarshalJSON(types.CandlesReq{MarketID: market.ID, Interval: types.CandleInterval5m, StartTime: 0, EndTime: dayStart + 3600}),
+		})
+		require.NoError(t, err)
+
+		candles := types.Candles{}
+		require.NoError(t, input.cdc.UnmarshalJSON(res, &candles))
+		require.Len(t, candles, 2)
+
+		_, err = querier(input.ctx, []string{types.QueryCandles}, abci.RequestQuery{
+			Data: input.cdc.MustMarshalJSON(types.CandlesReq{MarketID: market.ID, Interval: types.CandleInterval5m, StartTime: 1, EndTime: 0}),
+		})
+		require.Error(t, err)
+	}
+
+	// check genesis export / import doesn't rebuild candles
+	{
+		var state types.GenesisState
+		input.cdc.MustUnmarshalJSON(keeper.ExportGenesis(input.ctx), &state)
+		require.Equal(t, types.DefaultParams(), state.Params)
+		require.Len(t, state.Candles, 3+2+1+1)
+
+		ctx := input.ctx.WithBlockTime(time.Unix(dayStart+3600, 0)).WithBlockHeight(5)
+		keeper.InitGenesis(ctx, input.cdc.MustMarshalJSON(state))
+		require.Equal(t, state.Candles, keeper.GetCandlesList(ctx))
+	}
+
+	// check pruning
+	{
+		keeper.SetParams(input.ctx, types.NewParams(types.MinCandleRetention))
+
+		// retention period covers all candles
+		ctx := input.ctx.WithBlockTime(time.Unix(dayStart+3600, 0))
+		require.Equal(t, 0, keeper.PruneCandles(ctx))
+
+		// 1m candles ending before dayStart+400 and 5m candle ending before dayStart+400 are pruned
+		ctx = input.ctx.WithBlockTime(time.Unix(dayStart+400, 0).Add(types.MinCandleRetention))
+		require.Equal(t, 3, keeper.PruneCandles(ctx))
+
+		candles, err := keeper.GetCandles(ctx, market.ID, types.CandleInterval1m, 0, dayStart+3600)
+		require.NoError(t, err)
+		require.Len(t, candles, 1)
+		require.Equal(t, dayStart+360, candles[0].StartTime)
+
+		candles, err = keeper.GetCandles(ctx, market.ID, types.CandleInterval5m, 0, dayStart+3600)
+		require.NoError(t, err)
+		require.Len(t, candles, 1)
+		require.Equal(t, dayStart+300, candles[0].StartTime)
+
+		// pruning is disabled
+		keeper.SetParams(input.ctx, types.NewParams(0))
+		ctx = input.ctx.WithBlockTime(time.Unix(dayStart, 0).Add(365 * types.MinCandleRetention))
+		require.Equal(t, 0, keeper.PruneCandles(ctx))
+
+		// everything is pruned
+		keeper.SetParams(input.ctx, types.NewParams(types.MinCandleRetention))
+		require.Equal(t, 4, keeper.PruneCandles(ctx))
+		require.Len(t, keeper.GetCandlesList(ctx), 0)
+	}
+}
@@ -0,0 +1,124 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	dnTypes "github.com/dfinance/dnode/helpers/types"
+	"github.com/dfinance/dnode/x/markets"
+	"github.com/dfinance/dnode/x/orderbook/internal/types"
+)
+
+// CheckCircuitBreaker checks that the market clearance price is within the market max deviation band.
+// Reference price is the previous market clearance price or the oracle current price (market params).
+// Check is skipped if the band is disabled or the reference price is not available.
+// Returns the reference price and the breach flag.
+func (k Keeper) CheckCircuitBreaker(ctx sdk.Context, market markets.Market, price sdk.Uint) (sdk.Uint, bool) {
+	k.modulePerms.AutoCheck(types.PermCircuitBreaker)
+
+	maxDeviation := market.GetMaxPriceDeviation()
+	if !maxDeviation.IsPositive() {
+		return sdk.ZeroUint(), false
+	}
+
+	refPrice := sdk.ZeroUint()
+	switch market.GetPriceReference() {
+	case markets.PriceReferenceClearance:
+		refPrice = k.getLastClearancePrice(ctx, market.ID)
+	case markets.PriceReferenceOracle:
+		refPrice = k.getOraclePrice(ctx, market)
+	}
+	if refPrice.IsZero() {
+		return refPrice, false
+	}
+
+	priceDec, refPriceDec := sdk.NewDecFromBigInt(price.BigInt()), sdk.NewDecFromBigInt(refPrice.BigInt())
+	deviation := priceDec.Sub(refPriceDec).Abs().Quo(refPriceDec)
+
+	return refPrice, deviation.GT(maxDeviation)
+}
+
+// HaltMarket switches market to post-only for the market halt period (circuit breaker trip).
+// Market is switched back to the pre-halt status by ResumeMarkets.
+// Returns resume block height (zero if market halt period is not set and status is not changed).
+func (k Keeper) HaltMarket(ctx sdk.Context, market markets.Market) (int64, error) {
+	k.modulePerms.AutoCheck(types.PermCircuitBreaker)
+
+	if market.HaltBlocks == 0 {
+		return 0, nil
+	}
+
+	if _, err := k.marketKeeper.SetStatus(ctx, market.ID, markets.MarketStatusPostOnly); err != nil {
+		return 0, fmt.Errorf("setting market status: %w", err)
+	}
+
+	halt := types.MarketHalt{
+		MarketID:     market.ID,
+		ResumeHeight: ctx.BlockHeight() + int64(market.HaltBlocks),
+		PrevStatus:   market.GetStatus(),
+	}
+	k.setMarketHalt(ctx, halt)
+
+	return halt.ResumeHeight, nil
+}
+
+// ResumeMarkets switches halted markets back to the pre-halt status once the halt period is over.
+// Market status is not changed if it was modified during the halt period (is not the post-only status set by the breaker).
+// Returns number of resumed markets.
+func (k Keeper) ResumeMarkets(ctx sdk.Context) int {
+	k.modulePerms.AutoCheck(types.PermCircuitBreaker)
+
+	resumedCnt := 0
+	for _, halt := range k.getMarketHalts(ctx) {
+		if halt.ResumeHeight > ctx.BlockHeight() {
+			continue
+		}
+		k.deleteMarketHalt(ctx, halt.MarketID)
+
+		market, err := k.marketKeeper.Get(ctx, halt.MarketID)
+		if err != nil {
+			k.GetLogger(ctx).Error(fmt.Sprintf("Reading halted market %q: %v", halt.MarketID, err))
+			continue
+		}
+		if market.GetStatus() != markets.MarketStatusPostOnly {
+			continue
+		}
+
+		if _, err := k.marketKeeper.SetStatus(ctx, market.ID, halt.GetPrevStatus()); err != nil {
+			k.GetLogger(ctx).Error(fmt.Sprintf("Resuming halted market %q: %v", halt.MarketID, err))
+			continue
+		}
+		resumedCnt++
+	}
+
+	return resumedCnt
+}
+
+// getMarketHalts returns all halted markets (sorted by marketID).
+func (k Keeper) getMarketHalts(ctx sdk.Context) types.MarketHalts {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.MarketHaltKeyPrefix)
+	defer iterator.Close()
+
+	halts := make(types.MarketHalts, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		halt := types.MarketHalt{}
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &halt)
+		halts = append(halts, halt)
+	}
+
+	return halts
+}
+
+// setMarketHalt creates / overwrites market halt object in the storage.
+func (k Keeper) setMarketHalt(ctx sdk.Context, halt types.MarketHalt) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetMarketHaltKey(halt.MarketID), k.cdc.MustMarshalBinaryLengthPrefixed(halt))
+}
+
+// deleteMarketHalt removes market halt object from the storage.
+func (k Keeper) deleteMarketHalt(ctx sdk.Context, marketID dnTypes.ID) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetMarketHaltKey(marketID))
+}
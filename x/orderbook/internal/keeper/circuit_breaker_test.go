@@ -0,0 +1,117 @@
+// +build unit
+
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dfinance/dnode/x/markets"
+	"github.com/dfinance/dnode/x/orderbook/internal/types"
+)
+
+func TestOBKeeper_CircuitBreaker(t *testing.T) {
+	input := NewTestInput(t)
+
+	market, err := input.marketKeeper.Add(input.ctx, input.baseBtcDenom, input.quoteDenom)
+	require.NoError(t, err)
+
+	refPrice := sdk.NewUint(100)
+	item := NewMockHistoryItem(market.ID, 1)
+	item.ClearancePrice = refPrice
+	input.keeper.SetHistoryItem(input.ctx, item)
+
+	// circuit breaker is disabled
+	{
+		_, breached := input.keeper.CheckCircuitBreaker(input.ctx, market, sdk.NewUint(1000))
+		require.False(t, breached)
+	}
+
+	// oracle reference price is not available
+	{
+		market, err := input.marketKeeper.SetCircuitBreaker(input.ctx, market.ID, sdk.NewDecWithPrec(1, 1), markets.PriceReferenceOracle, 0)
+		require.NoError(t, err)
+
+		_, breached := input.keeper.CheckCircuitBreaker(input.ctx, market, sdk.NewUint(1000))
+		require.False(t, breached)
+	}
+
+	// clearance reference price: 10% band
+	market, err = input.marketKeeper.SetCircuitBreaker(input.ctx, market.ID, sdk.NewDecWithPrec(1, 1), markets.PriceReferenceClearance, 2)
+	require.NoError(t, err)
+	{
+		for _, tc := range []struct {
+			price    uint64
+			breached bool
+		}{
+			{price: 100, breached: false},
+			{price: 110, breached: false},
+			{price: 90, breached: false},
+			{price: 111, breached: true},
+			{price: 89, breached: true},
+		} {
+			ref, breached := input.keeper.CheckCircuitBreaker(input.ctx, market, sdk.NewUint(tc.price))
+			require.True(t, ref.Equal(refPrice))
+			require.Equal(t, tc.breached, breached, "price %d", tc.price)
+		}
+	}
+
+	// halt and resume
+	{
+		ctx := input.ctx.WithBlockHeight(5)
+		resumeHeight, err := input.keeper.HaltMarket(ctx, market)
+		require.NoError(t, err)
+		require.EqualValues(t, 7, resumeHeight)
+
+		haltedMarket, err := input.marketKeeper.Get(ctx, market.ID)
+		require.NoError(t, err)
+		require.Equal(t, markets.MarketStatusPostOnly, haltedMarket.GetStatus())
+
+		// halt is exported
+		state := types.GenesisState{}
+		input.cdc.MustUnmarshalJSON(input.keeper.ExportGenesis(ctx), &state)
+		require.Len(t, state.MarketHalts, 1)
+		require.True(t, state.MarketHalts[0].MarketID.Equal(market.ID))
+		require.Equal(t, markets.MarketStatusActive, state.MarketHalts[0].PrevStatus)
+
+		require.Equal(t, 0, input.keeper.ResumeMarkets(ctx.WithBlockHeight(6)))
+		require.Equal(t, 1, input.keeper.ResumeMarkets(ctx.WithBlockHeight(7)))
+		require.Empty(t, input.keeper.getMarketHalts(ctx))
+
+		resumedMarket, err := input.marketKeeper.Get(ctx, market.ID)
+		require.NoError(t, err)
+		require.Equal(t, markets.MarketStatusActive, resumedMarket.GetStatus())
+	}
+
+	// market status changed during the halt period: status is kept
+	{
+		ctx := input.ctx.WithBlockHeight(10)
+		_, err := input.keeper.HaltMarket(ctx, market)
+		require.NoError(t, err)
+
+		_, err = input.marketKeeper.SetStatus(ctx, market.ID, markets.MarketStatusHalted)
+		require.NoError(t, err)
+
+		require.Equal(t, 0, input.keeper.ResumeMarkets(ctx.WithBlockHeight(12)))
+		require.Empty(t, input.keeper.getMarketHalts(ctx))
+
+		haltedMarket, err := input.marketKeeper.Get(ctx, market.ID)
+		require.NoError(t, err)
+		require.Equal(t, markets.MarketStatusHalted, haltedMarket.GetStatus())
+	}
+
+	// no halt period: status is not changed
+	{
+		_, err := input.marketKeeper.SetStatus(input.ctx, market.ID, markets.MarketStatusActive)
+		require.NoError(t, err)
+		market, err := input.marketKeeper.SetCircuitBreaker(input.ctx, market.ID, sdk.NewDecWithPrec(1, 1), markets.PriceReferenceClearance, 0)
+		require.NoError(t, err)
+
+		resumeHeight, err := input.keeper.HaltMarket(input.ctx, market)
+		require.NoError(t, err)
+		require.Zero(t, resumeHeight)
+		require.Empty(t, input.keeper.getMarketHalts(input.ctx))
+	}
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/dfinance/dnode/x/ccstorage"
 	"github.com/dfinance/dnode/x/common_vm"
 	"github.com/dfinance/dnode/x/markets"
+	"github.com/dfinance/dnode/x/oracle"
 	"github.com/dfinance/dnode/x/orderbook/internal/types"
 	"github.com/dfinance/dnode/x/orders"
 	"github.com/dfinance/dnode/x/vm"
@@ -41,6 +42,7 @@ type TestInput struct {
 	keyMarkets *sdk.KVStoreKey
 	keyOrders  *sdk.KVStoreKey
 	keyOB      *sdk.KVStoreKey
+	keyOracle  *sdk.KVStoreKey
 	keyVMS     *sdk.KVStoreKey
 	tKeyParams *sdk.TransientStoreKey
 	//
@@ -57,6 +59,7 @@ type TestInput struct {
 	ccsKeeper     ccstorage.Keeper
 	marketKeeper  markets.Keeper
 	orderKeeper   orders.Keeper
+	oracleKeeper  oracle.Keeper
 	paramsKeeper  params.Keeper
 	keeper        Keeper
 	//
@@ -73,6 +76,7 @@ func NewTestInput(t *testing.T) TestInput {
 		keyMarkets: sdk.NewKVStoreKey(markets.StoreKey),
 		keyOrders:  sdk.NewKVStoreKey(orders.StoreKey),
 		keyOB:      sdk.NewKVStoreKey(types.StoreKey),
+		keyOracle:  sdk.NewKVStoreKey(oracle.StoreKey),
 		keyVMS:     sdk.NewKVStoreKey(vm.StoreKey),
 		tKeyParams: sdk.NewTransientStoreKey(params.TStoreKey),
 		//
@@ -92,6 +96,7 @@ func NewTestInput(t *testing.T) TestInput {
 	bank.RegisterCodec(input.cdc)
 	supply.RegisterCodec(input.cdc)
 	orders.RegisterCodec(input.cdc)
+	oracle.RegisterCodec(input.cdc)
 	types.RegisterCodec(input.cdc)
 
 	// init in-memory DB
@@ -105,6 +110,7 @@ func NewTestInput(t *testing.T) TestInput {
 	mstore.MountStoreWithDB(input.keyMarkets, sdk.StoreTypeIAVL, db)
 	mstore.MountStoreWithDB(input.keyOrders, sdk.StoreTypeIAVL, db)
 	mstore.MountStoreWithDB(input.keyOB, sdk.StoreTypeIAVL, db)
+	mstore.MountStoreWithDB(input.keyOracle, sdk.StoreTypeIAVL, db)
 	mstore.MountStoreWithDB(input.tKeyParams, sdk.StoreTypeTransient, db)
 	require.NoError(t, mstore.LoadLatestVersion(), "in-memory DB init")
 
@@ -123,18 +129,29 @@ func NewTestInput(t *testing.T) TestInput {
 	input.marketKeeper = markets.NewKeeper(
 		input.cdc,
 		input.keyMarkets,
+		input.paramsKeeper.Subspace(markets.DefaultParamspace),
 		input.ccsKeeper,
 		orders.RequestMarketsPerms(),
+		types.RequestMarketsPerms(),
 	)
 	input.orderKeeper = orders.NewKeeper(
 		input.cdc,
 		input.keyOrders,
+		input.paramsKeeper.Subspace(orders.DefaultParamspace),
 		input.bankKeeper,
 		input.supplyKeeper,
 		input.marketKeeper,
 		types.RequestOrdersPerms(),
 	)
-	input.keeper = NewKeeper(input.cdc, input.keyOB, input.orderKeeper)
+	input.oracleKeeper = oracle.NewKeeper(
+		input.cdc,
+		input.keyOracle,
+		input.paramsKeeper.Subspace(oracle.DefaultParamspace),
+		input.vmStorage,
+		input.supplyKeeper,
+		types.RequestOraclePerms(),
+	)
+	input.keeper = NewKeeper(input.cdc, input.keyOB, input.paramsKeeper.Subspace(types.DefaultParamspace), input.marketKeeper, input.orderKeeper, input.oracleKeeper)
 
 	// create context
 	input.ctx = sdk.NewContext(mstore, abci.Header{ChainID: "test-chain-id"}, false, log.NewNopLogger())
@@ -142,6 +159,8 @@ func NewTestInput(t *testing.T) TestInput {
 	// init genesis / params
 	input.ccsKeeper.InitDefaultGenesis(input.ctx)
 	input.marketKeeper.InitDefaultGenesis(input.ctx)
+	input.orderKeeper.InitDefaultGenesis(input.ctx)
+	input.keeper.InitDefaultGenesis(input.ctx)
 
 	return input
 }
@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	dnTypes "github.com/dfinance/dnode/helpers/types"
+	"github.com/dfinance/dnode/x/orderbook/internal/types"
+	"github.com/dfinance/dnode/x/orders"
+)
+
+// GetDepth returns market bid / ask aggregated depth built from active orders.
+func (k Keeper) GetDepth(ctx sdk.Context, marketID dnTypes.ID) (types.Depth, error) {
+	bidOrders, askOrders, err := k.getMarketOrdersByDirection(ctx, marketID)
+	if err != nil {
+		return types.Depth{}, err
+	}
+
+	return types.Depth{
+		MarketID: marketID,
+		Bids:     NewBidOrderAggregates(bidOrders),
+		Asks:     NewAskOrderAggregates(askOrders),
+	}, nil
+}
+
+// SimulateMatching matches active market orders without executing order fills.
+// Market status is not checked, so the result shows what matching would look like.
+func (k Keeper) SimulateMatching(ctx sdk.Context, marketID dnTypes.ID) (types.Simulation, error) {
+	marketOrders, err := k.GetMarketOrders(ctx, marketID)
+	if err != nil {
+		return types.Simulation{}, err
+	}
+
+	matcher := NewMatcher(marketID, k.GetLogger(ctx))
+	for i := range marketOrders {
+		if err := matcher.AddOrder(&marketOrders[i]); err != nil {
+			return types.Simulation{}, err
+		}
+	}
+
+	result, err := matcher.Match()
+	if err != nil {
+		return types.Simulation{}, err
+	}
+
+	return types.Simulation{
+		Result:   result,
+		SDCurves: matcher.GetSDCurves(),
+	}, nil
+}
+
+// getMarketOrdersByDirection returns market bid and ask orders (price sorted ASC).
+func (k Keeper) getMarketOrdersByDirection(ctx sdk.Context, marketID dnTypes.ID) (bidOrders, askOrders orders.Orders, retErr error) {
+	marketOrders, err := k.GetMarketOrders(ctx, marketID)
+	if err != nil {
+		retErr = err
+		return
+	}
+
+	bidOrders, askOrders = make(orders.Orders, 0), make(orders.Orders, 0)
+	for _, order := range marketOrders {
+		switch order.Direction {
+		case orders.BidDirection:
+			bidOrders = append(bidOrders, order)
+		case orders.AskDirection:
+			askOrders = append(askOrders, order)
+		}
+	}
+
+	return
+}
@@ -8,7 +8,8 @@ import (
 	"github.com/dfinance/dnode/x/orderbook/internal/types"
 )
 
-// InitGenesis inits module genesis state: creates history items.
+// InitGenesis inits module genesis state: sets params, creates history items, candles and market halts.
+// Candles are imported as is (not rebuilt from history items).
 func (k Keeper) InitGenesis(ctx sdk.Context, data json.RawMessage) {
 	k.modulePerms.AutoCheck(types.PermInit)
 
@@ -19,8 +20,18 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data json.RawMessage) {
 		panic(err)
 	}
 
+	k.SetParams(ctx, state.Params)
+
 	for _, item := range state.HistoryItems {
-		k.SetHistoryItem(ctx, item)
+		k.setHistoryItem(ctx, item)
+	}
+
+	for _, candle := range state.Candles {
+		k.setCandle(ctx, candle)
+	}
+
+	for _, halt := range state.MarketHalts {
+		k.setMarketHalt(ctx, halt)
 	}
 }
 
@@ -28,7 +39,9 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data json.RawMessage) {
 func (k Keeper) ExportGenesis(ctx sdk.Context) json.RawMessage {
 	k.modulePerms.AutoCheck(types.PermExport)
 
-	state := types.GenesisState{}
+	state := types.GenesisState{
+		Params: k.GetParams(ctx),
+	}
 
 	historyItems, err := k.GetHistoryItemsList(ctx)
 	if err != nil {
@@ -36,6 +49,8 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) json.RawMessage {
 	}
 
 	state.HistoryItems = append(state.HistoryItems, historyItems...)
+	state.Candles = k.GetCandlesList(ctx)
+	state.MarketHalts = k.getMarketHalts(ctx)
 
 	return k.cdc.MustMarshalJSON(state)
 }
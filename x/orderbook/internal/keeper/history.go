@@ -37,6 +37,26 @@ func (k Keeper) GetHistoryItem(ctx sdk.Context, marketID dnTypes.ID, blockHeight
 	return item, nil
 }
 
+// GetLastHistoryItem gets the latest historyItem object for marketID (market last clearance).
+func (k Keeper) GetLastHistoryItem(ctx sdk.Context, marketID dnTypes.ID) (types.HistoryItem, error) {
+	k.modulePerms.AutoCheck(types.PermHistoryRead)
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStoreReversePrefixIterator(store, types.GetHistoryItemsMarketPrefix(marketID))
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return types.HistoryItem{}, types.ErrWrongHistoryItem
+	}
+
+	item := types.HistoryItem{}
+	if err := k.cdc.UnmarshalBinaryLengthPrefixed(iterator.Value(), &item); err != nil {
+		panic(fmt.Errorf("historyItem unmarshal: %w", err))
+	}
+
+	return item, nil
+}
+
 // GetHistoryItemsList return all history items.
 func (k Keeper) GetHistoryItemsList(ctx sdk.Context) (types.HistoryItems, error) {
 	k.modulePerms.AutoCheck(types.PermHistoryRead)
@@ -83,10 +103,16 @@ func (k Keeper) GetHistoryItemsInBlockHeightRange(ctx sdk.Context, marketID dnTy
 	return items, nil
 }
 
-// SetHistoryItem adds historyItem to the storage.
+// SetHistoryItem adds historyItem to the storage and updates market candles.
 func (k Keeper) SetHistoryItem(ctx sdk.Context, item types.HistoryItem) {
 	k.modulePerms.AutoCheck(types.PermHistoryWrite)
 
+	k.setHistoryItem(ctx, item)
+	k.updateCandles(ctx, item)
+}
+
+// setHistoryItem sets historyItem to the storage.
+func (k Keeper) setHistoryItem(ctx sdk.Context, item types.HistoryItem) {
 	store := ctx.KVStore(k.storeKey)
 	key := types.GetHistoryItemKey(item.MarketID, item.BlockHeight)
 	bz := k.cdc.MustMarshalBinaryLengthPrefixed(item)
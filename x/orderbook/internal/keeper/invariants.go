@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dfinance/dnode/x/orderbook/internal/types"
+	"github.com/dfinance/dnode/x/orders"
+)
+
+// RegisterInvariants registers all module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "order-markets", OrderMarkets(k))
+}
+
+// OrderMarkets checks that every active / stop order refers to an existing market.
+// Stored orders are decoded without market resolving (order market currencies are resolved on read).
+func OrderMarkets(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		irComment := ""
+
+		for _, iterator := range []sdk.Iterator{k.orderKeeper.GetIterator(ctx), k.orderKeeper.GetStopOrderIterator(ctx)} {
+			for ; iterator.Valid(); iterator.Next() {
+				order := orders.StoredOrder{}
+				if err := k.cdc.UnmarshalBinaryLengthPrefixed(iterator.Value(), &order); err != nil {
+					irComment += fmt.Sprintf("\torder unmarshal: %v\n", err)
+					continue
+				}
+
+				if !k.marketKeeper.Has(ctx, order.MarketID) {
+					irComment += fmt.Sprintf("\torder %s: market %s: not found\n", order.ID, order.MarketID)
+				}
+			}
+			iterator.Close()
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "order-markets", irComment), irComment != ""
+	}
+}
@@ -0,0 +1,91 @@
+// +build unit
+
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authTypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dfinance/dnode/helpers/perms"
+	"github.com/dfinance/dnode/x/markets"
+	marketsClient "github.com/dfinance/dnode/x/markets/client"
+	"github.com/dfinance/dnode/x/orderbook/internal/types"
+	"github.com/dfinance/dnode/x/orders"
+	ordersClient "github.com/dfinance/dnode/x/orders/client"
+)
+
+func TestOBKeeper_Invariants(t *testing.T) {
+	input := NewTestInput(t)
+
+	// test keepers with extra permissions to create a market and post orders
+	marketKeeper := markets.NewKeeper(
+		input.cdc,
+		input.keyMarkets,
+		params.NewSubspace(input.cdc, input.keyParams, input.tKeyParams, markets.DefaultParamspace),
+		input.ccsKeeper,
+		func() (moduleName string, modulePerms perms.Permissions) {
+			return types.ModuleName, perms.Permissions{marketsClient.PermCreate, marketsClient.PermRead}
+		},
+	)
+	orderKeeper := orders.NewKeeper(
+		input.cdc,
+		input.keyOrders,
+		params.NewSubspace(input.cdc, input.keyParams, input.tKeyParams, orders.DefaultParamspace),
+		input.bankKeeper,
+		input.supplyKeeper,
+		input.marketKeeper,
+		func() (moduleName string, modulePerms perms.Permissions) {
+			return types.ModuleName, perms.Permissions{ordersClient.PermOrderPost, ordersClient.PermRead}
+		},
+	)
+
+	market, err := marketKeeper.Add(input.ctx, input.baseBtcDenom, input.quoteDenom)
+	require.NoError(t, err)
+
+	// create account with supplies
+	_, _, addr := authTypes.KeyTestPubAddr()
+	baseBalance, ok := sdk.NewIntFromString("100000000000") // 1000 btc
+	require.True(t, ok)
+	quoteBalance, ok := sdk.NewIntFromString("1000000000000000000000") // 1000 xfi
+	require.True(t, ok)
+
+	acc := input.accountKeeper.NewAccountWithAddress(input.ctx, addr)
+	require.NoError(t, acc.SetCoins(sdk.NewCoins(sdk.NewCoin(input.baseBtcDenom, baseBalance), sdk.NewCoin(input.quoteDenom, quoteBalance))))
+	input.accountKeeper.SetAccount(input.ctx, acc)
+
+	price := sdk.NewUintFromString("10000000000000000000") // 10 xfi
+	quantity := sdk.NewUintFromString("100000000")         // 1 btc
+
+	_, err = orderKeeper.PostOrder(input.ctx, addr, market.GetAssetCode(), orders.AskDirection, price, quantity, 60)
+	require.NoError(t, err)
+	_, err = orderKeeper.PostStopOrder(input.ctx, addr, market.GetAssetCode(), orders.BidDirection, price, quantity, 60, price, orders.StopTriggerClearance)
+	require.NoError(t, err)
+
+	// stored orders are consistent with markets
+	{
+		msg, broken := OrderMarkets(input.keeper)(input.ctx)
+		require.False(t, broken, msg)
+	}
+
+	// orders refer to a non-existing market (markets storage is wiped)
+	{
+		marketsStore := input.ctx.KVStore(input.keyMarkets)
+		iterator := marketsStore.Iterator(nil, nil)
+		keys := make([][]byte, 0)
+		for ; iterator.Valid(); iterator.Next() {
+			keys = append(keys, iterator.Key())
+		}
+		iterator.Close()
+		for _, key := range keys {
+			marketsStore.Delete(key)
+		}
+
+		msg, broken := OrderMarkets(input.keeper)(input.ctx)
+		require.True(t, broken)
+		require.Contains(t, msg, "not found")
+	}
+}
@@ -4,19 +4,26 @@ package keeper
 import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
 	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/dfinance/dnode/helpers/perms"
+	dnTypes "github.com/dfinance/dnode/helpers/types"
+	"github.com/dfinance/dnode/x/markets"
+	"github.com/dfinance/dnode/x/oracle"
 	"github.com/dfinance/dnode/x/orderbook/internal/types"
 	"github.com/dfinance/dnode/x/orders"
 )
 
 // Module keeper object.
 type Keeper struct {
-	cdc         *codec.Codec
-	storeKey    sdk.StoreKey
-	orderKeeper orders.Keeper
-	modulePerms perms.ModulePermissions
+	cdc          *codec.Codec
+	storeKey     sdk.StoreKey
+	paramStore   params.Subspace
+	marketKeeper markets.Keeper
+	orderKeeper  orders.Keeper
+	oracleKeeper oracle.Keeper
+	modulePerms  perms.ModulePermissions
 }
 
 // GetLogger gets logger with keeper context.
@@ -24,32 +31,65 @@ func (k Keeper) GetLogger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+types.ModuleName)
 }
 
-// GetOrderIterator returns orders module iterator (over orders).
-func (k Keeper) GetOrderIterator(ctx sdk.Context) sdk.Iterator {
+// GetMarketOrders returns orders module active orders for the market.
+func (k Keeper) GetMarketOrders(ctx sdk.Context, marketID dnTypes.ID) (orders.Orders, error) {
 	k.modulePerms.AutoCheck(types.PermOrdersRead)
 
-	return k.orderKeeper.GetIterator(ctx)
+	return k.orderKeeper.GetMarketOrders(ctx, marketID)
+}
+
+// GetMarkets returns orders markets.
+func (k Keeper) GetMarkets(ctx sdk.Context) markets.Markets {
+	k.modulePerms.AutoCheck(types.PermOrdersRead)
+
+	return k.orderKeeper.GetMarkets(ctx)
+}
+
+// GetMarket returns orders market (used to check market status).
+func (k Keeper) GetMarket(ctx sdk.Context, marketID dnTypes.ID) (markets.Market, error) {
+	k.modulePerms.AutoCheck(types.PermOrdersRead)
+
+	return k.orderKeeper.GetMarket(ctx, marketID)
 }
 
 // ProcessOrderFills passes order fills to the orders module.
-func (k Keeper) ProcessOrderFills(ctx sdk.Context, orderFills orders.OrderFills) {
+// Returns fees collected.
+func (k Keeper) ProcessOrderFills(ctx sdk.Context, orderFills orders.OrderFills) sdk.Coins {
 	k.modulePerms.AutoCheck(types.PermExecFill)
 
-	k.orderKeeper.ExecuteOrderFills(ctx, orderFills)
+	return k.orderKeeper.ExecuteOrderFills(ctx, orderFills)
+}
+
+// RevokeOrderLeftover revokes an order if it still exists (not filled or partially filled).
+// Used to cancel immediate (ioc/fok) orders after matching.
+func (k Keeper) RevokeOrderLeftover(ctx sdk.Context, id dnTypes.ID) error {
+	k.modulePerms.AutoCheck(types.PermOrdersRevoke)
+
+	if !k.orderKeeper.Has(ctx, id) {
+		return nil
+	}
+
+	return k.orderKeeper.RevokeOrder(ctx, id)
 }
 
 // NewKeeper creates keeper object.
 func NewKeeper(
 	cdc *codec.Codec,
 	storeKey sdk.StoreKey,
+	paramStore params.Subspace,
+	mk markets.Keeper,
 	ok orders.Keeper,
+	ork oracle.Keeper,
 	permsRequesters ...perms.RequestModulePermissions,
 ) Keeper {
 	k := Keeper{
-		cdc:         cdc,
-		storeKey:    storeKey,
-		orderKeeper: ok,
-		modulePerms: types.NewModulePerms(),
+		cdc:          cdc,
+		storeKey:     storeKey,
+		paramStore:   paramStore.WithKeyTable(types.ParamKeyTable()),
+		marketKeeper: mk,
+		orderKeeper:  ok,
+		oracleKeeper: ork,
+		modulePerms:  types.NewModulePerms(),
 	}
 	for _, requester := range permsRequesters {
 		k.modulePerms.AutoAddRequester(requester)
@@ -32,8 +32,8 @@ type MatcherOrders struct {
 
 // MatcherAggregates stores bid/ask aggregates.
 type MatcherAggregates struct {
-	bid OrderAggregates
-	ask OrderAggregates
+	bid types.OrderAggregates
+	ask types.OrderAggregates
 }
 
 // AddOrder validates the input order and adds it to the corresponding queue.
@@ -74,8 +74,38 @@ func (m *Matcher) AddOrder(order *orders.Order) error {
 	return nil
 }
 
-// Match sorts order queues, builds order aggregates and SDCurves.
+// Match matches orders and excludes fill-or-kill orders that can't be fully filled at the clearance price.
+// Self-trade prevention policies are applied once before the matching, adjustments are returned even if matching fails.
+// Matching is repeated until there are no partially filled fill-or-kill orders left (each round excludes at least one order).
 func (m *Matcher) Match() (result types.MatcherResult, retErr error) {
+	selfTradeAdjustments := m.preventSelfTrades()
+
+	for {
+		result, retErr = m.match()
+		if retErr != nil {
+			result = types.MatcherResult{MarketID: m.marketID, SelfTradeAdjustments: selfTradeAdjustments}
+			return
+		}
+
+		killedIDs := make(map[string]bool)
+		for _, fill := range result.OrderFills {
+			if fill.Order.GetTimeInForce() == orders.TimeInForceFOK && !fill.QuantityUnfilled.IsZero() {
+				killedIDs[fill.Order.ID.String()] = true
+			}
+		}
+
+		if len(killedIDs) == 0 {
+			result.SelfTradeAdjustments = selfTradeAdjustments
+			return
+		}
+
+		m.orders.bid = excludeOrders(m.orders.bid, killedIDs)
+		m.orders.ask = excludeOrders(m.orders.ask, killedIDs)
+	}
+}
+
+// match sorts order queues, builds order aggregates and SDCurves.
+func (m *Matcher) match() (result types.MatcherResult, retErr error) {
 	// orders sorting and aggregating (that can be safely paralleled)
 	wg := sync.WaitGroup{}
 	wg.Add(2)
@@ -83,7 +113,7 @@ func (m *Matcher) Match() (result types.MatcherResult, retErr error) {
 	go func() {
 		defer wg.Done()
 		// orders should be sorted by price for aggregates build
-		// orders would be filled up in reverse order, so orders came first (lower ID) should be executed firstly
+		// orders would be filled up in reverse order, so orders came first (lower PriorityID) should be executed firstly
 		sort.Sort(ByPriceAscIDDesc(m.orders.bid))
 		m.aggregates.bid = NewBidOrderAggregates(m.orders.bid)
 	}()
@@ -91,7 +121,7 @@ func (m *Matcher) Match() (result types.MatcherResult, retErr error) {
 	go func() {
 		defer wg.Done()
 		// orders should be sorted by price for aggregates build
-		// orders would be filled up in direct order, so orders came first (lower ID) should be executed firstly
+		// orders would be filled up in direct order, so orders came first (lower PriorityID) should be executed firstly
 		sort.Sort(ByPriceAscIDAsc(m.orders.ask))
 		m.aggregates.ask = NewAskOrderAggregates(m.orders.ask)
 	}()
@@ -154,7 +184,7 @@ func (m *Matcher) Match() (result types.MatcherResult, retErr error) {
 	return
 }
 
-// getBidOrderFills fills up bid orders in reverse order (from highest target price and lower order IDs).
+// getBidOrderFills fills up bid orders in reverse order (from highest target price and lower order PriorityIDs).
 func (m *Matcher) getBidOrderFills(clearanceState types.ClearanceState) (fills orders.OrderFills, matchedVolume sdk.Dec) {
 	// fills stores result order fills
 	// matchedVolume stores current matched volume (should be <= clearanceState.MaxBidVolume
@@ -198,7 +228,7 @@ func (m *Matcher) getBidOrderFills(clearanceState types.ClearanceState) (fills o
 	return
 }
 
-// getAskOrderFills fills up ask orders in direct order (from lowest target price and lower order IDs).
+// getAskOrderFills fills up ask orders in direct order (from lowest target price and lower order PriorityIDs).
 func (m *Matcher) getAskOrderFills(clearanceState types.ClearanceState) (fills orders.OrderFills, matchedVolume sdk.Dec) {
 	// fills stores result order fills
 	// matchedVolume stores current matched volume (should be <= clearanceState.MaxBidVolume
@@ -242,6 +272,18 @@ func (m *Matcher) getAskOrderFills(clearanceState types.ClearanceState) (fills o
 	return
 }
 
+// excludeOrders returns orders slice without orders with specified IDs (order is kept).
+func excludeOrders(list orders.Orders, ids map[string]bool) orders.Orders {
+	filtered := make(orders.Orders, 0, len(list))
+	for _, order := range list {
+		if !ids[order.ID.String()] {
+			filtered = append(filtered, order)
+		}
+	}
+
+	return filtered
+}
+
 // GetSDCurves returns SDCurves (for debug use only).
 func (m *Matcher) GetSDCurves() SDCurves {
 	return m.sdCurves
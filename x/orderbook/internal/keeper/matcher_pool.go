@@ -2,6 +2,9 @@ package keeper
 
 import (
 	"fmt"
+	"runtime"
+	"sort"
+	"sync"
 
 	"github.com/tendermint/tendermint/libs/log"
 
@@ -12,8 +15,16 @@ import (
 
 // MatcherPool objects stores matchers for market IDs.
 type MatcherPool struct {
-	logger log.Logger
-	pool   map[string]*Matcher
+	logger  log.Logger
+	pool    map[string]*Matcher
+	workers int
+}
+
+// matcherPoolOutput stores single matcher Match results (used to merge parallel matching results).
+type matcherPoolOutput struct {
+	result   types.MatcherResult
+	err      error
+	panicObj interface{}
 }
 
 // AddOrder adds order to the corresponding matcher (by marketID).
@@ -29,25 +40,63 @@ func (mp *MatcherPool) AddOrder(order orders.Order) error {
 }
 
 // Process executes every pool matcher and combines the results.
+// Matchers are independent and executed in parallel, results are merged in the marketID ascending order,
+// so the output (and the following order fills processing) is deterministic.
 // Panics on internal errors, otherwise just logs.
+// Failed matcher result is kept (without order fills) if self-trade prevention has adjusted market orders.
 func (mp *MatcherPool) Process() types.MatcherResults {
-	results := make(types.MatcherResults, 0, len(mp.pool))
+	matchers := mp.getSortedMatchers()
+	outputs := make([]matcherPoolOutput, len(matchers))
+
+	workers := mp.workers
+	if workers > len(matchers) {
+		workers = len(matchers)
+	}
+
+	// execute matchers (each output slot is written by one worker only)
+	idxCh := make(chan int, len(matchers))
+	for i := range matchers {
+		idxCh <- i
+	}
+	close(idxCh)
 
-	for marketID, matcher := range mp.pool {
-		result, err := matcher.Match()
-		if err != nil {
-			errMsg := fmt.Sprintf("Matcher for marketID %q: %v", marketID, err)
-			if types.ErrInternal.Is(err) {
+	wg := sync.WaitGroup{}
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+			for idx := range idxCh {
+				outputs[idx] = runMatcher(matchers[idx])
+			}
+		}()
+	}
+	wg.Wait()
+
+	// merge results
+	results := make(types.MatcherResults, 0, len(matchers))
+	for i, output := range outputs {
+		marketID := matchers[i].marketID
+		if output.panicObj != nil {
+			panic(fmt.Sprintf("Matcher for marketID %q: %v", marketID, output.panicObj))
+		}
+
+		if output.err != nil {
+			errMsg := fmt.Sprintf("Matcher for marketID %q: %v", marketID, output.err)
+			if types.ErrInternal.Is(output.err) {
 				panic(errMsg)
 			} else {
 				mp.logger.Info(errMsg)
 			}
 
+			if len(output.result.SelfTradeAdjustments) > 0 {
+				results = append(results, output.result)
+			}
+
 			continue
 		}
-		mp.logger.Info(result.ShortString())
+		mp.logger.Info(output.result.ShortString())
 
-		results = append(results, result)
+		results = append(results, output.result)
 	}
 
 	return results
@@ -63,10 +112,38 @@ func (mp *MatcherPool) GetSDCurves(marketID dnTypes.ID) SDCurves {
 	return matcher.GetSDCurves()
 }
 
+// getSortedMatchers returns pool matchers sorted by marketID (ASC).
+func (mp *MatcherPool) getSortedMatchers() []*Matcher {
+	matchers := make([]*Matcher, 0, len(mp.pool))
+	for _, matcher := range mp.pool {
+		matchers = append(matchers, matcher)
+	}
+
+	sort.Slice(matchers, func(i, j int) bool {
+		return matchers[i].marketID.LT(matchers[j].marketID)
+	})
+
+	return matchers
+}
+
+// runMatcher executes matcher catching a panic to rethrow it within the main goroutine.
+func runMatcher(matcher *Matcher) (output matcherPoolOutput) {
+	defer func() {
+		if r := recover(); r != nil {
+			output.panicObj = r
+		}
+	}()
+
+	output.result, output.err = matcher.Match()
+
+	return
+}
+
 // NewMatcherPool creates a new MatcherPool object.
 func NewMatcherPool(logger log.Logger) MatcherPool {
 	return MatcherPool{
-		logger: logger,
-		pool:   make(map[string]*Matcher),
+		logger:  logger,
+		pool:    make(map[string]*Matcher),
+		workers: runtime.NumCPU(),
 	}
 }
@@ -5,6 +5,8 @@ package keeper
 import (
 	"bytes"
 	"fmt"
+	"math/rand"
+	"runtime"
 	"sort"
 	"strconv"
 	"testing"
@@ -47,6 +49,12 @@ type MatchingPoolOrderInput struct {
 	OutQuantity uint64
 	// Order fill sequence number (0 - don't check, global between markets).
 	OutFillSeq uint64
+	// Order time-in-force policy (empty - gtc).
+	TimeInForce orders.TimeInForce
+	// Order owner (empty - default).
+	Owner sdk.AccAddress
+	// Order self-trade prevention policy (empty - none).
+	SelfTradePrevention orders.SelfTradePrevention
 }
 
 func (i *MatchingPoolInput) PostOrders(t *testing.T, pool *MatcherPool) {
@@ -66,11 +74,15 @@ func (i *MatchingPoolInput) PostOrders(t *testing.T, pool *MatcherPool) {
 
 	for _, input := range i.Orders {
 		order := orders.Order{
-			ID:        dnTypes.NewIDFromUint64(input.OrderID),
-			Market:    extMarkets[input.MarketID],
-			Direction: input.Direction,
-			Price:     sdk.NewUint(input.Price),
-			Quantity:  sdk.NewUint(input.InQuantity),
+			ID:          dnTypes.NewIDFromUint64(input.OrderID),
+			Market:      extMarkets[input.MarketID],
+			Direction:   input.Direction,
+			Price:       sdk.NewUint(input.Price),
+			Quantity:    sdk.NewUint(input.InQuantity),
+			TimeInForce: input.TimeInForce,
+			Owner:       input.Owner,
+
+			SelfTradePrevention: input.SelfTradePrevention,
 		}
 
 		if err := pool.AddOrder(order); err != nil {
@@ -377,3 +389,333 @@ func TestOBKeeper_Matching_TwoOrders(t *testing.T) {
 	inputs.PrintResults(results)
 	inputs.PrintCurves(&matcherPool)
 }
+
+func TestOBKeeper_Matching_FillOrKill(t *testing.T) {
+	testLogger := logger.NewDNLogger()
+	testLogger = log.NewFilter(testLogger, log.AllowAll())
+
+	getFills := func(results types.MatcherResults) map[uint64]orders.OrderFill {
+		fills := make(map[uint64]orders.OrderFill)
+		for _, result := range results {
+			for _, fill := range result.OrderFills {
+				fills[fill.Order.ID.UInt64()] = fill
+			}
+		}
+		return fills
+	}
+
+	// FOK order can be fully filled: kept
+	{
+		inputs := MatchingPoolInput{
+			Markets: []MatchingPoolMarketInput{
+				{BaseDenom: "btc", QuoteDenom: "xfi", BaseDecimals: 0, QuoteDecimals: 0},
+			},
+			Orders: []MatchingPoolOrderInput{
+				{MarketID: 0, Direction: orders.AskDirection, OrderID: 0, Price: 50, InQuantity: 60, OutQuantity: 60},
+				{MarketID: 0, Direction: orders.BidDirection, OrderID: 1, Price: 100, InQuantity: 30, OutQuantity: 30, TimeInForce: orders.TimeInForceFOK},
+				{MarketID: 0, Direction: orders.BidDirection, OrderID: 2, Price: 100, InQuantity: 30, OutQuantity: 30},
+			},
+		}
+
+		matcherPool := NewMatcherPool(testLogger)
+		inputs.PostOrders(t, &matcherPool)
+		results := matcherPool.Process()
+		inputs.Check(t, results)
+		require.Len(t, getFills(results), 3)
+	}
+
+	// FOK order can't be fully filled (ProRata): excluded, orders are rematched without it
+	{
+		inputs := MatchingPoolInput{
+			Markets: []MatchingPoolMarketInput{
+				{BaseDenom: "btc", QuoteDenom: "xfi", BaseDecimals: 0, QuoteDecimals: 0},
+			},
+			Orders: []MatchingPoolOrderInput{
+				{MarketID: 0, Direction: orders.AskDirection, OrderID: 0, Price: 50, InQuantity: 50},
+				{MarketID: 0, Direction: orders.BidDirection, OrderID: 1, Price: 100, InQuantity: 30, TimeInForce: orders.TimeInForceFOK},
+				{MarketID: 0, Direction: orders.BidDirection, OrderID: 2, Price: 100, InQuantity: 30},
+			},
+		}
+
+		matcherPool := NewMatcherPool(testLogger)
+		inputs.PostOrders(t, &matcherPool)
+		results := matcherPool.Process()
+		require.Len(t, results, 1)
+		require.Equal(t, 1, results[0].BidOrdersCount)
+
+		fills := getFills(results)
+		require.Len(t, fills, 2)
+		require.NotContains(t, fills, uint64(1))
+		require.EqualValues(t, 30, fills[2].QuantityFilled.Uint64())
+		require.True(t, fills[2].QuantityUnfilled.IsZero())
+		require.EqualValues(t, 30, fills[0].QuantityFilled.Uint64())
+		require.EqualValues(t, 20, fills[0].QuantityUnfilled.Uint64())
+	}
+
+	// FOK order is the only counterpart: no match left
+	{
+		inputs := MatchingPoolInput{
+			Markets: []MatchingPoolMarketInput{
+				{BaseDenom: "btc", QuoteDenom: "xfi", BaseDecimals: 0, QuoteDecimals: 0},
+			},
+			Orders: []MatchingPoolOrderInput{
+				{MarketID: 0, Direction: orders.AskDirection, OrderID: 0, Price: 50, InQuantity: 100, TimeInForce: orders.TimeInForceFOK},
+				{MarketID: 0, Direction: orders.BidDirection, OrderID: 1, Price: 100, InQuantity: 30},
+			},
+		}
+
+		matcherPool := NewMatcherPool(testLogger)
+		inputs.PostOrders(t, &matcherPool)
+		results := matcherPool.Process()
+		require.Len(t, results, 0)
+	}
+}
+
+func TestOBKeeper_Matching_SelfTradePrevention(t *testing.T) {
+	testLogger := logger.NewDNLogger()
+	testLogger = log.NewFilter(testLogger, log.AllowAll())
+
+	ownerA, ownerB := sdk.AccAddress("ownerA"), sdk.AccAddress("ownerB")
+	btcMarket := []MatchingPoolMarketInput{
+		{BaseDenom: "btc", QuoteDenom: "xfi", BaseDecimals: 0, QuoteDecimals: 0},
+	}
+
+	process := func(inputs MatchingPoolInput) (types.MatcherResult, map[uint64]orders.OrderFill) {
+		matcherPool := NewMatcherPool(testLogger)
+		inputs.PostOrders(t, &matcherPool)
+		results := matcherPool.Process()
+		require.Len(t, results, 1)
+
+		fills := make(map[uint64]orders.OrderFill)
+		for _, fill := range results[0].OrderFills {
+			fills[fill.Order.ID.UInt64()] = fill
+		}
+
+		return results[0], fills
+	}
+
+	checkAdjustment := func(adj types.SelfTradeAdjustment, orderID, qCanceled, qLeft uint64) {
+		require.EqualValues(t, orderID, adj.OrderID.UInt64())
+		require.EqualValues(t, qCanceled, adj.QuantityCanceled.Uint64(), "order %d: QuantityCanceled", orderID)
+		require.EqualValues(t, qLeft, adj.QuantityLeft.Uint64(), "order %d: QuantityLeft", orderID)
+	}
+
+	// none policy: owner orders are matched against each other
+	{
+		result, fills := process(MatchingPoolInput{
+			Markets: btcMarket,
+			Orders: []MatchingPoolOrderInput{
+				{MarketID: 0, Direction: orders.AskDirection, OrderID: 0, Price: 50, InQuantity: 50, Owner: ownerA},
+				{MarketID: 0, Direction: orders.BidDirection, OrderID: 1, Price: 100, InQuantity: 30, Owner: ownerA},
+			},
+		})
+
+		require.Empty(t, result.SelfTradeAdjustments)
+		require.Len(t, fills, 2)
+	}
+
+	// cancel_newest: the newer crossing order is canceled
+	{
+		result, fills := process(MatchingPoolInput{
+			Markets: btcMarket,
+			Orders: []MatchingPoolOrderInput{
+				{MarketID: 0, Direction: orders.AskDirection, OrderID: 0, Price: 50, InQuantity: 50, Owner: ownerA},
+				{MarketID: 0, Direction: orders.BidDirection, OrderID: 1, Price: 100, InQuantity: 30, Owner: ownerB},
+				{MarketID: 0, Direction: orders.BidDirection, OrderID: 2, Price: 100, InQuantity: 30, Owner: ownerA, SelfTradePrevention: orders.SelfTradePreventionCancelNewest},
+			},
+		})
+
+		require.Len(t, result.SelfTradeAdjustments, 1)
+		checkAdjustment(result.SelfTradeAdjustments[0], 2, 30, 0)
+		require.True(t, result.SelfTradeAdjustments[0].IsCanceled())
+		require.Equal(t, 1, result.BidOrdersCount)
+
+		require.Len(t, fills, 2)
+		require.NotContains(t, fills, uint64(2))
+		require.EqualValues(t, 30, fills[1].QuantityFilled.Uint64())
+	}
+
+	// cancel_oldest: the older crossed order is canceled
+	{
+		result, fills := process(MatchingPoolInput{
+			Markets: btcMarket,
+			Orders: []MatchingPoolOrderInput{
+				{MarketID: 0, Direction: orders.AskDirection, OrderID: 0, Price: 50, InQuantity: 50, Owner: ownerA},
+				{MarketID: 0, Direction: orders.BidDirection, OrderID: 1, Price: 100, InQuantity: 30, Owner: ownerB},
+				{MarketID: 0, Direction: orders.BidDirection, OrderID: 2, Price: 100, InQuantity: 30, Owner: ownerA, SelfTradePrevention: orders.SelfTradePreventionCancelOldest},
+				{MarketID: 0, Direction: orders.AskDirection, OrderID: 3, Price: 60, InQuantity: 60, Owner: ownerB},
+			},
+		})
+
+		require.Len(t, result.SelfTradeAdjustments, 1)
+		checkAdjustment(result.SelfTradeAdjustments[0], 0, 50, 0)
+		require.Equal(t, 1, result.AskOrdersCount)
+
+		require.Len(t, fills, 3)
+		require.NotContains(t, fills, uint64(0))
+		require.EqualValues(t, 30, fills[2].QuantityFilled.Uint64())
+		require.EqualValues(t, 60, fills[3].QuantityFilled.Uint64())
+	}
+
+	// decrement_both: both orders are decremented by the smaller quantity
+	{
+		result, fills := process(MatchingPoolInput{
+			Markets: btcMarket,
+			Orders: []MatchingPoolOrderInput{
+				{MarketID: 0, Direction: orders.AskDirection, OrderID: 0, Price: 50, InQuantity: 50, Owner: ownerA},
+				{MarketID: 0, Direction: orders.BidDirection, OrderID: 1, Price: 100, InQuantity: 30, Owner: ownerA, SelfTradePrevention: orders.SelfTradePreventionDecrementBoth},
+				{MarketID: 0, Direction: orders.BidDirection, OrderID: 2, Price: 100, InQuantity: 30, Owner: ownerB},
+			},
+		})
+
+		require.Len(t, result.SelfTradeAdjustments, 2)
+		checkAdjustment(result.SelfTradeAdjustments[0], 0, 30, 20)
+		require.False(t, result.SelfTradeAdjustments[0].IsCanceled())
+		checkAdjustment(result.SelfTradeAdjustments[1], 1, 30, 0)
+		require.True(t, result.SelfTradeAdjustments[1].IsCanceled())
+
+		require.Len(t, fills, 2)
+		require.EqualValues(t, 20, fills[0].QuantityFilled.Uint64())
+		require.True(t, fills[0].QuantityUnfilled.IsZero())
+		require.EqualValues(t, 20, fills[2].QuantityFilled.Uint64())
+		require.EqualValues(t, 10, fills[2].QuantityUnfilled.Uint64())
+	}
+
+	// no orders left to match: result contains only adjustments
+	{
+		result, fills := process(MatchingPoolInput{
+			Markets: btcMarket,
+			Orders: []MatchingPoolOrderInput{
+				{MarketID: 0, Direction: orders.BidDirection, OrderID: 0, Price: 100, InQuantity: 30, Owner: ownerA},
+				{MarketID: 0, Direction: orders.AskDirection, OrderID: 1, Price: 50, InQuantity: 50, Owner: ownerA, SelfTradePrevention: orders.SelfTradePreventionCancelNewest},
+			},
+		})
+
+		require.Len(t, result.SelfTradeAdjustments, 1)
+		checkAdjustment(result.SelfTradeAdjustments[0], 1, 50, 0)
+		require.Empty(t, fills)
+	}
+
+	// not crossing owner orders: policy is not applied
+	{
+		result, fills := process(MatchingPoolInput{
+			Markets: btcMarket,
+			Orders: []MatchingPoolOrderInput{
+				{MarketID: 0, Direction: orders.AskDirection, OrderID: 0, Price: 150, InQuantity: 50, Owner: ownerA},
+				{MarketID: 0, Direction: orders.BidDirection, OrderID: 1, Price: 100, InQuantity: 30, Owner: ownerA, SelfTradePrevention: orders.SelfTradePreventionCancelNewest},
+				{MarketID: 0, Direction: orders.AskDirection, OrderID: 2, Price: 50, InQuantity: 30, Owner: ownerB},
+			},
+		})
+
+		require.Empty(t, result.SelfTradeAdjustments)
+		require.Len(t, fills, 2)
+		require.EqualValues(t, 30, fills[1].QuantityFilled.Uint64())
+	}
+}
+
+// newMultiMarketOrders generates pseudo-random bid / ask orders for a number of markets.
+func newMultiMarketOrders(marketsCnt, marketOrdersCnt int) []orders.Order {
+	rnd := rand.New(rand.NewSource(1))
+
+	marketOrders := make([]orders.Order, 0, marketsCnt*marketOrdersCnt)
+	orderID := uint64(0)
+	for marketIdx := 0; marketIdx < marketsCnt; marketIdx++ {
+		baseDenom := "base" + strconv.Itoa(marketIdx)
+		market := markets.NewMarket(dnTypes.NewIDFromUint64(uint64(marketIdx)), baseDenom, "xfi")
+		marketExt := markets.NewMarketExtended(
+			market,
+			ccstorage.Currency{Denom: baseDenom, Decimals: 0},
+			ccstorage.Currency{Denom: "xfi", Decimals: 0},
+		)
+
+		for i := 0; i < marketOrdersCnt; i++ {
+			direction := orders.BidDirection
+			if i%2 == 1 {
+				direction = orders.AskDirection
+			}
+
+			marketOrders = append(marketOrders, orders.Order{
+				ID:        dnTypes.NewIDFromUint64(orderID),
+				Market:    marketExt,
+				Direction: direction,
+				Price:     sdk.NewUint(90 + rnd.Uint64()%20),
+				Quantity:  sdk.NewUint(1 + rnd.Uint64()%1000),
+			})
+			orderID++
+		}
+	}
+
+	return marketOrders
+}
+
+func TestOBKeeper_MatcherPool_Deterministic(t *testing.T) {
+	const marketsCnt, marketOrdersCnt = 50, 100
+	marketOrders := newMultiMarketOrders(marketsCnt, marketOrdersCnt)
+
+	process := func(workers int) types.MatcherResults {
+		matcherPool := NewMatcherPool(log.NewNopLogger())
+		matcherPool.workers = workers
+		for _, order := range marketOrders {
+			require.NoError(t, matcherPool.AddOrder(order))
+		}
+
+		return matcherPool.Process()
+	}
+
+	// sequential processing is a reference
+	refResults := process(1)
+	require.Len(t, refResults, marketsCnt)
+	for i, result := range refResults {
+		require.Equal(t, uint64(i), result.MarketID.UInt64(), "result [%d]: marketID order", i)
+	}
+	refBz := types.ModuleCdc.MustMarshalBinaryBare(refResults)
+
+	// results must be byte-identical regardless of workers count and goroutines scheduling
+	for _, workers := range []int{2, 8, marketsCnt, 2 * marketsCnt} {
+		for i := 0; i < 5; i++ {
+			results := process(workers)
+			require.Equal(t, refBz, types.ModuleCdc.MustMarshalBinaryBare(results), "workers %d, run %d", workers, i)
+		}
+	}
+}
+
+func BenchmarkOBKeeper_MatcherPool_Process(b *testing.B) {
+	benchCases := []struct {
+		marketsCnt      int
+		marketOrdersCnt int
+	}{
+		{marketsCnt: 1, marketOrdersCnt: 1000},
+		{marketsCnt: 10, marketOrdersCnt: 1000},
+		{marketsCnt: 100, marketOrdersCnt: 100},
+		{marketsCnt: 100, marketOrdersCnt: 1000},
+	}
+
+	// sequential vs parallel processing
+	workersCases := []int{1}
+	if runtime.NumCPU() > 1 {
+		workersCases = append(workersCases, runtime.NumCPU())
+	}
+
+	for _, bc := range benchCases {
+		marketOrders := newMultiMarketOrders(bc.marketsCnt, bc.marketOrdersCnt)
+
+		for _, workers := range workersCases {
+			name := fmt.Sprintf("markets_%d/orders_%d/workers_%d", bc.marketsCnt, bc.marketOrdersCnt, workers)
+			b.Run(name, func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					b.StopTimer()
+					matcherPool := NewMatcherPool(log.NewNopLogger())
+					matcherPool.workers = workers
+					for _, order := range marketOrders {
+						if err := matcherPool.AddOrder(order); err != nil {
+							b.Fatal(err)
+						}
+					}
+					b.StartTimer()
+
+					matcherPool.Process()
+				}
+			})
+		}
+	}
+}
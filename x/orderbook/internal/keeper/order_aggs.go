@@ -1,57 +1,22 @@
 package keeper
 
 import (
-	"bytes"
-
-	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/olekukonko/tablewriter"
-
+	"github.com/dfinance/dnode/x/orderbook/internal/types"
 	"github.com/dfinance/dnode/x/orders"
 )
 
-// OrderAggregate type stores aggregated quantity (relative to price) for bid/ask orders.
-// Bid/ask aggregates are combined to build PQCurve.
-type OrderAggregate struct {
-	Price    sdk.Uint
-	Quantity sdk.Uint
-}
-
-// OrderAggregate sort.Interface.
-type OrderAggregates []OrderAggregate
-
-// Strings returns multi-line text object representation.
-func (a *OrderAggregates) String() string {
-	var buf bytes.Buffer
-
-	t := tablewriter.NewWriter(&buf)
-	t.SetHeader([]string{
-		"OA.Price",
-		"OA.Price",
-	})
-
-	for _, o := range *a {
-		t.Append([]string{
-			o.Price.String(),
-			o.Quantity.String(),
-		})
-	}
-	t.Render()
-
-	return buf.String()
-}
-
 // NewBidOrderAggregates groups bid orders by price summing quantities.
 // Contract: orders must be price sorted (ASC).
 // Result is price sorted (ASC).
-func NewBidOrderAggregates(orders orders.Orders) OrderAggregates {
-	aggs := make(OrderAggregates, 0, len(orders))
+func NewBidOrderAggregates(orders orders.Orders) types.OrderAggregates {
+	aggs := make(types.OrderAggregates, 0, len(orders))
 	lastIdx := len(orders) - 1
 	if lastIdx < 0 {
 		return aggs
 	}
 
 	// add the first element with the highest price
-	aggs = append(aggs, OrderAggregate{
+	aggs = append(aggs, types.OrderAggregate{
 		Price:    orders[lastIdx].Price,
 		Quantity: orders[lastIdx].Quantity},
 	)
@@ -65,7 +30,7 @@ func NewBidOrderAggregates(orders orders.Orders) OrderAggregates {
 		}
 
 		// prepend the aggregate if price wasn't not found
-		aggs = append(OrderAggregates{{
+		aggs = append(types.OrderAggregates{{
 			Price:    order.Price,
 			Quantity: aggs[0].Quantity.Add(order.Quantity),
 		}}, aggs...)
@@ -77,14 +42,14 @@ func NewBidOrderAggregates(orders orders.Orders) OrderAggregates {
 // NewAskOrderAggregates groups ask orders by price summing quantities.
 // Contract: orders must be price sorted (ASC).
 // Result is price sorted (ASC).
-func NewAskOrderAggregates(orders orders.Orders) OrderAggregates {
-	aggs := make(OrderAggregates, 0, len(orders))
+func NewAskOrderAggregates(orders orders.Orders) types.OrderAggregates {
+	aggs := make(types.OrderAggregates, 0, len(orders))
 	if len(orders) < 1 {
 		return aggs
 	}
 
 	// add the first element with the lowest price
-	aggs = append(aggs, OrderAggregate{
+	aggs = append(aggs, types.OrderAggregate{
 		Price:    orders[0].Price,
 		Quantity: orders[0].Quantity},
 	)
@@ -99,7 +64,7 @@ func NewAskOrderAggregates(orders orders.Orders) OrderAggregates {
 		}
 
 		// append the aggregate if price wasn't not found
-		aggs = append(aggs, OrderAggregate{
+		aggs = append(aggs, types.OrderAggregate{
 			Price:    order.Price,
 			Quantity: aggs[lastIdx].Quantity.Add(order.Quantity),
 		})
@@ -8,15 +8,16 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
+	"github.com/dfinance/dnode/x/orderbook/internal/types"
 	"github.com/dfinance/dnode/x/orders"
 )
 
 type AggInput struct {
 	Input  orders.Orders
-	Output OrderAggregates
+	Output types.OrderAggregates
 }
 
-func (aggInput AggInput) Check(t *testing.T, agg OrderAggregates) {
+func (aggInput AggInput) Check(t *testing.T, agg types.OrderAggregates) {
 	require.Len(t, agg, len(aggInput.Output))
 	for i := range agg {
 		require.True(t, aggInput.Output[i].Price.Equal(agg[i].Price), "%d: Price (expected / received): %s / %s", i, aggInput.Output[i].Price, agg[i].Price)
@@ -39,8 +40,8 @@ func TestOBKeeper_OrderAggregate_Bid(t *testing.T) {
 			Input: orders.Orders{
 				orders.Order{Price: sdk.NewUint(50), Quantity: sdk.NewUint(100)},
 			},
-			Output: OrderAggregates{
-				OrderAggregate{Price: sdk.NewUint(50), Quantity: sdk.NewUint(100)},
+			Output: types.OrderAggregates{
+				types.OrderAggregate{Price: sdk.NewUint(50), Quantity: sdk.NewUint(100)},
 			},
 		}
 		input.Check(t, NewBidOrderAggregates(input.Input))
@@ -59,11 +60,11 @@ func TestOBKeeper_OrderAggregate_Bid(t *testing.T) {
 				orders.Order{Price: sdk.NewUint(150), Quantity: sdk.NewUint(200)},
 				orders.Order{Price: sdk.NewUint(150), Quantity: sdk.NewUint(100)},
 			},
-			Output: OrderAggregates{
-				OrderAggregate{Price: sdk.NewUint(10), Quantity: sdk.NewUint(850)},
-				OrderAggregate{Price: sdk.NewUint(50), Quantity: sdk.NewUint(750)},
-				OrderAggregate{Price: sdk.NewUint(75), Quantity: sdk.NewUint(450)},
-				OrderAggregate{Price: sdk.NewUint(150), Quantity: sdk.NewUint(300)},
+			Output: types.OrderAggregates{
+				types.OrderAggregate{Price: sdk.NewUint(10), Quantity: sdk.NewUint(850)},
+				types.OrderAggregate{Price: sdk.NewUint(50), Quantity: sdk.NewUint(750)},
+				types.OrderAggregate{Price: sdk.NewUint(75), Quantity: sdk.NewUint(450)},
+				types.OrderAggregate{Price: sdk.NewUint(150), Quantity: sdk.NewUint(300)},
 			},
 		}
 		input.Check(t, NewBidOrderAggregates(input.Input))
@@ -85,8 +86,8 @@ func TestOBKeeper_OrderAggregate_Ask(t *testing.T) {
 			Input: orders.Orders{
 				orders.Order{Price: sdk.NewUint(50), Quantity: sdk.NewUint(100)},
 			},
-			Output: OrderAggregates{
-				OrderAggregate{Price: sdk.NewUint(50), Quantity: sdk.NewUint(100)},
+			Output: types.OrderAggregates{
+				types.OrderAggregate{Price: sdk.NewUint(50), Quantity: sdk.NewUint(100)},
 			},
 		}
 		input.Check(t, NewAskOrderAggregates(input.Input))
@@ -105,11 +106,11 @@ func TestOBKeeper_OrderAggregate_Ask(t *testing.T) {
 				orders.Order{Price: sdk.NewUint(150), Quantity: sdk.NewUint(200)},
 				orders.Order{Price: sdk.NewUint(150), Quantity: sdk.NewUint(100)},
 			},
-			Output: OrderAggregates{
-				OrderAggregate{Price: sdk.NewUint(10), Quantity: sdk.NewUint(100)},
-				OrderAggregate{Price: sdk.NewUint(50), Quantity: sdk.NewUint(400)},
-				OrderAggregate{Price: sdk.NewUint(75), Quantity: sdk.NewUint(550)},
-				OrderAggregate{Price: sdk.NewUint(150), Quantity: sdk.NewUint(850)},
+			Output: types.OrderAggregates{
+				types.OrderAggregate{Price: sdk.NewUint(10), Quantity: sdk.NewUint(100)},
+				types.OrderAggregate{Price: sdk.NewUint(50), Quantity: sdk.NewUint(400)},
+				types.OrderAggregate{Price: sdk.NewUint(75), Quantity: sdk.NewUint(550)},
+				types.OrderAggregate{Price: sdk.NewUint(150), Quantity: sdk.NewUint(850)},
 			},
 		}
 		input.Check(t, NewAskOrderAggregates(input.Input))
@@ -4,7 +4,7 @@ import (
 	"github.com/dfinance/dnode/x/orders"
 )
 
-// ByPriceAscIDDesc is a type wrapper used to sort orders slice by Price ASC (1st priority) and ID DESC (2nd priority).
+// ByPriceAscIDDesc is a type wrapper used to sort orders slice by Price ASC (1st priority) and PriorityID DESC (2nd priority).
 type ByPriceAscIDDesc orders.Orders
 
 // Implements sort.Interface.
@@ -15,7 +15,7 @@ func (s ByPriceAscIDDesc) Len() int {
 // Implements sort.Interface.
 func (s ByPriceAscIDDesc) Less(i, j int) bool {
 	if s[i].Price.Equal(s[j].Price) {
-		return s[i].ID.GT(s[j].ID)
+		return s[i].GetPriorityID().GT(s[j].GetPriorityID())
 	}
 
 	return s[i].Price.LT(s[j].Price)
@@ -26,7 +26,7 @@ func (s ByPriceAscIDDesc) Swap(i, j int) {
 	s[i], s[j] = s[j], s[i]
 }
 
-// ByPriceAscIDAsc is a type wrapper used to sort orders slice by Price ASC (1st priority) and ID ASC (2nd priority).
+// ByPriceAscIDAsc is a type wrapper used to sort orders slice by Price ASC (1st priority) and PriorityID ASC (2nd priority).
 type ByPriceAscIDAsc orders.Orders
 
 // Implements sort.Interface.
@@ -37,7 +37,7 @@ func (s ByPriceAscIDAsc) Len() int {
 // Implements sort.Interface.
 func (s ByPriceAscIDAsc) Less(i, j int) bool {
 	if s[i].Price.Equal(s[j].Price) {
-		return s[i].ID.LT(s[j].ID)
+		return s[i].GetPriorityID().LT(s[j].GetPriorityID())
 	}
 
 	return s[i].Price.LT(s[j].Price)
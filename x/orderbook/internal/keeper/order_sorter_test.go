@@ -45,3 +45,24 @@ func TestOBKeeper_Sorter(t *testing.T) {
 	})
 	require.True(t, isSortedByPriceAscIDAsc)
 }
+
+func TestOBKeeper_SorterPriorityID(t *testing.T) {
+	t.Parallel()
+
+	// order 0 lost its priority (amended), order 1 has no priority set (ID is used)
+	orders := orders.Orders{
+		orders.Order{ID: dnTypes.NewIDFromUint64(0), PriorityID: dnTypes.NewIDFromUint64(3), Price: sdk.NewUint(50)},
+		orders.Order{ID: dnTypes.NewIDFromUint64(1), Price: sdk.NewUint(50)},
+		orders.Order{ID: dnTypes.NewIDFromUint64(2), PriorityID: dnTypes.NewIDFromUint64(2), Price: sdk.NewUint(50)},
+	}
+
+	sort.Sort(ByPriceAscIDAsc(orders))
+	require.EqualValues(t, 1, orders[0].ID.UInt64())
+	require.EqualValues(t, 2, orders[1].ID.UInt64())
+	require.EqualValues(t, 0, orders[2].ID.UInt64())
+
+	sort.Sort(ByPriceAscIDDesc(orders))
+	require.EqualValues(t, 0, orders[0].ID.UInt64())
+	require.EqualValues(t, 2, orders[1].ID.UInt64())
+	require.EqualValues(t, 1, orders[2].ID.UInt64())
+}
@@ -12,27 +12,8 @@ import (
 	"github.com/dfinance/dnode/x/orderbook/internal/types"
 )
 
-// Supply-demand curves point holding aggregated supply and demand quantities for price.
-type SDItem struct {
-	Price  sdk.Uint
-	Supply sdk.Uint
-	Demand sdk.Uint
-}
-
-// SupplyDemandBalance compares point supply and demand.
-func (i SDItem) SupplyDemandBalance() int {
-	if i.Supply.GT(i.Demand) {
-		return 1
-	}
-	if i.Supply.Equal(i.Demand) {
-		return 0
-	}
-
-	return -1
-}
-
 // SDCurves object stores all Supply-demand curves points.
-type SDCurves []SDItem
+type SDCurves []types.SDItem
 
 // Strings returns multi-line text object representation.
 func (c *SDCurves) String() string {
@@ -58,7 +39,7 @@ func (c *SDCurves) String() string {
 }
 
 // NewSDCurves creates a new SDCurves object mergind ask/bid aggregates.
-func NewSDCurves(askAggs, bidAggs OrderAggregates) (SDCurves, error) {
+func NewSDCurves(askAggs, bidAggs types.OrderAggregates) (SDCurves, error) {
 	// check if curves can be obtained
 	if len(askAggs) == 0 || len(bidAggs) == 0 {
 		return SDCurves{}, fmt.Errorf("ask / bid orders are empty: %d / %d", len(askAggs), len(bidAggs))
@@ -133,7 +114,7 @@ func (c *SDCurves) GetClearanceState() (retState types.ClearanceState, retErr er
 
 // getCrossPoint searches for the crossing point.
 // Crossing point might not be found: other point is picked in that case (edge cases).
-func (c *SDCurves) getCrossPoint() SDItem {
+func (c *SDCurves) getCrossPoint() types.SDItem {
 	// crossPointIdx is an index of the last found crossing point
 	// clearancePrice is the last calculated clearance price
 	crossPointIdx, clearancePrice := -1, sdk.ZeroUint()
@@ -143,7 +124,7 @@ func (c *SDCurves) getCrossPoint() SDItem {
 	// it's a crossing point, but left SupplyDemandBalance can't be calculated (idx == -1)
 	if firstItem := &(*c)[0]; firstItem.Supply.Equal(firstItem.Demand) {
 		if !firstItem.Supply.IsZero() && !firstItem.Demand.IsZero() {
-			return SDItem{
+			return types.SDItem{
 				Price:  firstItem.Price,
 				Supply: firstItem.Supply,
 				Demand: firstItem.Demand,
@@ -159,7 +140,7 @@ func (c *SDCurves) getCrossPoint() SDItem {
 			crossPointIdx, clearancePrice = i, curItem.Price
 
 			// check if next points are equal to the found one ("corridor")
-			leftCrossPoint, rightCrossPoint := curItem, (*SDItem)(nil)
+			leftCrossPoint, rightCrossPoint := curItem, (*types.SDItem)(nil)
 			for j := i + 1; j < cLen; j++ {
 				rightItem := &(*c)[j]
 				if !leftCrossPoint.Supply.Equal(rightItem.Supply) || !leftCrossPoint.Demand.Equal(rightItem.Demand) {
@@ -182,7 +163,7 @@ func (c *SDCurves) getCrossPoint() SDItem {
 
 		if !curItem.Supply.IsZero() && !curItem.Demand.IsZero() {
 			// edge-case 1a: crossing point has volumes
-			return SDItem{
+			return types.SDItem{
 				Price:  clearancePrice,
 				Supply: curItem.Supply,
 				Demand: curItem.Demand,
@@ -191,7 +172,7 @@ func (c *SDCurves) getCrossPoint() SDItem {
 
 		if !prevItem.Supply.IsZero() && !prevItem.Demand.IsZero() {
 			// edge-case 1b: prev to the crossing point has volumes
-			return SDItem{
+			return types.SDItem{
 				Price:  prevItem.Price,
 				Supply: prevItem.Supply,
 				Demand: prevItem.Demand,
@@ -225,7 +206,7 @@ func (c *SDCurves) getCrossPoint() SDItem {
 	for i := 0; i < len(cSorted); i++ {
 		item := &cSorted[i]
 		if !item.Supply.IsZero() && !item.Demand.IsZero() {
-			return SDItem{
+			return types.SDItem{
 				Price:  item.Price,
 				Supply: item.Supply,
 				Demand: item.Demand,
@@ -239,14 +220,14 @@ func (c *SDCurves) getCrossPoint() SDItem {
 	//for rightIdx := middleIdx; rightIdx < cLen; rightIdx++ {
 	//	rightItem := &(*c)[rightIdx]
 	//
-	//	var leftItem *SDItem
+	//	var leftItem *types.SDItem
 	//	leftIdx := middleIdx - (rightIdx - middleIdx)
 	//	if leftIdx >= 0 {
 	//		leftItem = &(*c)[leftIdx]
 	//	}
 	//
 	//	if !rightItem.Supply.IsZero() && !rightItem.Demand.IsZero() {
-	//		return SDItem{
+	//		return types.SDItem{
 	//			Price:  rightItem.Price,
 	//			Supply: rightItem.Supply,
 	//			Demand: rightItem.Demand,
@@ -254,7 +235,7 @@ func (c *SDCurves) getCrossPoint() SDItem {
 	//	}
 	//
 	//	if leftItem != nil && !leftItem.Supply.IsZero() && !leftItem.Demand.IsZero() {
-	//		return SDItem{
+	//		return types.SDItem{
 	//			Price:  leftItem.Price,
 	//			Supply: leftItem.Supply,
 	//			Demand: leftItem.Demand,
@@ -263,7 +244,7 @@ func (c *SDCurves) getCrossPoint() SDItem {
 	//}
 
 	// edge-case 3: can't happen if the lowest ask price is higher then the highest bid price
-	return SDItem{
+	return types.SDItem{
 		Price:  sdk.ZeroUint(),
 		Supply: sdk.ZeroUint(),
 		Demand: sdk.ZeroUint(),
@@ -271,11 +252,11 @@ func (c *SDCurves) getCrossPoint() SDItem {
 }
 
 // addAskOrders merges ask aggregates into SDCurve.
-func (c *SDCurves) addAskOrders(aggs OrderAggregates) {
+func (c *SDCurves) addAskOrders(aggs types.OrderAggregates) {
 	for i := 0; i < len(aggs); i++ {
 		agg := &aggs[i]
 
-		*c = append(*c, SDItem{
+		*c = append(*c, types.SDItem{
 			Price:  agg.Price,
 			Supply: agg.Quantity,
 			Demand: sdk.ZeroUint(),
@@ -285,7 +266,7 @@ func (c *SDCurves) addAskOrders(aggs OrderAggregates) {
 
 // addAskOrders merges bid aggregates into SDCurve.
 // Contract: ask aggregates must be merged first.
-func (c *SDCurves) addBidOrders(aggs OrderAggregates) {
+func (c *SDCurves) addBidOrders(aggs types.OrderAggregates) {
 	for i := 0; i < len(aggs); i++ {
 		agg := &aggs[i]
 
@@ -298,9 +279,9 @@ func (c *SDCurves) addBidOrders(aggs OrderAggregates) {
 			continue
 		}
 
-		*c = append(*c, SDItem{})
+		*c = append(*c, types.SDItem{})
 		copy((*c)[gtePriceIdx+1:], (*c)[gtePriceIdx:])
-		(*c)[gtePriceIdx] = SDItem{
+		(*c)[gtePriceIdx] = types.SDItem{
 			Price:  agg.Price,
 			Supply: sdk.ZeroUint(),
 			Demand: agg.Quantity,
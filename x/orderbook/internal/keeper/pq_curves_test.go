@@ -8,6 +8,8 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
+
+	"github.com/dfinance/dnode/x/orderbook/internal/types"
 )
 
 const (
@@ -34,15 +36,15 @@ type SDOutputItem struct {
 }
 
 func (input SDCurvesInput) Check(t *testing.T) {
-	bidAggs, askAggs := OrderAggregates{}, OrderAggregates{}
+	bidAggs, askAggs := types.OrderAggregates{}, types.OrderAggregates{}
 	for _, item := range input.Aggs {
 		price := sdk.NewUint(item.P)
 		quantity := sdk.NewUint(item.Q)
 		switch item.T {
 		case SDAggTypeBid:
-			bidAggs = append(bidAggs, OrderAggregate{Price: price, Quantity: quantity})
+			bidAggs = append(bidAggs, types.OrderAggregate{Price: price, Quantity: quantity})
 		case SDAggTypeAsk:
-			askAggs = append(askAggs, OrderAggregate{Price: price, Quantity: quantity})
+			askAggs = append(askAggs, types.OrderAggregate{Price: price, Quantity: quantity})
 		}
 	}
 
@@ -169,7 +171,7 @@ type ClearanceStateInput struct {
 func (input ClearanceStateInput) Check(t *testing.T, caseName string) {
 	sdCurves := make(SDCurves, 0, len(input.Curves))
 	for _, item := range input.Curves {
-		sdCurves = append(sdCurves, SDItem{
+		sdCurves = append(sdCurves, types.SDItem{
 			Price:  sdk.NewUint(item.P),
 			Supply: sdk.NewUint(item.S),
 			Demand: sdk.NewUint(item.D),
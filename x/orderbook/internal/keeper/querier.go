@@ -0,0 +1,140 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/dfinance/dnode/x/orderbook/internal/types"
+)
+
+// NewQuerier return keeper querier.
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) (res []byte, err error) {
+		switch path[0] {
+		case types.QueryDepth:
+			return queryDepth(ctx, k, req)
+		case types.QueryClearance:
+			return queryClearance(ctx, k, req)
+		case types.QuerySimulate:
+			return querySimulate(ctx, k, req)
+		case types.QueryHistory:
+			return queryHistory(ctx, k, req)
+		case types.QueryCandles:
+			return queryCandles(ctx, k, req)
+		default:
+			return nil, sdkErrors.Wrapf(sdkErrors.ErrUnknownRequest, "unsupported query endpoint %q for module %q", path[0], types.ModuleName)
+		}
+	}
+}
+
+// queryDepth handles depth query which return market bid / ask aggregates.
+func queryDepth(ctx sdk.Context, k Keeper, req abci.RequestQuery) ([]byte, error) {
+	var params types.MarketReq
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkErrors.Wrapf(types.ErrInternal, "failed to parse params: %v", err)
+	}
+
+	depth, err := k.GetDepth(ctx, params.MarketID)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, depth)
+	if err != nil {
+		return nil, fmt.Errorf("depth marshal: %w", err)
+	}
+
+	return res, nil
+}
+
+// queryClearance handles clearance query which return market last history item (last clearance state).
+func queryClearance(ctx sdk.Context, k Keeper, req abci.RequestQuery) ([]byte, error) {
+	var params types.MarketReq
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkErrors.Wrapf(types.ErrInternal, "failed to parse params: %v", err)
+	}
+
+	item, err := k.GetLastHistoryItem(ctx, params.MarketID)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, item)
+	if err != nil {
+		return nil, fmt.Errorf("historyItem marshal: %w", err)
+	}
+
+	return res, nil
+}
+
+// querySimulate handles simulate query which return matching results for current market orders.
+func querySimulate(ctx sdk.Context, k Keeper, req abci.RequestQuery) ([]byte, error) {
+	var params types.MarketReq
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkErrors.Wrapf(types.ErrInternal, "failed to parse params: %v", err)
+	}
+
+	simulation, err := k.SimulateMatching(ctx, params.MarketID)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, simulation)
+	if err != nil {
+		return nil, fmt.Errorf("simulation marshal: %w", err)
+	}
+
+	return res, nil
+}
+
+// queryHistory handles history query which return market history items within block height range.
+func queryHistory(ctx sdk.Context, k Keeper, req abci.RequestQuery) ([]byte, error) {
+	var params types.HistoryReq
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkErrors.Wrapf(types.ErrInternal, "failed to parse params: %v", err)
+	}
+
+	if params.StartHeight < 0 || params.EndHeight < params.StartHeight {
+		return nil, sdkErrors.Wrapf(types.ErrWrongHistoryItem, "invalid block height range [%d:%d]", params.StartHeight, params.EndHeight)
+	}
+
+	items, err := k.GetHistoryItemsInBlockHeightRange(ctx, params.MarketID, params.StartHeight, params.EndHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, items)
+	if err != nil {
+		return nil, fmt.Errorf("historyItems marshal: %w", err)
+	}
+
+	return res, nil
+}
+
+// queryCandles handles candles query which return market candles for the interval within start time range.
+func queryCandles(ctx sdk.Context, k Keeper, req abci.RequestQuery) ([]byte, error) {
+	var params types.CandlesReq
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkErrors.Wrapf(types.ErrInternal, "failed to parse params: %v", err)
+	}
+
+	if params.StartTime < 0 || params.EndTime < params.StartTime {
+		return nil, sdkErrors.Wrapf(types.ErrWrongCandle, "invalid time range [%d:%d]", params.StartTime, params.EndTime)
+	}
+
+	candles, err := k.GetCandles(ctx, params.MarketID, params.Interval, params.StartTime, params.EndTime)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, candles)
+	if err != nil {
+		return nil, fmt.Errorf("candles marshal: %w", err)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,158 @@
+// +build unit
+
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authTypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/dfinance/dnode/helpers/perms"
+	"github.com/dfinance/dnode/x/markets"
+	marketsClient "github.com/dfinance/dnode/x/markets/client"
+	"github.com/dfinance/dnode/x/orderbook/internal/types"
+	"github.com/dfinance/dnode/x/orders"
+	ordersClient "github.com/dfinance/dnode/x/orders/client"
+)
+
+func TestOBKeeper_Querier(t *testing.T) {
+	input := NewTestInput(t)
+	querier := NewQuerier(input.keeper)
+
+	// test keepers with extra permissions to create a market and post orders
+	marketKeeper := markets.NewKeeper(
+		input.cdc,
+		input.keyMarkets,
+		params.NewSubspace(input.cdc, input.keyParams, input.tKeyParams, markets.DefaultParamspace),
+		input.ccsKeeper,
+		func() (moduleName string, modulePerms perms.Permissions) {
+			return types.ModuleName, perms.Permissions{marketsClient.PermCreate, marketsClient.PermRead}
+		},
+	)
+	orderKeeper := orders.NewKeeper(
+		input.cdc,
+		input.keyOrders,
+		params.NewSubspace(input.cdc, input.keyParams, input.tKeyParams, orders.DefaultParamspace),
+		input.bankKeeper,
+		input.supplyKeeper,
+		input.marketKeeper,
+		func() (moduleName string, modulePerms perms.Permissions) {
+			return types.ModuleName, perms.Permissions{ordersClient.PermOrderPost, ordersClient.PermRead}
+		},
+	)
+
+	market, err := marketKeeper.Add(input.ctx, input.baseBtcDenom, input.quoteDenom)
+	require.NoError(t, err)
+
+	// create account with supplies
+	_, _, addr := authTypes.KeyTestPubAddr()
+	baseBalance, ok := sdk.NewIntFromString("100000000000") // 1000 btc
+	require.True(t, ok)
+	quoteBalance, ok := sdk.NewIntFromString("1000000000000000000000") // 1000 xfi
+	require.True(t, ok)
+
+	acc := input.accountKeeper.NewAccountWithAddress(input.ctx, addr)
+	require.NoError(t, acc.SetCoins(sdk.NewCoins(sdk.NewCoin(input.baseBtcDenom, baseBalance), sdk.NewCoin(input.quoteDenom, quoteBalance))))
+	input.accountKeeper.SetAccount(input.ctx, acc)
+
+	quantity := sdk.NewUintFromString("100000000") // 1 btc
+	postOrder := func(direction orders.Direction, price string) {
+		_, err := orderKeeper.PostOrder(input.ctx, addr, market.GetAssetCode(), direction, sdk.NewUintFromString(price), quantity, 60)
+		require.NoError(t, err)
+	}
+
+	// bids at 10 / 12 xfi, asks at 9 / 11 xfi
+	postOrder(orders.BidDirection, "10000000000000000000")
+	postOrder(orders.BidDirection, "12000000000000000000")
+	postOrder(orders.AskDirection, "9000000000000000000")
+	postOrder(orders.AskDirection, "11000000000000000000")
+
+	marketReq := abci.RequestQuery{Data: input.cdc.MustMarshalJSON(types.MarketReq{MarketID: market.ID})}
+
+	// check depth
+	{
+		res, err := querier(input.ctx, []string{types.QueryDepth}, marketReq)
+		require.NoError(t, err)
+
+		depth := types.Depth{}
+		require.NoError(t, input.cdc.UnmarshalJSON(res, &depth))
+		require.True(t, depth.MarketID.Equal(market.ID))
+
+		require.Len(t, depth.Bids, 2)
+		require.True(t, depth.Bids[0].Price.Equal(sdk.NewUintFromString("10000000000000000000")))
+		require.True(t, depth.Bids[0].Quantity.Equal(quantity.MulUint64(2)))
+		require.True(t, depth.Bids[1].Price.Equal(sdk.NewUintFromString("12000000000000000000")))
+		require.True(t, depth.Bids[1].Quantity.Equal(quantity))
+
+		require.Len(t, depth.Asks, 2)
+		require.True(t, depth.Asks[0].Price.Equal(sdk.NewUintFromString("9000000000000000000")))
+		require.True(t, depth.Asks[0].Quantity.Equal(quantity))
+		require.True(t, depth.Asks[1].Price.Equal(sdk.NewUintFromString("11000000000000000000")))
+		require.True(t, depth.Asks[1].Quantity.Equal(quantity.MulUint64(2)))
+	}
+
+	// check simulation
+	{
+		res, err := querier(input.ctx, []string{types.QuerySimulate}, marketReq)
+		require.NoError(t, err)
+
+		simulation := types.Simulation{}
+		require.NoError(t, input.cdc.UnmarshalJSON(res, &simulation))
+		require.True(t, simulation.Result.MarketID.Equal(market.ID))
+		require.Equal(t, 2, simulation.Result.BidOrdersCount)
+		require.Equal(t, 2, simulation.Result.AskOrdersCount)
+		require.NotEmpty(t, simulation.Result.OrderFills)
+		require.NotEmpty(t, simulation.SDCurves)
+
+		// no state changes
+		marketOrders, err := input.keeper.GetMarketOrders(input.ctx, market.ID)
+		require.NoError(t, err)
+		require.Len(t, marketOrders, 4)
+	}
+
+	// check clearance
+	{
+		_, err := querier(input.ctx, []string{types.QueryClearance}, marketReq)
+		require.Error(t, err)
+
+		for height := int64(1); height <= 3; height++ {
+			input.keeper.SetHistoryItem(input.ctx, NewMockHistoryItem(market.ID, height))
+		}
+
+		res, err := querier(input.ctx, []string{types.QueryClearance}, marketReq)
+		require.NoError(t, err)
+
+		item := types.HistoryItem{}
+		require.NoError(t, input.cdc.UnmarshalJSON(res, &item))
+		require.Equal(t, int64(3), item.BlockHeight)
+	}
+
+	// check history
+	{
+		res, err := querier(input.ctx, []string{types.QueryHistory}, abci.RequestQuery{
+			Data: input.cdc.MustMarshalJSON(types.HistoryReq{MarketID: market.ID, StartHeight: 2, EndHeight: 3}),
+		})
+		require.NoError(t, err)
+
+		items := types.HistoryItems{}
+		require.NoError(t, input.cdc.UnmarshalJSON(res, &items))
+		require.Len(t, items, 2)
+		require.Equal(t, int64(2), items[0].BlockHeight)
+		require.Equal(t, int64(3), items[1].BlockHeight)
+
+		_, err = querier(input.ctx, []string{types.QueryHistory}, abci.RequestQuery{
+			Data: input.cdc.MustMarshalJSON(types.HistoryReq{MarketID: market.ID, StartHeight: 3, EndHeight: 2}),
+		})
+		require.Error(t, err)
+	}
+
+	// check unknown endpoint
+	{
+		_, err := querier(input.ctx, []string{"unknown"}, marketReq)
+		require.Error(t, err)
+	}
+}
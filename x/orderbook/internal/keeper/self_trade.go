@@ -0,0 +1,184 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	dnTypes "github.com/dfinance/dnode/helpers/types"
+	"github.com/dfinance/dnode/x/orderbook/internal/types"
+	"github.com/dfinance/dnode/x/orders"
+)
+
+// ApplySelfTradeAdjustments passes matcher self-trade prevention adjustments to the orders module.
+// Canceled orders are revoked (refunded), decremented orders are reduced keeping their matching priority.
+// Should be called before the market order fills are processed.
+func (k Keeper) ApplySelfTradeAdjustments(ctx sdk.Context, marketID dnTypes.ID, adjustments types.SelfTradeAdjustments) error {
+	k.modulePerms.AutoCheck(types.PermOrdersReduce)
+
+	for _, adj := range adjustments {
+		if adj.IsCanceled() {
+			if err := k.orderKeeper.RevokeOrder(ctx, adj.OrderID); err != nil {
+				return fmt.Errorf("order %q: revoke: %w", adj.OrderID, err)
+			}
+		} else {
+			if _, err := k.orderKeeper.ReduceOrder(ctx, adj.OrderID, adj.QuantityLeft); err != nil {
+				return fmt.Errorf("order %q: reduce: %w", adj.OrderID, err)
+			}
+		}
+
+		ctx.EventManager().EmitEvent(types.NewSelfTradePreventionEvent(marketID, adj))
+	}
+
+	return nil
+}
+
+// preventSelfTrades applies orders self-trade prevention (STP) policies before aggregates and SDCurves are built.
+// Owner orders are checked in the matching priority order: if an order with STP policy set crosses older opposite
+// orders of the same owner (bid price GTE ask price), the policy is applied to the crossed orders (best price first).
+// Canceled orders are removed from the matcher, decremented orders stay with the reduced quantity.
+// Returns adjusted orders sorted by ID.
+func (m *Matcher) preventSelfTrades() types.SelfTradeAdjustments {
+	// only owners having both bid and ask orders are checked
+	type ownerOrders struct {
+		bid []*orders.Order
+		ask []*orders.Order
+	}
+
+	owners := make(map[string]*ownerOrders)
+	getOwnerOrders := func(order *orders.Order) *ownerOrders {
+		ownerStr := order.Owner.String()
+		if owners[ownerStr] == nil {
+			owners[ownerStr] = &ownerOrders{}
+		}
+
+		return owners[ownerStr]
+	}
+	for i := range m.orders.bid {
+		o := getOwnerOrders(&m.orders.bid[i])
+		o.bid = append(o.bid, &m.orders.bid[i])
+	}
+	for i := range m.orders.ask {
+		o := getOwnerOrders(&m.orders.ask[i])
+		o.ask = append(o.ask, &m.orders.ask[i])
+	}
+
+	// owners are independent, so the iteration order doesn't affect the result
+	initialQuantities := make(map[string]sdk.Uint)
+	for _, o := range owners {
+		if len(o.bid) == 0 || len(o.ask) == 0 {
+			continue
+		}
+
+		ownerOrders := make([]*orders.Order, 0, len(o.bid)+len(o.ask))
+		ownerOrders = append(ownerOrders, o.bid...)
+		ownerOrders = append(ownerOrders, o.ask...)
+		sort.Slice(ownerOrders, func(i, j int) bool {
+			return ownerOrders[i].GetPriorityID().LT(ownerOrders[j].GetPriorityID())
+		})
+
+		for _, newOrder := range ownerOrders {
+			policy := newOrder.GetSelfTradePrevention()
+			if policy == orders.SelfTradePreventionNone {
+				continue
+			}
+
+			for _, oldOrder := range getCrossedOlderOrders(newOrder, o.bid, o.ask) {
+				if newOrder.Quantity.IsZero() {
+					break
+				}
+
+				for _, order := range []*orders.Order{newOrder, oldOrder} {
+					if _, ok := initialQuantities[order.ID.String()]; !ok {
+						initialQuantities[order.ID.String()] = order.Quantity
+					}
+				}
+
+				switch policy {
+				case orders.SelfTradePreventionCancelNewest:
+					newOrder.Quantity = sdk.ZeroUint()
+				case orders.SelfTradePreventionCancelOldest:
+					oldOrder.Quantity = sdk.ZeroUint()
+				case orders.SelfTradePreventionDecrementBoth:
+					decQuantity := sdk.MinUint(newOrder.Quantity, oldOrder.Quantity)
+					newOrder.Quantity = newOrder.Quantity.Sub(decQuantity)
+					oldOrder.Quantity = oldOrder.Quantity.Sub(decQuantity)
+				}
+			}
+		}
+	}
+
+	if len(initialQuantities) == 0 {
+		return nil
+	}
+
+	// build adjustments and remove canceled orders
+	adjustments := make(types.SelfTradeAdjustments, 0, len(initialQuantities))
+	canceledIDs := make(map[string]bool)
+	for _, list := range []orders.Orders{m.orders.bid, m.orders.ask} {
+		for _, order := range list {
+			initialQuantity, ok := initialQuantities[order.ID.String()]
+			if !ok || initialQuantity.Equal(order.Quantity) {
+				continue
+			}
+
+			adjustments = append(adjustments, types.SelfTradeAdjustment{
+				OrderID:          order.ID,
+				Owner:            order.Owner,
+				Direction:        order.Direction,
+				QuantityCanceled: initialQuantity.Sub(order.Quantity),
+				QuantityLeft:     order.Quantity,
+			})
+
+			if order.Quantity.IsZero() {
+				canceledIDs[order.ID.String()] = true
+			}
+		}
+	}
+
+	sort.Slice(adjustments, func(i, j int) bool {
+		return adjustments[i].OrderID.LT(adjustments[j].OrderID)
+	})
+
+	m.orders.bid = excludeOrders(m.orders.bid, canceledIDs)
+	m.orders.ask = excludeOrders(m.orders.ask, canceledIDs)
+
+	return adjustments
+}
+
+// getCrossedOlderOrders returns not canceled opposite orders with higher matching priority crossed by the order.
+// Result is sorted by price priority (lower ask / higher bid price first) and then by matching priority.
+func getCrossedOlderOrders(order *orders.Order, ownerBids, ownerAsks []*orders.Order) []*orders.Order {
+	isCrossed := func(bid, ask *orders.Order) bool {
+		return bid.Price.GTE(ask.Price)
+	}
+
+	crossed := make([]*orders.Order, 0)
+	if order.Direction == orders.BidDirection {
+		for _, ask := range ownerAsks {
+			if ask.GetPriorityID().LT(order.GetPriorityID()) && !ask.Quantity.IsZero() && isCrossed(order, ask) {
+				crossed = append(crossed, ask)
+			}
+		}
+	} else {
+		for _, bid := range ownerBids {
+			if bid.GetPriorityID().LT(order.GetPriorityID()) && !bid.Quantity.IsZero() && isCrossed(bid, order) {
+				crossed = append(crossed, bid)
+			}
+		}
+	}
+
+	sort.Slice(crossed, func(i, j int) bool {
+		if !crossed[i].Price.Equal(crossed[j].Price) {
+			if order.Direction == orders.BidDirection {
+				return crossed[i].Price.LT(crossed[j].Price)
+			}
+			return crossed[i].Price.GT(crossed[j].Price)
+		}
+
+		return crossed[i].GetPriorityID().LT(crossed[j].GetPriorityID())
+	})
+
+	return crossed
+}
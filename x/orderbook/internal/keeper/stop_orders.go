@@ -0,0 +1,85 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	dnTypes "github.com/dfinance/dnode/helpers/types"
+	"github.com/dfinance/dnode/x/markets"
+	"github.com/dfinance/dnode/x/orderbook/internal/types"
+	"github.com/dfinance/dnode/x/orders"
+)
+
+// TriggerStopOrders activates stop orders which stop price is crossed by the trigger price.
+// Triggered orders become regular limit orders and join the next matching round.
+// Stop orders are read market by market using the orders stop price index (only triggered orders are read),
+// market orders are triggered in ID order.
+// Returns number of triggered orders.
+func (k Keeper) TriggerStopOrders(ctx sdk.Context) int {
+	k.modulePerms.AutoCheck(types.PermOrdersTrigger)
+
+	triggeredCnt := 0
+	for _, market := range k.GetMarkets(ctx) {
+		triggerPrices := make(map[string]sdk.Uint)
+		ids := make([]dnTypes.ID, 0)
+		for _, trigger := range []orders.StopTrigger{orders.StopTriggerClearance, orders.StopTriggerOracle} {
+			price := k.getStopTriggerPrice(ctx, market, trigger)
+			for _, id := range k.orderKeeper.GetTriggeredStopOrderIDs(ctx, market.ID, trigger, price) {
+				triggerPrices[id.String()] = price
+				ids = append(ids, id)
+			}
+		}
+		sort.Slice(ids, func(i, j int) bool { return ids[i].LT(ids[j]) })
+
+		for _, id := range ids {
+			if _, err := k.orderKeeper.TriggerStopOrder(ctx, id, triggerPrices[id.String()]); err != nil {
+				k.GetLogger(ctx).Error(fmt.Sprintf("Triggering stop order %q: %v", id, err))
+				continue
+			}
+			triggeredCnt++
+		}
+	}
+
+	return triggeredCnt
+}
+
+// getStopTriggerPrice returns the current trigger price for the market and trigger source (zero if not available).
+func (k Keeper) getStopTriggerPrice(ctx sdk.Context, market markets.Market, trigger orders.StopTrigger) sdk.Uint {
+	switch trigger {
+	case orders.StopTriggerClearance:
+		return k.getLastClearancePrice(ctx, market.ID)
+	case orders.StopTriggerOracle:
+		return k.getOraclePrice(ctx, market)
+	}
+
+	return sdk.ZeroUint()
+}
+
+// getLastClearancePrice returns the last market clearance price (zero if not available).
+func (k Keeper) getLastClearancePrice(ctx sdk.Context, marketID dnTypes.ID) sdk.Uint {
+	item, err := k.GetLastHistoryItem(ctx, marketID)
+	if err != nil {
+		return sdk.ZeroUint()
+	}
+
+	return item.ClearancePrice
+}
+
+// getOraclePrice returns the oracle current price for the market asset code (zero if not available or stale).
+// Oracle price (PriceDecimals precision) is converted to the quote currency decimals (market price units).
+func (k Keeper) getOraclePrice(ctx sdk.Context, market markets.Market) sdk.Uint {
+	currentPrice := k.oracleKeeper.GetCurrentPrice(ctx, market.GetAssetCode())
+	if currentPrice.AssetCode == "" || currentPrice.Stale || !currentPrice.Price.IsPositive() {
+		return sdk.ZeroUint()
+	}
+
+	marketExt, err := k.marketKeeper.GetExtended(ctx, market.ID)
+	if err != nil {
+		k.GetLogger(ctx).Error(fmt.Sprintf("Reading market %q currencies: %v", market.ID, err))
+		return sdk.ZeroUint()
+	}
+
+	return marketExt.QuoteCurrency.DecToUint(currentPrice.GetPriceDec())
+}
@@ -0,0 +1,140 @@
+// +build unit
+
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authTypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dfinance/dnode/helpers/perms"
+	"github.com/dfinance/dnode/x/markets"
+	marketsClient "github.com/dfinance/dnode/x/markets/client"
+	"github.com/dfinance/dnode/x/oracle"
+	oracleClient "github.com/dfinance/dnode/x/oracle/client"
+	"github.com/dfinance/dnode/x/orderbook/internal/types"
+	"github.com/dfinance/dnode/x/orders"
+	ordersClient "github.com/dfinance/dnode/x/orders/client"
+)
+
+func TestOBKeeper_TriggerStopOrders(t *testing.T) {
+	input := NewTestInput(t)
+
+	// test keepers with extra permissions to create a market and post orders
+	marketKeeper := markets.NewKeeper(
+		input.cdc,
+		input.keyMarkets,
+		params.NewSubspace(input.cdc, input.keyParams, input.tKeyParams, markets.DefaultParamspace),
+		input.ccsKeeper,
+		func() (moduleName string, modulePerms perms.Permissions) {
+			return types.ModuleName, perms.Permissions{marketsClient.PermCreate, marketsClient.PermRead}
+		},
+	)
+	orderKeeper := orders.NewKeeper(
+		input.cdc,
+		input.keyOrders,
+		params.NewSubspace(input.cdc, input.keyParams, input.tKeyParams, orders.DefaultParamspace),
+		input.bankKeeper,
+		input.supplyKeeper,
+		input.marketKeeper,
+		func() (moduleName string, modulePerms perms.Permissions) {
+			return types.ModuleName, perms.Permissions{ordersClient.PermOrderPost, ordersClient.PermRead}
+		},
+	)
+
+	market, err := marketKeeper.Add(input.ctx, input.baseBtcDenom, input.quoteDenom)
+	require.NoError(t, err)
+
+	// create account with supplies
+	_, _, addr := authTypes.KeyTestPubAddr()
+	baseBalance, ok := sdk.NewIntFromString("100000000000") // 1000 btc
+	require.True(t, ok)
+	quoteBalance, ok := sdk.NewIntFromString("1000000000000000000000") // 1000 xfi
+	require.True(t, ok)
+
+	acc := input.accountKeeper.NewAccountWithAddress(input.ctx, addr)
+	require.NoError(t, acc.SetCoins(sdk.NewCoins(sdk.NewCoin(input.baseBtcDenom, baseBalance), sdk.NewCoin(input.quoteDenom, quoteBalance))))
+	input.accountKeeper.SetAccount(input.ctx, acc)
+
+	price := sdk.NewUintFromString("10000000000000000000") // 10 xfi
+	quantity := sdk.NewUintFromString("100000000")         // 1 btc
+
+	// bid stops at 11 xfi, ask stop at 9 xfi, oracle bid stop at 11 xfi
+	bidStop, err := orderKeeper.PostStopOrder(input.ctx, addr, market.GetAssetCode(), orders.BidDirection, price, quantity, 60, sdk.NewUintFromString("11000000000000000000"), orders.StopTriggerClearance)
+	require.NoError(t, err)
+	askStop, err := orderKeeper.PostStopOrder(input.ctx, addr, market.GetAssetCode(), orders.AskDirection, price, quantity, 60, sdk.NewUintFromString("9000000000000000000"), orders.StopTriggerClearance)
+	require.NoError(t, err)
+	oracleStop, err := orderKeeper.PostStopOrder(input.ctx, addr, market.GetAssetCode(), orders.BidDirection, price, quantity, 60, sdk.NewUintFromString("11000000000000000000"), orders.StopTriggerOracle)
+	require.NoError(t, err)
+
+	// no history: nothing is triggered
+	require.Equal(t, 0, input.keeper.TriggerStopOrders(input.ctx))
+
+	// clearance price between stops: nothing is triggered
+	{
+		item := NewMockHistoryItem(market.ID, 1)
+		item.ClearancePrice = price
+		input.keeper.SetHistoryItem(input.ctx, item)
+
+		require.Equal(t, 0, input.keeper.TriggerStopOrders(input.ctx))
+	}
+
+	// clearance price goes up: bid stop is triggered, oracle stop is not (no oracle price)
+	{
+		item := NewMockHistoryItem(market.ID, 2)
+		item.ClearancePrice = sdk.NewUintFromString("12000000000000000000")
+		input.keeper.SetHistoryItem(input.ctx, item)
+
+		require.Equal(t, 1, input.keeper.TriggerStopOrders(input.ctx))
+		require.True(t, orderKeeper.Has(input.ctx, bidStop.ID))
+		require.False(t, orderKeeper.HasStopOrder(input.ctx, bidStop.ID))
+		require.True(t, orderKeeper.HasStopOrder(input.ctx, askStop.ID))
+		require.True(t, orderKeeper.HasStopOrder(input.ctx, oracleStop.ID))
+	}
+
+	// clearance price goes down: ask stop is triggered
+	{
+		item := NewMockHistoryItem(market.ID, 3)
+		item.ClearancePrice = sdk.NewUintFromString("8000000000000000000")
+		input.keeper.SetHistoryItem(input.ctx, item)
+
+		require.Equal(t, 1, input.keeper.TriggerStopOrders(input.ctx))
+		require.True(t, orderKeeper.Has(input.ctx, askStop.ID))
+		require.True(t, orderKeeper.HasStopOrder(input.ctx, oracleStop.ID))
+	}
+
+	// oracle price goes up: oracle stop is triggered (oracle price is converted to the quote currency decimals)
+	{
+		oracleKeeper := oracle.NewKeeper(
+			input.cdc,
+			input.keyOracle,
+			params.NewSubspace(input.cdc, input.keyParams, input.tKeyParams, oracle.DefaultParamspace),
+			input.vmStorage,
+			input.supplyKeeper,
+			func() (moduleName string, modulePerms perms.Permissions) {
+				return types.ModuleName, perms.Permissions{oracleClient.PermRead, oracleClient.PermWrite}
+			},
+		)
+
+		ctx := input.ctx.WithBlockTime(time.Now().UTC())
+		oracleParams := oracle.DefaultGenesisState().Params
+		oracleParams.Assets = oracle.Assets{
+			oracle.Asset{AssetCode: market.GetAssetCode(), Oracles: oracle.Oracles{{Address: addr}}, Active: true},
+		}
+		oracleParams.Nominees = []string{addr.String()}
+		oracleKeeper.SetParams(ctx, oracleParams)
+
+		_, err := oracleKeeper.SetPrice(ctx, addr, market.GetAssetCode(), sdk.NewInt(1200000000), ctx.BlockTime()) // 12.0
+		require.NoError(t, err)
+		require.NoError(t, oracleKeeper.SetCurrentPrices(ctx))
+
+		require.True(t, input.keeper.getOraclePrice(ctx, market).Equal(sdk.NewUintFromString("12000000000000000000")))
+		require.Equal(t, 1, input.keeper.TriggerStopOrders(ctx))
+		require.True(t, orderKeeper.Has(ctx, oracleStop.ID))
+		require.False(t, orderKeeper.HasStopOrder(ctx, oracleStop.ID))
+	}
+}
@@ -0,0 +1,209 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/olekukonko/tablewriter"
+
+	dnTypes "github.com/dfinance/dnode/helpers/types"
+)
+
+const (
+	CandleInterval1m CandleInterval = "1m"
+	CandleInterval5m CandleInterval = "5m"
+	CandleInterval1h CandleInterval = "1h"
+	CandleInterval1d CandleInterval = "1d"
+)
+
+var (
+	// CandleIntervals contains all supported intervals (candles are built for every one of them).
+	CandleIntervals = []CandleInterval{
+		CandleInterval1m,
+		CandleInterval5m,
+		CandleInterval1h,
+		CandleInterval1d,
+	}
+)
+
+// CandleInterval defines OHLCV candle time bucket length.
+type CandleInterval string
+
+// Validate checks that interval is supported.
+func (i CandleInterval) Validate() error {
+	for _, interval := range CandleIntervals {
+		if i == interval {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("unsupported interval %q", i)
+}
+
+// Duration returns interval time bucket length.
+func (i CandleInterval) Duration() time.Duration {
+	switch i {
+	case CandleInterval1m:
+		return time.Minute
+	case CandleInterval5m:
+		return 5 * time.Minute
+	case CandleInterval1h:
+		return time.Hour
+	case CandleInterval1d:
+		return 24 * time.Hour
+	}
+
+	return 0
+}
+
+// StartTime returns time bucket start UNIX timestamp [s] the timestamp belongs to.
+func (i CandleInterval) StartTime(timestamp int64) int64 {
+	bucketLen := int64(i.Duration() / time.Second)
+
+	return timestamp - timestamp%bucketLen
+}
+
+// Candle stores OHLCV data aggregated from market history items within the time bucket.
+type Candle struct {
+	// MarketID
+	MarketID dnTypes.ID `json:"market_id" yaml:"market_id" example:"0" format:"string representation for big.Uint" swaggertype:"string"`
+	// Time bucket length
+	Interval CandleInterval `json:"interval" yaml:"interval" swaggertype:"string" example:"1m"`
+	// Time bucket start UNIX timestamp [s]
+	StartTime int64 `json:"start_time" yaml:"start_time"`
+	// First clearance price within the bucket
+	Open sdk.Uint `json:"open" yaml:"open" swaggertype:"string" example:"100"`
+	// Max clearance price within the bucket
+	High sdk.Uint `json:"high" yaml:"high" swaggertype:"string" example:"110"`
+	// Min clearance price within the bucket
+	Low sdk.Uint `json:"low" yaml:"low" swaggertype:"string" example:"90"`
+	// Last clearance price within the bucket
+	Close sdk.Uint `json:"close" yaml:"close" swaggertype:"string" example:"105"`
+	// Sum of matched bid orders volume within the bucket
+	Volume sdk.Uint `json:"volume" yaml:"volume" swaggertype:"string" example:"1000"`
+}
+
+// Valid checks that Candle is valid (used for genesis ops).
+func (c Candle) Valid() error {
+	if err := c.MarketID.Valid(); err != nil {
+		return fmt.Errorf("market_id: %w", err)
+	}
+	if err := c.Interval.Validate(); err != nil {
+		return fmt.Errorf("interval: %w", err)
+	}
+	if c.StartTime < 0 {
+		return fmt.Errorf("start_time is negative")
+	}
+	if c.Interval.StartTime(c.StartTime) != c.StartTime {
+		return fmt.Errorf("start_time is not aligned to interval")
+	}
+	if c.Open.IsZero() || c.High.IsZero() || c.Low.IsZero() || c.Close.IsZero() {
+		return fmt.Errorf("open / high / low / close: zero price")
+	}
+	if c.Low.GT(c.High) {
+		return fmt.Errorf("low is GT high")
+	}
+	if c.Open.GT(c.High) || c.Open.LT(c.Low) || c.Close.GT(c.High) || c.Close.LT(c.Low) {
+		return fmt.Errorf("open / close: out of low / high range")
+	}
+	if c.Volume.IsZero() {
+		return fmt.Errorf("volume is zero")
+	}
+
+	return nil
+}
+
+// Update adds history item clearance results to the candle.
+func (c *Candle) Update(item HistoryItem) {
+	if item.ClearancePrice.GT(c.High) {
+		c.High = item.ClearancePrice
+	}
+	if item.ClearancePrice.LT(c.Low) {
+		c.Low = item.ClearancePrice
+	}
+	c.Close = item.ClearancePrice
+	c.Volume = c.Volume.Add(item.MatchedBidVolume)
+}
+
+// Strings returns multi-line text object representation.
+func (c Candle) String() string {
+	b := strings.Builder{}
+	b.WriteString("Candle:\n")
+	b.WriteString(fmt.Sprintf("  MarketID:       %s\n", c.MarketID.String()))
+	b.WriteString(fmt.Sprintf("  Interval:       %s\n", c.Interval))
+	b.WriteString(fmt.Sprintf("  StartTime [s]:  %d\n", c.StartTime))
+	b.WriteString(fmt.Sprintf("  Open:           %s\n", c.Open.String()))
+	b.WriteString(fmt.Sprintf("  High:           %s\n", c.High.String()))
+	b.WriteString(fmt.Sprintf("  Low:            %s\n", c.Low.String()))
+	b.WriteString(fmt.Sprintf("  Close:          %s\n", c.Close.String()))
+	b.WriteString(fmt.Sprintf("  Volume:         %s\n", c.Volume.String()))
+
+	return b.String()
+}
+
+// TableHeaders returns table headers for multi-line text table object representation.
+func (c Candle) TableHeaders() []string {
+	headers := []string{
+		"C.MarketID",
+		"C.Interval",
+		"C.StartTime [s]",
+		"C.Open",
+		"C.High",
+		"C.Low",
+		"C.Close",
+		"C.Volume",
+	}
+
+	return headers
+}
+
+// TableHeaders returns table rows for multi-line text table object representation.
+func (c Candle) TableValues() []string {
+	values := []string{
+		c.MarketID.String(),
+		string(c.Interval),
+		time.Unix(c.StartTime, 0).String(),
+		c.Open.String(),
+		c.High.String(),
+		c.Low.String(),
+		c.Close.String(),
+		c.Volume.String(),
+	}
+
+	return values
+}
+
+// NewCandle creates a new Candle object for the interval using history item clearance results.
+func NewCandle(interval CandleInterval, item HistoryItem) Candle {
+	return Candle{
+		MarketID:  item.MarketID,
+		Interval:  interval,
+		StartTime: interval.StartTime(item.Timestamp),
+		Open:      item.ClearancePrice,
+		High:      item.ClearancePrice,
+		Low:       item.ClearancePrice,
+		Close:     item.ClearancePrice,
+		Volume:    item.MatchedBidVolume,
+	}
+}
+
+// Candle slice type.
+type Candles []Candle
+
+// Strings returns multi-line text object representation.
+func (c Candles) String() string {
+	var buf bytes.Buffer
+
+	t := tablewriter.NewWriter(&buf)
+	t.SetHeader(Candle{}.TableHeaders())
+
+	for _, candle := range c {
+		t.Append(candle.TableValues())
+	}
+	t.Render()
+
+	return buf.String()
+}
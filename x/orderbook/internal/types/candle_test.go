@@ -0,0 +1,114 @@
+// +build unit
+
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrderBook_CandleInterval(t *testing.T) {
+	// validate
+	for _, interval := range CandleIntervals {
+		require.NoError(t, interval.Validate())
+	}
+	require.Error(t, CandleInterval("").Validate())
+	require.Error(t, CandleInterval("2m").Validate())
+
+	// bucket start time
+	timestamp := int64(1600090359) // 2020-09-14 13:32:39 UTC
+	require.Equal(t, int64(1600090320), CandleInterval1m.StartTime(timestamp))
+	require.Equal(t, int64(1600090200), CandleInterval5m.StartTime(timestamp))
+	require.Equal(t, int64(1600088400), CandleInterval1h.StartTime(timestamp))
+	require.Equal(t, int64(1600041600), CandleInterval1d.StartTime(timestamp))
+	require.Equal(t, int64(1600041600), CandleInterval1d.StartTime(1600041600))
+}
+
+func TestOrderBook_Candle_Update(t *testing.T) {
+	item := NewMockHistoryItem(1)
+	item.Timestamp = 1600000030
+
+	candle := NewCandle(CandleInterval1m, item)
+	require.NoError(t, candle.Valid())
+	require.Equal(t, int64(1600000020), candle.StartTime)
+	require.True(t, candle.Open.Equal(item.ClearancePrice))
+	require.True(t, candle.High.Equal(item.ClearancePrice))
+	require.True(t, candle.Low.Equal(item.ClearancePrice))
+	require.True(t, candle.Close.Equal(item.ClearancePrice))
+	require.True(t, candle.Volume.Equal(item.MatchedBidVolume))
+
+	// price goes up
+	item.ClearancePrice = sdk.NewUint(150)
+	candle.Update(item)
+	require.NoError(t, candle.Valid())
+	require.True(t, candle.Open.Equal(sdk.NewUint(100)))
+	require.True(t, candle.High.Equal(sdk.NewUint(150)))
+	require.True(t, candle.Low.Equal(sdk.NewUint(100)))
+	require.True(t, candle.Close.Equal(sdk.NewUint(150)))
+	require.True(t, candle.Volume.Equal(sdk.NewUint(400)))
+
+	// price goes down
+	item.ClearancePrice = sdk.NewUint(50)
+	candle.Update(item)
+	require.NoError(t, candle.Valid())
+	require.True(t, candle.Open.Equal(sdk.NewUint(100)))
+	require.True(t, candle.High.Equal(sdk.NewUint(150)))
+	require.True(t, candle.Low.Equal(sdk.NewUint(50)))
+	require.True(t, candle.Close.Equal(sdk.NewUint(50)))
+	require.True(t, candle.Volume.Equal(sdk.NewUint(600)))
+}
+
+func TestOrderBook_Candle_Valid(t *testing.T) {
+	item := NewMockHistoryItem(1)
+	item.Timestamp = 1600000030
+
+	// ok
+	{
+		candle := NewCandle(CandleInterval1h, item)
+		require.NoError(t, candle.Valid())
+	}
+
+	// wrong interval
+	{
+		candle := NewCandle(CandleInterval1h, item)
+		candle.Interval = "2h"
+		require.Error(t, candle.Valid())
+	}
+
+	// not aligned start time
+	{
+		candle := NewCandle(CandleInterval1h, item)
+		candle.StartTime++
+		require.Error(t, candle.Valid())
+	}
+
+	// zero price
+	{
+		candle := NewCandle(CandleInterval1h, item)
+		candle.Low = sdk.ZeroUint()
+		require.Error(t, candle.Valid())
+	}
+
+	// low GT high
+	{
+		candle := NewCandle(CandleInterval1h, item)
+		candle.Low = candle.High.Add(sdk.OneUint())
+		require.Error(t, candle.Valid())
+	}
+
+	// close out of range
+	{
+		candle := NewCandle(CandleInterval1h, item)
+		candle.Close = candle.High.Add(sdk.OneUint())
+		require.Error(t, candle.Valid())
+	}
+
+	// zero volume
+	{
+		candle := NewCandle(CandleInterval1h, item)
+		candle.Volume = sdk.ZeroUint()
+		require.Error(t, candle.Valid())
+	}
+}
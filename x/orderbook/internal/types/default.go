@@ -3,4 +3,6 @@ package types
 const (
 	ModuleName = "orderbook"
 	StoreKey   = ModuleName
+	// Default params subspace name
+	DefaultParamspace = ModuleName
 )
@@ -6,4 +6,8 @@ var (
 	ErrInternal = sdkErrors.Register(ModuleName, 100, "internal")
 	// HistoryItem not found.
 	ErrWrongHistoryItem = sdkErrors.Register(ModuleName, 101, "wrong marketID / blockHeight")
+	// Candle request is invalid.
+	ErrWrongCandle = sdkErrors.Register(ModuleName, 102, "wrong candle interval / time range")
+	// MarketHalt is invalid.
+	ErrWrongMarketHalt = sdkErrors.Register(ModuleName, 103, "wrong market halt")
 )
@@ -1,14 +1,26 @@
 package types
 
 import (
+	"strconv"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	dnTypes "github.com/dfinance/dnode/helpers/types"
 )
 
 const (
-	EventTypeClearance = ModuleName + ".clearance"
+	EventTypeClearance           = ModuleName + ".clearance"
+	EventTypeSelfTradePrevention = ModuleName + ".self_trade_prevention"
+	EventTypeCircuitBreaker      = ModuleName + ".circuit_breaker"
 	//
-	AttributeMarketId = "market_id"
-	AttributePrice    = "price"
+	AttributeMarketId         = "market_id"
+	AttributePrice            = "price"
+	AttributeOrderId          = "order_id"
+	AttributeOwner            = "owner"
+	AttributeQuantityCanceled = "quantity_canceled"
+	AttributeQuantityLeft     = "quantity_left"
+	AttributeReferencePrice   = "reference_price"
+	AttributeResumeHeight     = "resume_height"
 )
 
 // NewClearanceEvent creates an Event on successful market match.
@@ -19,3 +31,27 @@ func NewClearanceEvent(result MatcherResult) sdk.Event {
 		sdk.NewAttribute(AttributePrice, result.ClearanceState.Price.String()),
 	)
 }
+
+// NewSelfTradePreventionEvent creates an Event on order canceled / decremented by the self-trade prevention.
+func NewSelfTradePreventionEvent(marketID dnTypes.ID, adjustment SelfTradeAdjustment) sdk.Event {
+	return sdk.NewEvent(
+		EventTypeSelfTradePrevention,
+		sdk.NewAttribute(AttributeMarketId, marketID.String()),
+		sdk.NewAttribute(AttributeOrderId, adjustment.OrderID.String()),
+		sdk.NewAttribute(AttributeOwner, adjustment.Owner.String()),
+		sdk.NewAttribute(AttributeQuantityCanceled, adjustment.QuantityCanceled.String()),
+		sdk.NewAttribute(AttributeQuantityLeft, adjustment.QuantityLeft.String()),
+	)
+}
+
+// NewCircuitBreakerEvent creates an Event on market matching skipped due to the clearance price out of the deviation band.
+// Zero resumeHeight means market status wasn't changed.
+func NewCircuitBreakerEvent(marketID dnTypes.ID, price, referencePrice sdk.Uint, resumeHeight int64) sdk.Event {
+	return sdk.NewEvent(
+		EventTypeCircuitBreaker,
+		sdk.NewAttribute(AttributeMarketId, marketID.String()),
+		sdk.NewAttribute(AttributePrice, price.String()),
+		sdk.NewAttribute(AttributeReferencePrice, referencePrice.String()),
+		sdk.NewAttribute(AttributeResumeHeight, strconv.FormatInt(resumeHeight, 10)),
+	)
+}
@@ -8,11 +8,18 @@ import (
 
 // GenesisState orderbook state that must be provided at genesis.
 type GenesisState struct {
+	Params       Params       `json:"params" yaml:"params"`
 	HistoryItems HistoryItems `json:"history_items" yaml:"history_items"`
+	Candles      Candles      `json:"candles" yaml:"candles"`
+	MarketHalts  MarketHalts  `json:"market_halts" yaml:"market_halts"`
 }
 
 // Validate checks that genesis state is valid.
 func (gs GenesisState) Validate(currentBlockTime time.Time, currentBlockHeight int64) error {
+	if err := gs.Params.Validate(); err != nil {
+		return fmt.Errorf("params: %w", err)
+	}
+
 	historyItemIdsSet := make(map[string]bool, len(gs.HistoryItems))
 	var maxBlockHeight int64
 
@@ -46,6 +53,41 @@ func (gs GenesisState) Validate(currentBlockTime time.Time, currentBlockHeight i
 		return fmt.Errorf("historyItem blockHeight: GT current blockHeight")
 	}
 
+	candleIdsSet := make(map[string]bool, len(gs.Candles))
+	getCandleId := func(candle Candle) string {
+		return fmt.Sprintf("%s:%s:%d", candle.MarketID, candle.Interval, candle.StartTime)
+	}
+
+	for i, candle := range gs.Candles {
+		if err := candle.Valid(); err != nil {
+			return fmt.Errorf("candle[%d]: %w", i, err)
+		}
+
+		if !currentBlockTime.IsZero() && time.Unix(candle.StartTime, 0).After(currentBlockTime) {
+			return fmt.Errorf("candle[%d]: start_time after block time", i)
+		}
+
+		candleId := getCandleId(candle)
+		if candleIdsSet[candleId] {
+			return fmt.Errorf("candle[%d]: duplicated ID %q", i, candleId)
+		}
+
+		candleIdsSet[candleId] = true
+	}
+
+	haltIdsSet := make(map[string]bool, len(gs.MarketHalts))
+	for i, halt := range gs.MarketHalts {
+		if err := halt.Valid(); err != nil {
+			return fmt.Errorf("marketHalt[%d]: %w", i, err)
+		}
+
+		if haltIdsSet[halt.MarketID.String()] {
+			return fmt.Errorf("marketHalt[%d]: duplicated marketID %q", i, halt.MarketID)
+		}
+
+		haltIdsSet[halt.MarketID.String()] = true
+	}
+
 	return nil
 }
 
@@ -64,6 +106,9 @@ func (gs GenesisState) IsEmpty() bool {
 // DefaultGenesisState defines default GenesisState for orderbook.
 func DefaultGenesisState() GenesisState {
 	return GenesisState{
+		Params:       DefaultParams(),
 		HistoryItems: HistoryItems{},
+		Candles:      Candles{},
+		MarketHalts:  MarketHalts{},
 	}
 }
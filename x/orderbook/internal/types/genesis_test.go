@@ -6,7 +6,11 @@ import (
 	"testing"
 	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
+
+	dnTypes "github.com/dfinance/dnode/helpers/types"
+	"github.com/dfinance/dnode/x/markets"
 )
 
 func getTestGenesisState(id uint64) GenesisState {
@@ -89,4 +93,67 @@ func TestOrderBook_Genesis_Valid(t *testing.T) {
 			require.Nil(t, err)
 		}
 	}
+
+	// invalid params
+	{
+		state := getTestGenesisState(1)
+		state.Params.CandleRetention = time.Hour
+		err := state.Validate(time.Now(), 1)
+
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "candle_retention")
+	}
+
+	// candles
+	{
+		state := getTestGenesisState(1)
+		state.Params = DefaultParams()
+		state.Candles = Candles{
+			NewCandle(CandleInterval1m, state.HistoryItems[0]),
+			NewCandle(CandleInterval1h, state.HistoryItems[0]),
+		}
+		require.NoError(t, state.Validate(time.Now(), 1))
+
+		// duplicated
+		state.Candles = append(state.Candles, state.Candles[0])
+		err := state.Validate(time.Now(), 1)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "duplicated")
+
+		// invalid
+		state.Candles = Candles{state.Candles[0]}
+		state.Candles[0].Volume = sdk.ZeroUint()
+		err = state.Validate(time.Now(), 1)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "volume")
+	}
+
+	// market halts
+	{
+		state := getTestGenesisState(1)
+		state.Params = DefaultParams()
+		state.MarketHalts = MarketHalts{
+			{MarketID: dnTypes.NewIDFromUint64(0), ResumeHeight: 10},
+			{MarketID: dnTypes.NewIDFromUint64(1), ResumeHeight: 10},
+		}
+		require.NoError(t, state.Validate(time.Now(), 1))
+
+		// duplicated
+		state.MarketHalts = append(state.MarketHalts, state.MarketHalts[0])
+		err := state.Validate(time.Now(), 1)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "duplicated")
+
+		// invalid
+		state.MarketHalts = MarketHalts{{MarketID: dnTypes.NewIDFromUint64(0)}}
+		err = state.Validate(time.Now(), 1)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "resume_height")
+
+		// invalid pre-halt status
+		state.MarketHalts = MarketHalts{{MarketID: dnTypes.NewIDFromUint64(0), ResumeHeight: 10, PrevStatus: markets.MarketStatusPostOnly}}
+		err = state.Validate(time.Now(), 1)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "prev_status")
+	}
 }
@@ -32,6 +32,8 @@ type HistoryItem struct {
 	MatchedBidVolume sdk.Uint `json:"matched_bid_volume" yaml:"matched_bid_volume" swaggertype:"string" example:"1000"`
 	// Matched ask orders volume
 	MatchedAskVolume sdk.Uint `json:"matched_ask_volume" yaml:"matched_ask_volume" swaggertype:"string" example:"2000"`
+	// Trading fees collected
+	Fees sdk.Coins `json:"fees" yaml:"fees" swaggertype:"string" example:"10xfi"`
 	// UNIX timestamp [s]
 	Timestamp int64 `json:"timestamp" yaml:"timestamp"`
 	// Block number
@@ -52,6 +54,9 @@ func (h HistoryItem) Valid() error {
 	if h.AskOrdersCount < 0 {
 		return fmt.Errorf("ask_orders_count has negative value")
 	}
+	if !h.Fees.IsValid() {
+		return fmt.Errorf("fees: invalid")
+	}
 	if h.Timestamp < 0 {
 		return fmt.Errorf("timestamp is negative")
 	}
@@ -73,6 +78,7 @@ func (h HistoryItem) String() string {
 	b.WriteString(fmt.Sprintf("  AskVolume:        %s\n", h.AskVolume.String()))
 	b.WriteString(fmt.Sprintf("  MatchedBidVolume: %s\n", h.MatchedBidVolume.String()))
 	b.WriteString(fmt.Sprintf("  MatchedAskVolume: %s\n", h.MatchedAskVolume.String()))
+	b.WriteString(fmt.Sprintf("  Fees:             %s\n", h.Fees.String()))
 	b.WriteString(fmt.Sprintf("  Timestamp [s]:    %d\n", h.Timestamp))
 	b.WriteString(fmt.Sprintf("  BlockHeight:      %d\n", h.BlockHeight))
 
@@ -90,6 +96,7 @@ func (h HistoryItem) TableHeaders() []string {
 		"H.AskVolume",
 		"H.MatchedBidVolume",
 		"H.MatchedAskVolume",
+		"H.Fees",
 		"H.Timestamp [s]",
 		"H.BlockHeight",
 	}
@@ -108,6 +115,7 @@ func (h HistoryItem) TableValues() []string {
 		h.AskVolume.String(),
 		h.MatchedBidVolume.String(),
 		h.MatchedAskVolume.String(),
+		h.Fees.String(),
 		time.Unix(h.Timestamp, 0).String(),
 		strconv.FormatInt(h.BlockHeight, 10),
 	}
@@ -115,7 +123,8 @@ func (h HistoryItem) TableValues() []string {
 	return values
 }
 
-func NewHistoryItem(ctx sdk.Context, result MatcherResult) HistoryItem {
+// NewHistoryItem creates a new HistoryItem object using matcher result and fees collected.
+func NewHistoryItem(ctx sdk.Context, result MatcherResult, fees sdk.Coins) HistoryItem {
 	return HistoryItem{
 		MarketID:         result.MarketID,
 		ClearancePrice:   result.ClearanceState.Price,
@@ -125,6 +134,7 @@ func NewHistoryItem(ctx sdk.Context, result MatcherResult) HistoryItem {
 		AskVolume:        sdk.Uint(result.ClearanceState.MaxAskVolume.TruncateInt()),
 		MatchedBidVolume: sdk.Uint(result.MatchedBidVolume.TruncateInt()),
 		MatchedAskVolume: sdk.Uint(result.MatchedAskVolume.TruncateInt()),
+		Fees:             fees,
 		Timestamp:        ctx.BlockTime().Unix(),
 		BlockHeight:      ctx.BlockHeight(),
 	}
@@ -12,6 +12,8 @@ import (
 var (
 	KeyDelimiter         = []byte(":")
 	HistoryItemKeyPrefix = []byte("history_item")
+	CandleKeyPrefix      = []byte("candle")
+	MarketHaltKeyPrefix  = []byte("market_halt")
 )
 
 // GetOrderKey returns storage key for order ID.
@@ -25,3 +27,52 @@ func GetHistoryItemKey(marketID dnTypes.ID, blockHeight int64) []byte {
 		KeyDelimiter,
 	)
 }
+
+// GetHistoryItemsMarketPrefix returns storage key prefix for marketID history items.
+func GetHistoryItemsMarketPrefix(marketID dnTypes.ID) []byte {
+	return bytes.Join(
+		[][]byte{
+			HistoryItemKeyPrefix,
+			sdk.Uint64ToBigEndian(marketID.UInt64()),
+			{},
+		},
+		KeyDelimiter,
+	)
+}
+
+// GetCandleKey returns storage key for market candle by interval and start time.
+func GetCandleKey(marketID dnTypes.ID, interval CandleInterval, startTime int64) []byte {
+	return bytes.Join(
+		[][]byte{
+			CandleKeyPrefix,
+			sdk.Uint64ToBigEndian(marketID.UInt64()),
+			[]byte(interval),
+			sdk.Uint64ToBigEndian(uint64(startTime)),
+		},
+		KeyDelimiter,
+	)
+}
+
+// GetCandlesPrefix returns storage key prefix for market candles by interval.
+func GetCandlesPrefix(marketID dnTypes.ID, interval CandleInterval) []byte {
+	return bytes.Join(
+		[][]byte{
+			CandleKeyPrefix,
+			sdk.Uint64ToBigEndian(marketID.UInt64()),
+			[]byte(interval),
+			{},
+		},
+		KeyDelimiter,
+	)
+}
+
+// GetMarketHaltKey returns storage key for circuit breaker market halt.
+func GetMarketHaltKey(marketID dnTypes.ID) []byte {
+	return bytes.Join(
+		[][]byte{
+			MarketHaltKeyPrefix,
+			sdk.Uint64ToBigEndian(marketID.UInt64()),
+		},
+		KeyDelimiter,
+	)
+}
@@ -0,0 +1,59 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	dnTypes "github.com/dfinance/dnode/helpers/types"
+	"github.com/dfinance/dnode/x/markets"
+)
+
+// MarketHalt stores circuit breaker halted (switched to post-only) market resume info.
+type MarketHalt struct {
+	// Market ID
+	MarketID dnTypes.ID `json:"market_id" yaml:"market_id" swaggertype:"string" example:"0"`
+	// Block height at which market is switched back to the pre-halt status (at the end of the block)
+	ResumeHeight int64 `json:"resume_height" yaml:"resume_height" example:"100"`
+	// Market status before the halt (empty value is treated as active)
+	PrevStatus markets.MarketStatus `json:"prev_status" yaml:"prev_status" swaggertype:"string" example:"active"`
+}
+
+// GetPrevStatus returns market status before the halt (empty value is treated as active).
+func (h MarketHalt) GetPrevStatus() markets.MarketStatus {
+	if h.PrevStatus == "" {
+		return markets.MarketStatusActive
+	}
+
+	return h.PrevStatus
+}
+
+// Valid checks object validity.
+func (h MarketHalt) Valid() error {
+	if err := h.MarketID.Valid(); err != nil {
+		return sdkErrors.Wrapf(ErrWrongMarketHalt, "market_id: %v", err)
+	}
+	if h.ResumeHeight <= 0 {
+		return sdkErrors.Wrap(ErrWrongMarketHalt, "resume_height: should be GT 0")
+	}
+	if !h.GetPrevStatus().AllowsMatching() {
+		return sdkErrors.Wrapf(ErrWrongMarketHalt, "prev_status: %q doesn't allow matching", h.PrevStatus)
+	}
+
+	return nil
+}
+
+// String returns multi-line text object representation.
+func (h MarketHalt) String() string {
+	b := strings.Builder{}
+	b.WriteString("MarketHalt:\n")
+	b.WriteString(fmt.Sprintf("  MarketID:     %s\n", h.MarketID))
+	b.WriteString(fmt.Sprintf("  ResumeHeight: %d\n", h.ResumeHeight))
+	b.WriteString(fmt.Sprintf("  PrevStatus:   %s\n", h.GetPrevStatus()))
+
+	return b.String()
+}
+
+// MarketHalt slice.
+type MarketHalts []MarketHalt
@@ -13,19 +13,21 @@ import (
 // MatcherResult stores matcher results.
 type MatcherResult struct {
 	// MarketID
-	MarketID dnTypes.ID
+	MarketID dnTypes.ID `json:"market_id" yaml:"market_id" swaggertype:"string" example:"0"`
 	// Total number of active bid orders
-	BidOrdersCount int
+	BidOrdersCount int `json:"bid_orders_count" yaml:"bid_orders_count"`
 	// Total number of active ask orders
-	AskOrdersCount int
+	AskOrdersCount int `json:"ask_orders_count" yaml:"ask_orders_count"`
 	// PQCurve crossing point data
-	ClearanceState ClearanceState
+	ClearanceState ClearanceState `json:"clearance_state" yaml:"clearance_state"`
 	// Sum of matched bid orders volume
-	MatchedBidVolume sdk.Dec
+	MatchedBidVolume sdk.Dec `json:"matched_bid_volume" yaml:"matched_bid_volume" swaggertype:"string" example:"100.0"`
 	// Sum of matched ask orders volume
-	MatchedAskVolume sdk.Dec
+	MatchedAskVolume sdk.Dec `json:"matched_ask_volume" yaml:"matched_ask_volume" swaggertype:"string" example:"100.0"`
 	// Fully / partially filled orders with some meta
-	OrderFills orders.OrderFills
+	OrderFills orders.OrderFills `json:"order_fills" yaml:"order_fills"`
+	// Orders canceled / decremented by the self-trade prevention before matching
+	SelfTradeAdjustments SelfTradeAdjustments `json:"self_trade_adjustments" yaml:"self_trade_adjustments"`
 }
 
 func (r MatcherResult) ShortString() string {
@@ -34,7 +36,8 @@ func (r MatcherResult) ShortString() string {
 	b.WriteString(fmt.Sprintf("  Bid/Ask orders count:   %d/%d\n", r.BidOrdersCount, r.AskOrdersCount))
 	b.WriteString(fmt.Sprintf("  ClearanceState.Price:   %s\n", r.ClearanceState.Price.String()))
 	b.WriteString(fmt.Sprintf("  ClearanceState.ProRata: %s\n", r.ClearanceState.ProRata.String()))
-	b.WriteString(fmt.Sprintf("  OrderFillsCount:        %d", len(r.OrderFills)))
+	b.WriteString(fmt.Sprintf("  OrderFillsCount:        %d\n", len(r.OrderFills)))
+	b.WriteString(fmt.Sprintf("  SelfTradeAdjustments:   %d", len(r.SelfTradeAdjustments)))
 
 	return b.String()
 }
@@ -51,6 +54,10 @@ func (r MatcherResult) String() string {
 	b.WriteString(r.ClearanceState.String())
 	b.WriteString("OrderFills:\n")
 	b.WriteString(r.OrderFills.String())
+	if len(r.SelfTradeAdjustments) > 0 {
+		b.WriteString("SelfTradeAdjustments:\n")
+		b.WriteString(r.SelfTradeAdjustments.String())
+	}
 
 	return b.String()
 }
@@ -0,0 +1,39 @@
+package types
+
+import (
+	"bytes"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/olekukonko/tablewriter"
+)
+
+// OrderAggregate type stores aggregated quantity (relative to price) for bid/ask orders.
+// Bid/ask aggregates are combined to build PQCurve.
+type OrderAggregate struct {
+	Price    sdk.Uint `json:"price" yaml:"price" swaggertype:"string" example:"100"`
+	Quantity sdk.Uint `json:"quantity" yaml:"quantity" swaggertype:"string" example:"10"`
+}
+
+// OrderAggregate sort.Interface.
+type OrderAggregates []OrderAggregate
+
+// Strings returns multi-line text object representation.
+func (a *OrderAggregates) String() string {
+	var buf bytes.Buffer
+
+	t := tablewriter.NewWriter(&buf)
+	t.SetHeader([]string{
+		"OA.Price",
+		"OA.Price",
+	})
+
+	for _, o := range *a {
+		t.Append([]string{
+			o.Price.String(),
+			o.Quantity.String(),
+		})
+	}
+	t.Render()
+
+	return buf.String()
+}
@@ -0,0 +1,77 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+// Default parameters values.
+const (
+	// candles are kept for 30 days
+	DefCandleRetention = 30 * 24 * time.Hour
+	// candles retention can't be shorter than the longest candle interval (0 disables pruning)
+	MinCandleRetention = 24 * time.Hour
+)
+
+// Parameter store key.
+var (
+	ParamStoreKeyCandleRetention = []byte("candleretention")
+)
+
+// Params defines keeper params.
+type Params struct {
+	// Candles with end time older than the retention period are pruned (0 - candles are not pruned)
+	CandleRetention time.Duration `json:"candle_retention" yaml:"candle_retention"`
+}
+
+// Implements subspace.ParamSet interface.
+func (p *Params) ParamSetPairs() params.ParamSetPairs {
+	return params.ParamSetPairs{
+		{Key: ParamStoreKeyCandleRetention, Value: &p.CandleRetention, ValidatorFn: validateCandleRetention},
+	}
+}
+
+// Validate validates params.
+func (p Params) Validate() error {
+	return validateCandleRetention(p.CandleRetention)
+}
+
+func (p Params) String() string {
+	return fmt.Sprintf("Params:\n"+
+		"CandleRetention: %s",
+		p.CandleRetention,
+	)
+}
+
+// NewParams creates a new module Params.
+func NewParams(candleRetention time.Duration) Params {
+	return Params{
+		CandleRetention: candleRetention,
+	}
+}
+
+// DefaultParams returns default module Params.
+func DefaultParams() Params {
+	return NewParams(DefCandleRetention)
+}
+
+// ParamKeyTable returns Key declaration for parameters storage.
+func ParamKeyTable() params.KeyTable {
+	return params.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// validateCandleRetention validates CandleRetention param value.
+func validateCandleRetention(value interface{}) error {
+	retention, ok := value.(time.Duration)
+	if !ok {
+		return fmt.Errorf("candle_retention: invalid parameter type: %T", value)
+	}
+
+	if retention != 0 && retention < MinCandleRetention {
+		return fmt.Errorf("candle_retention: should be 0 or GTE than %s", MinCandleRetention)
+	}
+
+	return nil
+}
@@ -2,6 +2,8 @@ package types
 
 import (
 	"github.com/dfinance/dnode/helpers/perms"
+	marketsClient "github.com/dfinance/dnode/x/markets/client"
+	oracleClient "github.com/dfinance/dnode/x/oracle/client"
 	ordersClient "github.com/dfinance/dnode/x/orders/client"
 )
 
@@ -14,10 +16,22 @@ const (
 	PermHistoryRead perms.Permission = ModuleName + "PermHistoryRead"
 	// Write history item
 	PermHistoryWrite perms.Permission = ModuleName + "PermHistoryWrite"
+	// Read params
+	PermParamsRead perms.Permission = ModuleName + "PermParamsRead"
+	// Write params
+	PermParamsWrite perms.Permission = ModuleName + "PermParamsWrite"
 	// Read orders
 	PermOrdersRead perms.Permission = ModuleName + "PermOrdersRead"
 	// Execute order fills
 	PermExecFill perms.Permission = ModuleName + "PermExecFill"
+	// Revoke immediate (ioc/fok) orders leftovers
+	PermOrdersRevoke perms.Permission = ModuleName + "PermOrdersRevoke"
+	// Trigger stop orders
+	PermOrdersTrigger perms.Permission = ModuleName + "PermOrdersTrigger"
+	// Apply self-trade prevention adjustments (cancel / reduce orders)
+	PermOrdersReduce perms.Permission = ModuleName + "PermOrdersReduce"
+	// Check / trip / reset markets circuit breaker
+	PermCircuitBreaker perms.Permission = ModuleName + "PermCircuitBreaker"
 )
 
 var (
@@ -26,8 +40,14 @@ var (
 		PermInit,
 		PermHistoryRead,
 		PermHistoryWrite,
+		PermParamsRead,
+		PermParamsWrite,
 		PermOrdersRead,
 		PermExecFill,
+		PermOrdersRevoke,
+		PermOrdersTrigger,
+		PermOrdersReduce,
+		PermCircuitBreaker,
 	}
 )
 
@@ -42,6 +62,32 @@ func RequestOrdersPerms() perms.RequestModulePermissions {
 		modulePerms = perms.Permissions{
 			ordersClient.PermRead,
 			ordersClient.PermExecFill,
+			ordersClient.PermOrderRevoke,
+			ordersClient.PermOrderTrigger,
+			ordersClient.PermOrderReduce,
+		}
+		return
+	}
+}
+
+// RequestMarketsPerms returns module perms used by this module.
+func RequestMarketsPerms() perms.RequestModulePermissions {
+	return func() (moduleName string, modulePerms perms.Permissions) {
+		moduleName = ModuleName
+		modulePerms = perms.Permissions{
+			marketsClient.PermRead,
+			marketsClient.PermCreate,
+		}
+		return
+	}
+}
+
+// RequestOraclePerms returns module perms used by this module.
+func RequestOraclePerms() perms.RequestModulePermissions {
+	return func() (moduleName string, modulePerms perms.Permissions) {
+		moduleName = ModuleName
+		modulePerms = perms.Permissions{
+			oracleClient.PermRead,
 		}
 		return
 	}
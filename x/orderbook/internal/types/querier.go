@@ -0,0 +1,58 @@
+package types
+
+import (
+	dnTypes "github.com/dfinance/dnode/helpers/types"
+)
+
+const (
+	QueryDepth     = "depth"
+	QueryClearance = "clearance"
+	QuerySimulate  = "simulate"
+	QueryHistory   = "history"
+	QueryCandles   = "candles"
+)
+
+// Client request for market depth / last clearance / matching simulation.
+type MarketReq struct {
+	MarketID dnTypes.ID `json:"market_id" yaml:"market_id"`
+}
+
+// Client request for market history items.
+type HistoryReq struct {
+	MarketID dnTypes.ID `json:"market_id" yaml:"market_id"`
+	// Block height range start (inclusive)
+	StartHeight int64 `json:"start_height" yaml:"start_height"`
+	// Block height range end (inclusive)
+	EndHeight int64 `json:"end_height" yaml:"end_height"`
+}
+
+// Client request for market candles.
+type CandlesReq struct {
+	MarketID dnTypes.ID `json:"market_id" yaml:"market_id"`
+	// Candle interval (1m / 5m / 1h / 1d)
+	Interval CandleInterval `json:"interval" yaml:"interval"`
+	// Candle start time range start UNIX timestamp [s] (inclusive)
+	StartTime int64 `json:"start_time" yaml:"start_time"`
+	// Candle start time range end UNIX timestamp [s] (inclusive)
+	EndTime int64 `json:"end_time" yaml:"end_time"`
+}
+
+// Depth stores market bid / ask aggregated depth levels.
+// Bid level quantity is a sum of bid orders with price GTE level price,
+// ask level quantity is a sum of ask orders with price LTE level price.
+type Depth struct {
+	// MarketID
+	MarketID dnTypes.ID `json:"market_id" yaml:"market_id" swaggertype:"string" example:"0"`
+	// Bid aggregates (price sorted ASC)
+	Bids OrderAggregates `json:"bids" yaml:"bids"`
+	// Ask aggregates (price sorted ASC)
+	Asks OrderAggregates `json:"asks" yaml:"asks"`
+}
+
+// Simulation stores matching results for current market orders (no state changes are made).
+type Simulation struct {
+	// Matcher result
+	Result MatcherResult `json:"result" yaml:"result"`
+	// Supply-demand curves points
+	SDCurves []SDItem `json:"sd_curves" yaml:"sd_curves"`
+}
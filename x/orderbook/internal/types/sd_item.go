@@ -0,0 +1,24 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Supply-demand curves point holding aggregated supply and demand quantities for price.
+type SDItem struct {
+	Price  sdk.Uint `json:"price" yaml:"price" swaggertype:"string" example:"100"`
+	Supply sdk.Uint `json:"supply" yaml:"supply" swaggertype:"string" example:"10"`
+	Demand sdk.Uint `json:"demand" yaml:"demand" swaggertype:"string" example:"20"`
+}
+
+// SupplyDemandBalance compares point supply and demand.
+func (i SDItem) SupplyDemandBalance() int {
+	if i.Supply.GT(i.Demand) {
+		return 1
+	}
+	if i.Supply.Equal(i.Demand) {
+		return 0
+	}
+
+	return -1
+}
@@ -0,0 +1,75 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/olekukonko/tablewriter"
+
+	dnTypes "github.com/dfinance/dnode/helpers/types"
+	"github.com/dfinance/dnode/x/orders"
+)
+
+// SelfTradeAdjustment stores an order change made by the self-trade prevention before matching.
+type SelfTradeAdjustment struct {
+	// Order ID
+	OrderID dnTypes.ID `json:"order_id" yaml:"order_id" example:"0" format:"string representation for big.Uint" swaggertype:"string"`
+	// Order owner
+	Owner sdk.AccAddress `json:"owner" yaml:"owner" swaggertype:"string" format:"bech32" example:"wallet13jyjuz3kkdvqw8u4qfkwd94emdl3vx394kn07h"`
+	// Order type (bid/ask)
+	Direction orders.Direction `json:"direction" yaml:"direction" swaggertype:"string" example:"bid"`
+	// Order quantity removed from matching
+	QuantityCanceled sdk.Uint `json:"quantity_canceled" yaml:"quantity_canceled" swaggertype:"string" example:"50"`
+	// Order quantity left for matching (zero for a canceled order)
+	QuantityLeft sdk.Uint `json:"quantity_left" yaml:"quantity_left" swaggertype:"string" example:"0"`
+}
+
+// IsCanceled checks if order was canceled (not just decremented).
+func (a SelfTradeAdjustment) IsCanceled() bool {
+	return a.QuantityLeft.IsZero()
+}
+
+// Strings returns multi-line text object representation.
+func (a SelfTradeAdjustment) String() string {
+	b := strings.Builder{}
+	b.WriteString("SelfTradeAdjustment:\n")
+	b.WriteString(fmt.Sprintf("  OrderID:          %s\n", a.OrderID.String()))
+	b.WriteString(fmt.Sprintf("  Owner:            %s\n", a.Owner.String()))
+	b.WriteString(fmt.Sprintf("  Direction:        %s\n", a.Direction.String()))
+	b.WriteString(fmt.Sprintf("  QuantityCanceled: %s\n", a.QuantityCanceled.String()))
+	b.WriteString(fmt.Sprintf("  QuantityLeft:     %s\n", a.QuantityLeft.String()))
+
+	return b.String()
+}
+
+// SelfTradeAdjustment slice type.
+type SelfTradeAdjustments []SelfTradeAdjustment
+
+// Strings returns multi-line text object representation.
+func (a SelfTradeAdjustments) String() string {
+	var buf bytes.Buffer
+
+	t := tablewriter.NewWriter(&buf)
+	t.SetHeader([]string{
+		"A.OrderID",
+		"A.Owner",
+		"A.Direction",
+		"A.QuantityCanceled",
+		"A.QuantityLeft",
+	})
+
+	for _, adj := range a {
+		t.Append([]string{
+			adj.OrderID.String(),
+			adj.Owner.String(),
+			adj.Direction.String(),
+			adj.QuantityCanceled.String(),
+			adj.QuantityLeft.String(),
+		})
+	}
+	t.Render()
+
+	return buf.String()
+}
@@ -7,30 +7,62 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	dnTypes "github.com/dfinance/dnode/helpers/types"
+	"github.com/dfinance/dnode/x/markets"
 )
 
-// EndBlocker iterates over active orders and cancels them by TTL timeout condition.
+// EndBlocker cancels active and stop orders using storage indexes (only orders to cancel are read).
+// Orders of delisted markets are canceled (refunded along with the order deposit), as well as orders canceled by good-till-block height
+// (order deposit is burned for expired orders).
+// Good-till-canceled orders are canceled by TTL timeout using the expiry queue.
+// Immediate (ioc/fok) orders are canceled by the orderbook module after matching.
+// Fill records older than the retention period are pruned.
 func EndBlocker(ctx sdk.Context, k Keeper) []abci.ValidatorUpdate {
-	now := ctx.BlockTime()
 	prevEventsCnt := len(ctx.EventManager().Events())
-	iterator := k.GetIterator(ctx)
-	defer iterator.Close()
 
-	for ; iterator.Valid(); iterator.Next() {
-		order := Order{}
-		ModuleCdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &order)
+	revokeOrders := func(ids []dnTypes.ID, reason string, refundDeposit bool) {
+		revokeFn := k.RevokeOrder
+		if refundDeposit {
+			revokeFn = k.RevokeOrderRefundDeposit
+		}
 
-		if now.Sub(order.CreatedAt) >= order.Ttl {
-			k.GetLogger(ctx).Info(fmt.Sprintf("order canceled by TTL: %s", order.ID.String()))
-			if err := k.RevokeOrder(ctx, order.ID); err != nil {
-				k.GetLogger(ctx).Error(fmt.Sprintf("Revoking order %q by TTL: %v", order.ID, err))
+		for _, id := range ids {
+			k.GetLogger(ctx).Info(fmt.Sprintf("order canceled by %s: %s", reason, id.String()))
+			if err := revokeFn(ctx, id); err != nil {
+				k.GetLogger(ctx).Error(fmt.Sprintf("Revoking order %q by %s: %v", id, reason, err))
 			}
 		}
 	}
 
+	for _, market := range k.GetMarkets(ctx) {
+		if market.GetStatus() == markets.MarketStatusDelisted {
+			revokeOrders(k.GetMarketOrderIDs(ctx, market.ID), "market delisting", true)
+		}
+	}
+	revokeOrders(getTtlExpiredOrderIDs(ctx, k), "TTL", false)
+	revokeOrders(k.GetGoodTillBlockExpiredOrderIDs(ctx, ctx.BlockHeight()), "good-till-block height", false)
+
+	if prunedCnt := k.PruneFillRecords(ctx); prunedCnt > 0 {
+		k.GetLogger(ctx).Info(fmt.Sprintf("fill records pruned: %d", prunedCnt))
+	}
+
 	if curEventsCnt := len(ctx.EventManager().Events()); curEventsCnt != prevEventsCnt {
 		ctx.EventManager().EmitEvent(dnTypes.NewModuleNameEvent(ModuleName))
 	}
 
 	return []abci.ValidatorUpdate{}
 }
+
+// getTtlExpiredOrderIDs reads the expiry queue till the current block time.
+func getTtlExpiredOrderIDs(ctx sdk.Context, k Keeper) []dnTypes.ID {
+	iterator := k.GetExpiryQueueIteratorTill(ctx, ctx.BlockTime())
+	defer iterator.Close()
+
+	ids := make([]dnTypes.ID, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		id := dnTypes.ID{}
+		ModuleCdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &id)
+		ids = append(ids, id)
+	}
+
+	return ids
+}
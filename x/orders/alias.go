@@ -6,33 +6,70 @@ import (
 )
 
 type (
-	GenesisState   = types.GenesisState
-	Keeper         = keeper.Keeper
-	Order          = types.Order
-	Orders         = types.Orders
-	OrderFill      = types.OrderFill
-	OrderFills     = types.OrderFills
-	Direction      = types.Direction
-	MsgPostOrder   = types.MsgPostOrder
-	MsgRevokeOrder = types.MsgRevokeOrder
-	OrdersReq      = types.OrdersReq
+	GenesisState        = types.GenesisState
+	Params              = types.Params
+	Keeper              = keeper.Keeper
+	Order               = types.Order
+	Orders              = types.Orders
+	StoredOrder         = types.StoredOrder
+	StoredOrders        = types.StoredOrders
+	OrderFill           = types.OrderFill
+	OrderFills          = types.OrderFills
+	FillRecord          = types.FillRecord
+	FillRecords         = types.FillRecords
+	FillRecordsReq      = types.FillRecordsReq
+	Direction           = types.Direction
+	TimeInForce         = types.TimeInForce
+	StopTrigger         = types.StopTrigger
+	SelfTradePrevention = types.SelfTradePrevention
+	MsgPostStopOrder    = types.MsgPostStopOrder
+	MsgPostOrder        = types.MsgPostOrder
+	MsgRevokeOrder      = types.MsgRevokeOrder
+	MsgAmendOrder       = types.MsgAmendOrder
+	MsgBatchOrders      = types.MsgBatchOrders
+	BatchOrderPost      = types.BatchOrderPost
+	MsgRevokeAllOrders  = types.MsgRevokeAllOrders
+	OrdersReq           = types.OrdersReq
 )
 
 const (
-	ModuleName   = types.ModuleName
-	StoreKey     = types.StoreKey
-	BidDirection = types.Bid
-	AskDirection = types.Ask
+	ModuleName           = types.ModuleName
+	StoreKey             = types.StoreKey
+	FeeCollectorName     = types.FeeCollectorName
+	DefaultParamspace    = types.DefaultParamspace
+	BidDirection         = types.Bid
+	AskDirection         = types.Ask
+	TimeInForceGTC       = types.TimeInForceGTC
+	TimeInForceIOC       = types.TimeInForceIOC
+	TimeInForceFOK       = types.TimeInForceFOK
+	TimeInForceGTB       = types.TimeInForceGTB
+	StopTriggerClearance = types.StopTriggerClearance
+	StopTriggerOracle    = types.StopTriggerOracle
+	// Self-trade prevention policies
+	SelfTradePreventionNone          = types.SelfTradePreventionNone
+	SelfTradePreventionCancelNewest  = types.SelfTradePreventionCancelNewest
+	SelfTradePreventionCancelOldest  = types.SelfTradePreventionCancelOldest
+	SelfTradePreventionDecrementBoth = types.SelfTradePreventionDecrementBoth
 	// Event types, attribute types
 	EventTypeOrderPost            = types.EventTypeOrderPost
 	EventTypeOrderCancel          = types.EventTypeOrderCancel
+	EventTypeOrderAmend           = types.EventTypeOrderAmend
 	EventTypeFullyFilledOrder     = types.EventTypeFullyFilledOrder
 	EventTypePartiallyFilledOrder = types.EventTypePartiallyFilledOrder
+	EventTypeStopOrderPost        = types.EventTypeStopOrderPost
+	EventTypeStopOrderTrigger     = types.EventTypeStopOrderTrigger
+	EventTypeDepositRefund        = types.EventTypeDepositRefund
+	EventTypeDepositBurn          = types.EventTypeDepositBurn
 	//
-	AttributeKeyMarketID = types.AttributeMarketId
-	AttributeKeyOrderID  = types.AttributeOrderId
-	AttributeKeyOwner    = types.AttributeOwner
-	AttributeKeyQuantity = types.AttributeQuantity
+	AttributeKeyMarketID  = types.AttributeMarketId
+	AttributeKeyOrderID   = types.AttributeOrderId
+	AttributeKeyOwner     = types.AttributeOwner
+	AttributeKeyQuantity  = types.AttributeQuantity
+	AttributeKeyFee       = types.AttributeFee
+	AttributeKeyPriority  = types.AttributePriority
+	AttributeKeyStopPrice = types.AttributeStopPrice
+	AttributeKeyTrigger   = types.AttributeTrigger
+	AttributeKeyDeposit   = types.AttributeDeposit
 )
 
 var (
@@ -40,19 +77,40 @@ var (
 	ModuleCdc            = types.ModuleCdc
 	AvailablePermissions = types.AvailablePermissions
 	// function aliases
-	RegisterCodec       = types.RegisterCodec
-	DefaultGenesisState = types.DefaultGenesisState
-	NewKeeper           = keeper.NewKeeper
-	NewQuerier          = keeper.NewQuerier
+	RegisterCodec             = types.RegisterCodec
+	NewTimeInForceRaw         = types.NewTimeInForceRaw
+	NewStopTriggerRaw         = types.NewStopTriggerRaw
+	NewSelfTradePreventionRaw = types.NewSelfTradePreventionRaw
+	DefaultGenesisState       = types.DefaultGenesisState
+	DefaultParams             = types.DefaultParams
+	NewParams                 = types.NewParams
+	NewMsgBatchOrders         = types.NewMsgBatchOrders
+	NewMsgRevokeAllOrders     = types.NewMsgRevokeAllOrders
+	NewMsgRevokeOrder         = types.NewMsgRevokeOrder
+	NewStoredOrder            = types.NewStoredOrder
+	NewStoredOrders           = types.NewStoredOrders
+	NewKeeper                 = keeper.NewKeeper
+	NewQuerier                = keeper.NewQuerier
+	RegisterInvariants        = keeper.RegisterInvariants
 	// perms requests
 	RequestMarketsPerms = types.RequestMarketsPerms
 	// error aliases
-	ErrWrongMarketID  = types.ErrWrongMarketID
-	ErrWrongOwner     = types.ErrWrongOwner
-	ErrWrongPrice     = types.ErrWrongPrice
-	ErrWrongQuantity  = types.ErrWrongQuantity
-	ErrWrongTtl       = types.ErrWrongTtl
-	ErrWrongDirection = types.ErrWrongDirection
-	ErrWrongOrderID   = types.ErrWrongOrderID
-	ErrWrongAssetCode = types.ErrWrongAssetCode
+	ErrWrongMarketID            = types.ErrWrongMarketID
+	ErrWrongOwner               = types.ErrWrongOwner
+	ErrWrongPrice               = types.ErrWrongPrice
+	ErrWrongQuantity            = types.ErrWrongQuantity
+	ErrWrongTtl                 = types.ErrWrongTtl
+	ErrWrongDirection           = types.ErrWrongDirection
+	ErrWrongOrderID             = types.ErrWrongOrderID
+	ErrWrongAssetCode           = types.ErrWrongAssetCode
+	ErrWrongMarketStatus        = types.ErrWrongMarketStatus
+	ErrWrongAmend               = types.ErrWrongAmend
+	ErrWrongTimeInForce         = types.ErrWrongTimeInForce
+	ErrWrongGoodTillBlock       = types.ErrWrongGoodTillBlock
+	ErrWrongStopPrice           = types.ErrWrongStopPrice
+	ErrWrongStopTrigger         = types.ErrWrongStopTrigger
+	ErrWrongSelfTradePrevention = types.ErrWrongSelfTradePrevention
+	ErrMaxOpenOrders            = types.ErrMaxOpenOrders
+	ErrWrongFillRecordID        = types.ErrWrongFillRecordID
+	ErrWrongBatch               = types.ErrWrongBatch
 )
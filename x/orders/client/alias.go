@@ -4,10 +4,15 @@ import "github.com/dfinance/dnode/x/orders/internal/types"
 
 const (
 	// Permissions
-	PermOrderPost   = types.PermOrderPost
-	PermOrderRevoke = types.PermOrderRevoke
-	PermRead        = types.PermRead
-	PermOrderLock   = types.PermOrderLock
-	PermOrderUnlock = types.PermOrderUnlock
-	PermExecFill    = types.PermExecFill
+	PermOrderPost    = types.PermOrderPost
+	PermOrderRevoke  = types.PermOrderRevoke
+	PermOrderAmend   = types.PermOrderAmend
+	PermOrderReduce  = types.PermOrderReduce
+	PermOrderTrigger = types.PermOrderTrigger
+	PermRead         = types.PermRead
+	PermOrderLock    = types.PermOrderLock
+	PermOrderUnlock  = types.PermOrderUnlock
+	PermExecFill     = types.PermExecFill
+	PermParamsRead   = types.PermParamsRead
+	PermParamsWrite  = types.PermParamsWrite
 )
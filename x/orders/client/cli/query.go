@@ -22,11 +22,21 @@ const (
 
 // GetCmdListOrders returns query command that lists all order objects with filters and pagination.
 func GetCmdListOrders(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return newListOrdersCmd(queryRoute, types.QueryList, cdc, "list", "Lists all orders")
+}
+
+// GetCmdListStopOrders returns query command that lists all not triggered stop order objects with filters and pagination.
+func GetCmdListStopOrders(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return newListOrdersCmd(queryRoute, types.QueryStopList, cdc, "stop-list", "Lists all not triggered stop orders")
+}
+
+// newListOrdersCmd builds orders list query command for the query endpoint.
+func newListOrdersCmd(queryRoute, queryEndpoint string, cdc *codec.Codec, use, short string) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "list",
+		Use:     use,
 		Args:    cobra.ExactArgs(0),
-		Example: "list",
-		Short:   "Lists all orders",
+		Example: use,
+		Short:   short,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := context.NewCLIContext().WithCodec(cdc)
 
@@ -64,7 +74,7 @@ func GetCmdListOrders(queryRoute string, cdc *codec.Codec) *cobra.Command {
 			}
 
 			// query and parse the result
-			res, _, err := ctx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryList), bz)
+			res, _, err := ctx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, queryEndpoint), bz)
 			if err != nil {
 				return err
 			}
@@ -83,6 +93,66 @@ func GetCmdListOrders(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	return cmd
 }
 
+// GetCmdListFillRecords returns query command that lists fill records (trade history) with filters and pagination.
+func GetCmdListFillRecords(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "fills",
+		Args:    cobra.ExactArgs(0),
+		Example: "fills --owner wallet1a7260dyzp487r7wghr99f6r3h2h2z4gk4d740k --market-id 0",
+		Short:   "Lists order fill records (newest first)",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCLIContext().WithCodec(cdc)
+
+			// parse inputs
+			ownerFilterStr := viper.GetString(flagOrderOwner)
+			marketIDFilter := viper.GetString(flagOrderMarketID)
+			pageStr, limitStr := viper.GetString(flags.FlagPage), viper.GetString(flags.FlagLimit)
+			page, limit, err := helpers.ParsePaginationParams(pageStr, limitStr, helpers.ParamTypeCliFlag)
+			if err != nil {
+				return err
+			}
+
+			ownerFilter := sdk.AccAddress{}
+			if ownerFilterStr != "" {
+				var err error
+				ownerFilter, err = sdk.AccAddressFromBech32(ownerFilterStr)
+				if err != nil {
+					return fmt.Errorf("%s argument %q parse error: %w", flagOrderOwner, ownerFilterStr, err)
+				}
+			}
+
+			// prepare request
+			req := types.FillRecordsReq{
+				Page:     page,
+				Limit:    limit,
+				Owner:    ownerFilter,
+				MarketID: marketIDFilter,
+			}
+
+			bz, err := ctx.Codec.MarshalJSON(req)
+			if err != nil {
+				return err
+			}
+
+			// query and parse the result
+			res, _, err := ctx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryFills), bz)
+			if err != nil {
+				return err
+			}
+
+			var out types.FillRecords
+			cdc.MustUnmarshalJSON(res, &out)
+
+			return ctx.PrintOutput(out)
+		},
+	}
+	helpers.AddPaginationCmdFlags(cmd)
+	cmd.Flags().String(flagOrderOwner, "", "(optional) filter by owner address")
+	cmd.Flags().String(flagOrderMarketID, "", "(optional) filter by marketID")
+
+	return cmd
+}
+
 // GetCmdOrder returns query command that returns order by id.
 func GetCmdOrder(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
@@ -1,6 +1,8 @@
 package cli
 
 import (
+	"fmt"
+	"io/ioutil"
 	"os"
 	"strings"
 
@@ -8,16 +10,23 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/dfinance/dnode/helpers"
 	"github.com/dfinance/dnode/x/orders/internal/types"
 )
 
+const (
+	flagOrderTimeInForce         = "time-in-force"
+	flagOrderGoodTillBlock       = "good-till-block"
+	flagOrderSelfTradePrevention = "self-trade-prevention"
+)
+
 // GetCmdPostOrder returns tx command which post a new order.
 func GetCmdPostOrder(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "post [asset_code] [direction] [price] [quantity] [TTL_in_sec]",
-		Example: "post btc_xfi bid 100 100000000 --from wallet1a7280dyzp487r7wghr99f6r3h2h2z4gk4d740m",
+		Example: "post btc_xfi bid 100 100000000 60 --time-in-force ioc --from wallet1a7280dyzp487r7wghr99f6r3h2h2z4gk4d740m",
 		Short:   "Post a new order",
 		Args:    cobra.ExactArgs(5),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -54,8 +63,26 @@ func GetCmdPostOrder(cdc *codec.Codec) *cobra.Command {
 				return err
 			}
 
+			timeInForce := types.NewTimeInForceRaw(strings.ToLower(viper.GetString(flagOrderTimeInForce)))
+			if !timeInForce.IsValid() {
+				return helpers.BuildError(flagOrderTimeInForce, viper.GetString(flagOrderTimeInForce), helpers.ParamTypeCliFlag, "invalid (gtc / ioc / fok / gtb)")
+			}
+
+			goodTillBlock, err := helpers.ParseInt64Param(flagOrderGoodTillBlock, viper.GetString(flagOrderGoodTillBlock), helpers.ParamTypeCliFlag)
+			if err != nil {
+				return err
+			}
+
+			selfTradePrevention := types.NewSelfTradePreventionRaw(strings.ToLower(viper.GetString(flagOrderSelfTradePrevention)))
+			if !selfTradePrevention.IsValid() {
+				return helpers.BuildError(flagOrderSelfTradePrevention, viper.GetString(flagOrderSelfTradePrevention), helpers.ParamTypeCliFlag, "invalid (none / cancel_newest / cancel_oldest / decrement_both)")
+			}
+
 			// prepare and send message
-			msg := types.NewMsgPost(fromAddr, assetCode, direction, price, quantity, ttlInSec)
+			msg := types.NewMsgPostWithTimeInForce(fromAddr, assetCode, direction, price, quantity, ttlInSec, timeInForce, goodTillBlock)
+			if selfTradePrevention != types.SelfTradePreventionNone {
+				msg.SelfTradePrevention = selfTradePrevention
+			}
 
 			cliCtx.WithOutput(os.Stdout)
 
@@ -67,8 +94,11 @@ func GetCmdPostOrder(cdc *codec.Codec) *cobra.Command {
 		"order type [bid/ask]",
 		"quoteAsset price with decimals (1.0 XFI with 18 decimals -> 1000000000000000000)",
 		"baseAsset quantity with decimals (1.0 BTC with 8 decimals -> 100000000)",
-		"order TTL [s]",
+		"order TTL [s] (not used for ioc / fok / gtb orders)",
 	})
+	cmd.Flags().String(flagOrderTimeInForce, types.TimeInForceGTC.String(), "(optional) order time-in-force policy [gtc/ioc/fok/gtb]")
+	cmd.Flags().String(flagOrderGoodTillBlock, "0", "(optional) block height gtb order is canceled after")
+	cmd.Flags().String(flagOrderSelfTradePrevention, types.SelfTradePreventionNone.String(), "(optional) order self-trade prevention policy [none/cancel_newest/cancel_oldest/decrement_both]")
 
 	return cmd
 }
@@ -108,3 +138,227 @@ func GetCmdRevokeOrder(cdc *codec.Codec) *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdRevokeAllOrders returns tx command which revokes all orders of the sender.
+func GetCmdRevokeAllOrders(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "revoke-all",
+		Short:   "Revoke all active and stop orders (optionally filtered by market and direction)",
+		Example: "revoke-all --market-id 0 --direction bid --from wallet1a7280dyzp487r7wghr99f6r3h2h2z4gk4d740m",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, txBuilder := helpers.GetTxCmdCtx(cdc, cmd.InOrStdin())
+
+			// parse inputs
+			fromAddr, err := helpers.ParseFromFlag(cliCtx)
+			if err != nil {
+				return err
+			}
+
+			marketID := viper.GetString(flagOrderMarketID)
+			if marketID != "" {
+				if _, err := helpers.ParseDnIDParam(flagOrderMarketID, marketID, helpers.ParamTypeCliFlag); err != nil {
+					return err
+				}
+			}
+
+			direction := types.Direction(strings.ToLower(viper.GetString(flagOrderDirection)))
+			if direction != "" && !direction.IsValid() {
+				return helpers.BuildError(flagOrderDirection, direction.String(), helpers.ParamTypeCliFlag, "invalid (bid / ask)")
+			}
+
+			// prepare and send message
+			msg := types.NewMsgRevokeAllOrders(fromAddr, marketID, direction)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			cliCtx.WithOutput(os.Stdout)
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBuilder, []sdk.Msg{msg})
+		},
+	}
+	cmd.Flags().String(flagOrderMarketID, "", "(optional) revoke orders of the market ID only")
+	cmd.Flags().String(flagOrderDirection, "", "(optional) revoke orders of the order type only [bid/ask]")
+
+	return cmd
+}
+
+// GetCmdAmendOrder returns tx command which amends an order price and / or quantity.
+func GetCmdAmendOrder(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "amend [order_id] [price] [quantity]",
+		Short:   "Amend an order price and / or quantity (0 keeps the current value)",
+		Example: "amend 0 100 0 --from wallet1a7280dyzp487r7wghr99f6r3h2h2z4gk4d740m",
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, txBuilder := helpers.GetTxCmdCtx(cdc, cmd.InOrStdin())
+
+			// parse inputs
+			fromAddr, err := helpers.ParseFromFlag(cliCtx)
+			if err != nil {
+				return err
+			}
+
+			orderID, err := helpers.ParseDnIDParam("order_id", args[0], helpers.ParamTypeCliArg)
+			if err != nil {
+				return err
+			}
+
+			price, err := helpers.ParseSdkUintParam("price", args[1], helpers.ParamTypeCliArg)
+			if err != nil {
+				return err
+			}
+
+			quantity, err := helpers.ParseSdkUintParam("quantity", args[2], helpers.ParamTypeCliArg)
+			if err != nil {
+				return err
+			}
+
+			// prepare and send message
+			msg := types.NewMsgAmendOrder(fromAddr, orderID, price, quantity)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			cliCtx.WithOutput(os.Stdout)
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBuilder, []sdk.Msg{msg})
+		},
+	}
+	helpers.BuildCmdHelp(cmd, []string{
+		"order ID [uint]",
+		"new price (0 keeps the current one) [uint]",
+		"new quantity (0 keeps the current one) [uint]",
+	})
+
+	return cmd
+}
+
+// GetCmdPostStopOrder returns tx command which post a new stop order.
+func GetCmdPostStopOrder(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "post-stop [asset_code] [direction] [price] [quantity] [TTL_in_sec] [stop_price] [trigger]",
+		Example: "post-stop btc_xfi ask 90 100000000 3600 95 oracle --from wallet1a7280dyzp487r7wghr99f6r3h2h2z4gk4d740m",
+		Short:   "Post a new stop order (activated when the trigger price crosses the stop price)",
+		Args:    cobra.ExactArgs(7),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, txBuilder := helpers.GetTxCmdCtx(cdc, cmd.InOrStdin())
+
+			// parse inputs
+			fromAddr, err := helpers.ParseFromFlag(cliCtx)
+			if err != nil {
+				return err
+			}
+
+			assetCode, err := helpers.ParseAssetCodeParam("asset_code", args[0], helpers.ParamTypeCliArg)
+			if err != nil {
+				return err
+			}
+
+			direction := types.Direction(strings.ToLower(args[1]))
+			if !direction.IsValid() {
+				return helpers.BuildError("direction", args[1], helpers.ParamTypeCliArg, "invalid (bid / ask)")
+			}
+
+			price, err := helpers.ParseSdkUintParam("price", args[2], helpers.ParamTypeCliArg)
+			if err != nil {
+				return err
+			}
+
+			quantity, err := helpers.ParseSdkUintParam("quantity", args[3], helpers.ParamTypeCliArg)
+			if err != nil {
+				return err
+			}
+
+			ttlInSec, err := helpers.ParseUint64Param("TTL_in_sec", args[4], helpers.ParamTypeCliArg)
+			if err != nil {
+				return err
+			}
+
+			stopPrice, err := helpers.ParseSdkUintParam("stop_price", args[5], helpers.ParamTypeCliArg)
+			if err != nil {
+				return err
+			}
+
+			stopTrigger := types.NewStopTriggerRaw(strings.ToLower(args[6]))
+			if !stopTrigger.IsValid() {
+				return helpers.BuildError("trigger", args[6], helpers.ParamTypeCliArg, "invalid (clearance / oracle)")
+			}
+
+			// prepare and send message
+			msg := types.NewMsgPostStopOrder(fromAddr, assetCode, direction, price, quantity, ttlInSec, stopPrice, stopTrigger)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			cliCtx.WithOutput(os.Stdout)
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBuilder, []sdk.Msg{msg})
+		},
+	}
+	helpers.BuildCmdHelp(cmd, []string{
+		"asset code in {base denomination_symbol}_{quote_denomination_symbol} format",
+		"order type [bid/ask]",
+		"quoteAsset limit price with decimals (1.0 XFI with 18 decimals -> 1000000000000000000)",
+		"baseAsset quantity with decimals (1.0 BTC with 8 decimals -> 100000000)",
+		"order TTL [s]",
+		"quoteAsset stop price with decimals: bid is triggered when price goes up to it, ask - when price goes down to it",
+		"trigger price source [clearance/oracle]",
+	})
+
+	return cmd
+}
+
+// GetCmdBatchOrders returns tx command which revokes and posts orders atomically.
+func GetCmdBatchOrders(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "batch [batch_file]",
+		Example: "batch ./batch.json --from wallet1a7280dyzp487r7wghr99f6r3h2h2z4gk4d740m",
+		Short:   "Revoke and post orders atomically (cancels are processed before posts)",
+		Long: `Revoke and post orders atomically (cancels are processed before posts).
+Batch file example:
+{
+  "cancels": ["0", "1"],
+  "posts": [
+    {"asset_code": "btc_xfi", "direction": "bid", "price": "100", "quantity": "100000000", "ttl_in_sec": "60"},
+    {"asset_code": "btc_xfi", "direction": "ask", "price": "110", "quantity": "100000000", "ttl_in_sec": "60", "time_in_force": "gtc"}
+  ]
+}`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, txBuilder := helpers.GetTxCmdCtx(cdc, cmd.InOrStdin())
+
+			// parse inputs
+			fromAddr, err := helpers.ParseFromFlag(cliCtx)
+			if err != nil {
+				return err
+			}
+
+			batchBz, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return helpers.BuildError("batch_file", args[0], helpers.ParamTypeCliArg, fmt.Sprintf("reading file: %v", err))
+			}
+
+			batch := types.MsgBatchOrders{}
+			if err := cdc.UnmarshalJSON(batchBz, &batch); err != nil {
+				return helpers.BuildError("batch_file", args[0], helpers.ParamTypeCliArg, fmt.Sprintf("JSON unmarshal: %v", err))
+			}
+
+			// prepare and send message
+			msg := types.NewMsgBatchOrders(fromAddr, batch.Cancels, batch.Posts)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			cliCtx.WithOutput(os.Stdout)
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBuilder, []sdk.Msg{msg})
+		},
+	}
+	helpers.BuildCmdHelp(cmd, []string{
+		"path to JSON file with order IDs to revoke (cancels) and orders to post (posts)",
+	})
+
+	return cmd
+}
@@ -20,6 +20,8 @@ func GetQueryCmd(cdc *amino.Codec) *cobra.Command {
 	queryCmd.AddCommand(sdkClient.GetCommands(
 		cli.GetCmdListOrders(types.ModuleName, cdc),
 		cli.GetCmdOrder(types.ModuleName, cdc),
+		cli.GetCmdListStopOrders(types.ModuleName, cdc),
+		cli.GetCmdListFillRecords(types.ModuleName, cdc),
 	)...)
 
 	return queryCmd
@@ -38,6 +40,10 @@ func GetTxCmd(cdc *amino.Codec) *cobra.Command {
 	txCmd.AddCommand(sdkClient.PostCommands(
 		cli.GetCmdPostOrder(cdc),
 		cli.GetCmdRevokeOrder(cdc),
+		cli.GetCmdRevokeAllOrders(cdc),
+		cli.GetCmdAmendOrder(cdc),
+		cli.GetCmdPostStopOrder(cdc),
+		cli.GetCmdBatchOrders(cdc),
 	)...,
 	)
 
@@ -32,8 +32,32 @@ type PostOrderReq struct {
 	Price string `json:"price" example:"100"`
 	// BaseAsset quantity with decimals (1.0 BTC with 8 decimals -> 100000000)
 	Quantity string `json:"quantity" example:"10"`
+	// Order TTL [s] (not used for ioc / fok / gtb orders)
+	TtlInSec string `json:"ttl_in_sec" example:"3"`
+	// Order time-in-force policy (gtc / ioc / fok / gtb), optional (gtc by default)
+	TimeInForce types.TimeInForce `json:"time_in_force" example:"gtc"`
+	// Block height gtb order is canceled after, optional
+	GoodTillBlock string `json:"good_till_block" example:"100"`
+	// Order self-trade prevention policy (none / cancel_newest / cancel_oldest / decrement_both), optional (none by default)
+	SelfTradePrevention types.SelfTradePrevention `json:"self_trade_prevention" example:"cancel_newest"`
+}
+
+type PostStopOrderReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+	// Market assetCode in the following format: {base_denomination_symbol}_{quote_denomination_symbol}
+	AssetCode dnTypes.AssetCode `json:"asset_code" example:"btc_xfi"`
+	// Order type (ask/bid)
+	Direction types.Direction `json:"direction" example:"ask"`
+	// QuoteAsset limit price with decimals (1.0 XFI with 18 decimals -> 1000000000000000000)
+	Price string `json:"price" example:"100"`
+	// BaseAsset quantity with decimals (1.0 BTC with 8 decimals -> 100000000)
+	Quantity string `json:"quantity" example:"10"`
 	// Order TTL [s]
 	TtlInSec string `json:"ttl_in_sec" example:"3"`
+	// QuoteAsset stop price with decimals (bid is triggered when price goes up to it, ask - when price goes down to it)
+	StopPrice string `json:"stop_price" example:"105"`
+	// Trigger price source (clearance/oracle)
+	StopTrigger types.StopTrigger `json:"stop_trigger" example:"oracle"`
 }
 
 type RevokeOrderReq struct {
@@ -41,12 +65,43 @@ type RevokeOrderReq struct {
 	OrderID string       `json:"order_id" yaml:"order_id" example:"100"`
 }
 
+type RevokeAllOrdersReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+	// Market ID filter, optional (all markets by default)
+	MarketID string `json:"market_id" yaml:"market_id" example:"0"`
+	// Order type filter (ask/bid), optional (both by default)
+	Direction types.Direction `json:"direction" yaml:"direction" example:"bid"`
+}
+
+type AmendOrderReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+	OrderID string       `json:"order_id" yaml:"order_id" example:"100"`
+	// New QuoteAsset price with decimals (0 keeps the current one)
+	Price string `json:"price" example:"100"`
+	// New BaseAsset quantity with decimals (0 keeps the current one)
+	Quantity string `json:"quantity" example:"10"`
+}
+
+type BatchOrdersReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+	// Order IDs to revoke (processed before posts)
+	Cancels []string `json:"cancels" yaml:"cancels" example:"0,1"`
+	// Orders to post
+	Posts []types.BatchOrderPost `json:"posts" yaml:"posts"`
+}
+
 // RegisterRoutes adds endpoint to REST router.
 func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	r.HandleFunc(fmt.Sprintf("/%s", types.ModuleName), getOrdersWithParams(cliCtx)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/stop", types.ModuleName), getStopOrdersWithParams(cliCtx)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/fills", types.ModuleName), getFillRecordsWithParams(cliCtx)).Methods("GET")
 	r.HandleFunc(fmt.Sprintf("/%s/{%s}", types.ModuleName, OrderID), getOrder(cliCtx)).Methods("GET")
 	r.HandleFunc(fmt.Sprintf("/%s/post", types.ModuleName), postOrder(cliCtx)).Methods("PUT")
 	r.HandleFunc(fmt.Sprintf("/%s/revoke", types.ModuleName), revokeOrder(cliCtx)).Methods("PUT")
+	r.HandleFunc(fmt.Sprintf("/%s/revoke_all", types.ModuleName), revokeAllOrders(cliCtx)).Methods("PUT")
+	r.HandleFunc(fmt.Sprintf("/%s/amend", types.ModuleName), amendOrder(cliCtx)).Methods("PUT")
+	r.HandleFunc(fmt.Sprintf("/%s/post_stop", types.ModuleName), postStopOrder(cliCtx)).Methods("PUT")
+	r.HandleFunc(fmt.Sprintf("/%s/batch", types.ModuleName), batchOrders(cliCtx)).Methods("PUT")
 }
 
 // GetOrdersWithParams godoc
@@ -66,6 +121,31 @@ func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
 // @Failure 500 {object} rest.ErrorResponse "Returned on server error"
 // @Router /orders [get]
 func getOrdersWithParams(cliCtx context.CLIContext) http.HandlerFunc {
+	return getOrdersListHandler(cliCtx, types.QueryList)
+}
+
+// GetStopOrdersWithParams godoc
+// @Tags Orders
+// @Summary Get stop orders
+// @Description Get array of not triggered stop Order objects with pagination and filters
+// @ID ordersGetStopOrdersWithParams
+// @Accept  json
+// @Produce json
+// @Param page query int false "page number (first page: 1)"
+// @Param limit query int false "items per page (default: 100)"
+// @Param owner query string false "owner filter"
+// @Param direction query string false "direction filter (bid/ask)"
+// @Param marketID query string false "marketID filter (bid/ask)"
+// @Success 200 {object} OrdersRespGetOrders
+// @Failure 400 {object} rest.ErrorResponse "Returned if the request doesn't have valid query/path params"
+// @Failure 500 {object} rest.ErrorResponse "Returned on server error"
+// @Router /orders/stop [get]
+func getStopOrdersWithParams(cliCtx context.CLIContext) http.HandlerFunc {
+	return getOrdersListHandler(cliCtx, types.QueryStopList)
+}
+
+// getOrdersListHandler builds orders list handler for the query endpoint.
+func getOrdersListHandler(cliCtx context.CLIContext, queryEndpoint string) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		// parse inputs
 		pageStr := r.URL.Query().Get("page")
@@ -106,7 +186,71 @@ func getOrdersWithParams(cliCtx context.CLIContext) http.HandlerFunc {
 		}
 
 		// query and parse the result
-		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/list", types.ModuleName), bz)
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.ModuleName, queryEndpoint), bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// GetFillRecordsWithParams godoc
+// @Tags Orders
+// @Summary Get fill records
+// @Description Get array of FillRecord objects (trade history, newest first) with pagination and filters
+// @ID ordersGetFillRecordsWithParams
+// @Accept  json
+// @Produce json
+// @Param page query int false "page number (first page: 1)"
+// @Param limit query int false "items per page (default: 100)"
+// @Param owner query string false "owner filter"
+// @Param marketID query string false "marketID filter"
+// @Success 200 {object} OrdersRespGetFillRecords
+// @Failure 400 {object} rest.ErrorResponse "Returned if the request doesn't have valid query/path params"
+// @Failure 500 {object} rest.ErrorResponse "Returned on server error"
+// @Router /orders/fills [get]
+func getFillRecordsWithParams(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// parse inputs
+		pageStr := r.URL.Query().Get("page")
+		limitStr := r.URL.Query().Get("limit")
+		page, limit, err := helpers.ParsePaginationParams(pageStr, limitStr, helpers.ParamTypeRestQuery)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		ownerFilterStr := r.URL.Query().Get(OrderOwner)
+		marketIDFilter := r.URL.Query().Get(OrderMarketID)
+
+		ownerFilter := sdk.AccAddress{}
+		if ownerFilterStr != "" {
+			var err error
+			ownerFilter, err = sdk.AccAddressFromBech32(ownerFilterStr)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("%s param parsing: %v", OrderOwner, err))
+				return
+			}
+		}
+
+		// prepare request
+		req := types.FillRecordsReq{
+			Page:     page,
+			Limit:    limit,
+			Owner:    ownerFilter,
+			MarketID: marketIDFilter,
+		}
+
+		bz, err := cliCtx.Codec.MarshalJSON(req)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		// query and parse the result
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.ModuleName, types.QueryFills), bz)
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
@@ -222,8 +366,34 @@ func postOrder(cliCtx context.CLIContext) http.HandlerFunc {
 			return
 		}
 
+		timeInForce := types.NewTimeInForceRaw(req.TimeInForce.String())
+		if !timeInForce.IsValid() {
+			err := helpers.BuildError("time_in_force", req.TimeInForce.String(), helpers.ParamTypeRestRequest, types.ErrWrongTimeInForce.Error())
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		goodTillBlock := int64(0)
+		if req.GoodTillBlock != "" {
+			goodTillBlock, err = helpers.ParseInt64Param("good_till_block", req.GoodTillBlock, helpers.ParamTypeRestRequest)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+
+		selfTradePrevention := types.NewSelfTradePreventionRaw(req.SelfTradePrevention.String())
+		if !selfTradePrevention.IsValid() {
+			err := helpers.BuildError("self_trade_prevention", req.SelfTradePrevention.String(), helpers.ParamTypeRestRequest, types.ErrWrongSelfTradePrevention.Error())
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
 		// prepare and send msg
-		msg := types.NewMsgPost(fromAddr, req.AssetCode, req.Direction, price, quantity, ttl)
+		msg := types.NewMsgPostWithTimeInForce(fromAddr, req.AssetCode, req.Direction, price, quantity, ttl, timeInForce, goodTillBlock)
+		if selfTradePrevention != types.SelfTradePreventionNone {
+			msg.SelfTradePrevention = selfTradePrevention
+		}
 		if err := msg.ValidateBasic(); err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
@@ -281,3 +451,258 @@ func revokeOrder(cliCtx context.CLIContext) http.HandlerFunc {
 		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
 	}
 }
+
+// revokeAllOrders godoc
+// @Tags Orders
+// @Summary Revoke all orders
+// @Description Revoke all active and stop orders of the sender (optionally filtered by market and direction)
+// @ID ordersRevokeAllOrders
+// @Accept  json
+// @Produce json
+// @Param postRequest body RevokeAllOrdersReq true "RevokeAllOrders request with signed transaction"
+// @Success 200 {object} OrdersRespRevokeAllOrders
+// @Failure 400 {object} rest.ErrorResponse "Returned if the request doesn't have valid query params"
+// @Failure 500 {object} rest.ErrorResponse "Returned on server error"
+// @Router /orders/revoke_all [put]
+func revokeAllOrders(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// parse inputs
+		var req RevokeAllOrdersReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := helpers.ParseSdkAddressParam("from", baseReq.From, helpers.ParamTypeRestRequest)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		if req.MarketID != "" {
+			if _, err := helpers.ParseDnIDParam("market_id", req.MarketID, helpers.ParamTypeRestRequest); err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+
+		if req.Direction != "" && !req.Direction.IsValid() {
+			err := helpers.BuildError("direction", req.Direction.String(), helpers.ParamTypeRestRequest, types.ErrWrongDirection.Error())
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// prepare and send msg
+		msg := types.NewMsgRevokeAllOrders(fromAddr, req.MarketID, req.Direction)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+// amendOrder godoc
+// @Tags Orders
+// @Summary Amend order
+// @Description Amend order price and / or quantity
+// @ID ordersAmendOrder
+// @Accept  json
+// @Produce json
+// @Param postRequest body AmendOrderReq true "AmendOrder request with signed transaction"
+// @Success 200 {object} OrdersRespAmendOrder
+// @Failure 400 {object} rest.ErrorResponse "Returned if the request doesn't have valid query params"
+// @Failure 500 {object} rest.ErrorResponse "Returned on server error"
+// @Router /orders/amend [put]
+func amendOrder(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// parse inputs
+		var req AmendOrderReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := helpers.ParseSdkAddressParam("from", baseReq.From, helpers.ParamTypeRestRequest)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		id, err := helpers.ParseDnIDParam("order_id", req.OrderID, helpers.ParamTypeRestRequest)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		price, err := helpers.ParseSdkUintParam("price", req.Price, helpers.ParamTypeRestRequest)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		quantity, err := helpers.ParseSdkUintParam("quantity", req.Quantity, helpers.ParamTypeRestRequest)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// prepare and send msg
+		msg := types.NewMsgAmendOrder(fromAddr, id, price, quantity)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+// postStopOrder godoc
+// @Tags Orders
+// @Summary Post new stop order
+// @Description Post new stop order (activated when the trigger price crosses the stop price)
+// @ID ordersPostStopOrder
+// @Accept  json
+// @Produce json
+// @Param postRequest body PostStopOrderReq true "PostStopOrder request with signed transaction"
+// @Success 200 {object} OrdersRespPostStopOrder
+// @Failure 400 {object} rest.ErrorResponse "Returned if the request doesn't have valid query params"
+// @Failure 500 {object} rest.ErrorResponse "Returned on server error"
+// @Router /orders/post_stop [put]
+func postStopOrder(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// parse inputs
+		var req PostStopOrderReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := helpers.ParseSdkAddressParam("from", baseReq.From, helpers.ParamTypeRestRequest)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		if err := req.AssetCode.Validate(); err != nil {
+			err := helpers.BuildError("asset_code", req.AssetCode.String(), helpers.ParamTypeRestRequest, err.Error())
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		if !req.Direction.IsValid() {
+			err := helpers.BuildError("direction", req.Direction.String(), helpers.ParamTypeRestRequest, types.ErrWrongDirection.Error())
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		price, err := helpers.ParseSdkUintParam("price", req.Price, helpers.ParamTypeRestRequest)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		quantity, err := helpers.ParseSdkUintParam("quantity", req.Quantity, helpers.ParamTypeRestRequest)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		ttl, err := helpers.ParseUint64Param("ttl_in_sec", req.TtlInSec, helpers.ParamTypeRestRequest)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		stopPrice, err := helpers.ParseSdkUintParam("stop_price", req.StopPrice, helpers.ParamTypeRestRequest)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		if !req.StopTrigger.IsValid() {
+			err := helpers.BuildError("stop_trigger", req.StopTrigger.String(), helpers.ParamTypeRestRequest, types.ErrWrongStopTrigger.Error())
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// prepare and send msg
+		msg := types.NewMsgPostStopOrder(fromAddr, req.AssetCode, req.Direction, price, quantity, ttl, stopPrice, req.StopTrigger)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+// batchOrders godoc
+// @Tags Orders
+// @Summary Revoke and post orders atomically
+// @Description Revoke and post orders atomically (cancels are processed before posts)
+// @ID ordersBatchOrders
+// @Accept  json
+// @Produce json
+// @Param postRequest body BatchOrdersReq true "BatchOrders request with signed transaction"
+// @Success 200 {object} OrdersRespBatchOrders
+// @Failure 400 {object} rest.ErrorResponse "Returned if the request doesn't have valid query params"
+// @Failure 500 {object} rest.ErrorResponse "Returned on server error"
+// @Router /orders/batch [put]
+func batchOrders(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// parse inputs
+		var req BatchOrdersReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := helpers.ParseSdkAddressParam("from", baseReq.From, helpers.ParamTypeRestRequest)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		cancelIDs := make([]dnTypes.ID, 0, len(req.Cancels))
+		for i, idRaw := range req.Cancels {
+			id, err := helpers.ParseDnIDParam(fmt.Sprintf("cancels[%d]", i), idRaw, helpers.ParamTypeRestRequest)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			cancelIDs = append(cancelIDs, id)
+		}
+
+		// prepare and send msg
+		msg := types.NewMsgBatchOrders(fromAddr, cancelIDs, req.Posts)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
@@ -17,6 +17,11 @@ type (
 		Result types.Order `json:"result"`
 	}
 
+	OrdersRespGetFillRecords struct {
+		Height int64             `json:"height"`
+		Result types.FillRecords `json:"result"`
+	}
+
 	OrdersRespRevokeOrder struct {
 		Type  string `json:"type" yaml:"type"`
 		Value struct {
@@ -32,6 +37,36 @@ type (
 		Value types.MsgRevokeOrder `json:"value" yaml:"type"`
 	}
 
+	OrdersRespRevokeAllOrders struct {
+		Type  string `json:"type" yaml:"type"`
+		Value struct {
+			Msg        RevokeAllOrdersMsg       `json:"msg" yaml:"msg"`
+			Fee        authTypes.StdFee         `json:"fee" yaml:"fee"`
+			Signatures []authTypes.StdSignature `json:"signatures" yaml:"signatures"`
+			Memo       string                   `json:"memo" yaml:"memo"`
+		} `json:"value" yaml:"type"`
+	}
+
+	RevokeAllOrdersMsg struct {
+		Type  string                   `json:"type" yaml:"type"`
+		Value types.MsgRevokeAllOrders `json:"value" yaml:"type"`
+	}
+
+	OrdersRespAmendOrder struct {
+		Type  string `json:"type" yaml:"type"`
+		Value struct {
+			Msg        AmendOrderMsg            `json:"msg" yaml:"msg"`
+			Fee        authTypes.StdFee         `json:"fee" yaml:"fee"`
+			Signatures []authTypes.StdSignature `json:"signatures" yaml:"signatures"`
+			Memo       string                   `json:"memo" yaml:"memo"`
+		} `json:"value" yaml:"type"`
+	}
+
+	AmendOrderMsg struct {
+		Type  string              `json:"type" yaml:"type"`
+		Value types.MsgAmendOrder `json:"value" yaml:"type"`
+	}
+
 	OrdersRespPostOrder struct {
 		Type  string `json:"type" yaml:"type"`
 		Value struct {
@@ -46,4 +81,34 @@ type (
 		Type  string             `json:"type" yaml:"type"`
 		Value types.MsgPostOrder `json:"value" yaml:"type"`
 	}
+
+	OrdersRespPostStopOrder struct {
+		Type  string `json:"type" yaml:"type"`
+		Value struct {
+			Msg        PostStopOrderMsg         `json:"msg" yaml:"msg"`
+			Fee        authTypes.StdFee         `json:"fee" yaml:"fee"`
+			Signatures []authTypes.StdSignature `json:"signatures" yaml:"signatures"`
+			Memo       string                   `json:"memo" yaml:"memo"`
+		} `json:"value" yaml:"type"`
+	}
+
+	PostStopOrderMsg struct {
+		Type  string                 `json:"type" yaml:"type"`
+		Value types.MsgPostStopOrder `json:"value" yaml:"type"`
+	}
+
+	OrdersRespBatchOrders struct {
+		Type  string `json:"type" yaml:"type"`
+		Value struct {
+			Msg        BatchOrdersMsg           `json:"msg" yaml:"msg"`
+			Fee        authTypes.StdFee         `json:"fee" yaml:"fee"`
+			Signatures []authTypes.StdSignature `json:"signatures" yaml:"signatures"`
+			Memo       string                   `json:"memo" yaml:"memo"`
+		} `json:"value" yaml:"type"`
+	}
+
+	BatchOrdersMsg struct {
+		Type  string               `json:"type" yaml:"type"`
+		Value types.MsgBatchOrders `json:"value" yaml:"type"`
+	}
 )
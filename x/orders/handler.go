@@ -17,6 +17,14 @@ func NewHandler(k Keeper) sdk.Handler {
 			return handleMsgPostOrder(ctx, k, msg)
 		case MsgRevokeOrder:
 			return handleMsgCancelOrder(ctx, k, msg)
+		case MsgAmendOrder:
+			return handleMsgAmendOrder(ctx, k, msg)
+		case MsgPostStopOrder:
+			return handleMsgPostStopOrder(ctx, k, msg)
+		case MsgBatchOrders:
+			return handleMsgBatchOrders(ctx, k, msg)
+		case MsgRevokeAllOrders:
+			return handleMsgRevokeAllOrders(ctx, k, msg)
 		default:
 			return nil, sdkErrors.Wrapf(sdkErrors.ErrUnknownRequest, "unrecognized orders message type: %T", msg)
 		}
@@ -25,7 +33,7 @@ func NewHandler(k Keeper) sdk.Handler {
 
 // handleMsgPostOrder handles MsgPostOrder message which creates a new order.
 func handleMsgPostOrder(ctx sdk.Context, k Keeper, msg MsgPostOrder) (*sdk.Result, error) {
-	order, err := k.PostOrder(ctx, msg.Owner, msg.AssetCode, msg.Direction, msg.Price, msg.Quantity, msg.TtlInSec)
+	order, err := k.PostOrderWithPolicies(ctx, msg.Owner, msg.AssetCode, msg.Direction, msg.Price, msg.Quantity, msg.TtlInSec, msg.TimeInForce, msg.GoodTillBlock, msg.SelfTradePrevention)
 	if err != nil {
 		return nil, err
 	}
@@ -43,9 +51,9 @@ func handleMsgPostOrder(ctx sdk.Context, k Keeper, msg MsgPostOrder) (*sdk.Resul
 	}, nil
 }
 
-// handleMsgCancelOrder handles MsgRevokeOrder message which deletes a new order.
+// handleMsgCancelOrder handles MsgRevokeOrder message which deletes an active or a stop order.
 func handleMsgCancelOrder(ctx sdk.Context, k Keeper, msg MsgRevokeOrder) (*sdk.Result, error) {
-	order, err := k.Get(ctx, msg.OrderID)
+	order, _, err := k.GetOpenOrder(ctx, msg.OrderID)
 	if err != nil {
 		return nil, err
 	}
@@ -70,3 +78,113 @@ func handleMsgCancelOrder(ctx sdk.Context, k Keeper, msg MsgRevokeOrder) (*sdk.R
 		Events: ctx.EventManager().Events(),
 	}, nil
 }
+
+// handleMsgAmendOrder handles MsgAmendOrder message which changes an order price and / or quantity.
+func handleMsgAmendOrder(ctx sdk.Context, k Keeper, msg MsgAmendOrder) (*sdk.Result, error) {
+	order, err := k.Get(ctx, msg.OrderID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !order.Owner.Equals(msg.Owner) {
+		return nil, sdkErrors.Wrap(ErrWrongOwner, "order owner mismatch")
+	}
+
+	order, err = k.AmendOrder(ctx, msg.OrderID, msg.Price, msg.Quantity)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := ModuleCdc.MarshalBinaryLengthPrefixed(order)
+	if err != nil {
+		return nil, fmt.Errorf("result marshal: %w", err)
+	}
+
+	ctx.EventManager().EmitEvent(dnTypes.NewModuleNameEvent(ModuleName))
+
+	return &sdk.Result{
+		Data:   res,
+		Events: ctx.EventManager().Events(),
+	}, nil
+}
+
+// handleMsgPostStopOrder handles MsgPostStopOrder message which creates a new stop order.
+func handleMsgPostStopOrder(ctx sdk.Context, k Keeper, msg MsgPostStopOrder) (*sdk.Result, error) {
+	order, err := k.PostStopOrder(ctx, msg.Owner, msg.AssetCode, msg.Direction, msg.Price, msg.Quantity, msg.TtlInSec, msg.StopPrice, msg.StopTrigger)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := ModuleCdc.MarshalBinaryLengthPrefixed(order)
+	if err != nil {
+		return nil, fmt.Errorf("result marshal: %w", err)
+	}
+
+	ctx.EventManager().EmitEvent(dnTypes.NewModuleNameEvent(ModuleName))
+
+	return &sdk.Result{
+		Data:   res,
+		Events: ctx.EventManager().Events(),
+	}, nil
+}
+
+// handleMsgBatchOrders handles MsgBatchOrders message which revokes and posts orders atomically.
+// Result data contains new order IDs.
+func handleMsgBatchOrders(ctx sdk.Context, k Keeper, msg MsgBatchOrders) (*sdk.Result, error) {
+	orders, err := k.BatchOrders(ctx, msg.Owner, msg.Cancels, msg.Posts)
+	if err != nil {
+		return nil, err
+	}
+
+	orderIDs := make([]dnTypes.ID, 0, len(orders))
+	for _, order := range orders {
+		orderIDs = append(orderIDs, order.ID)
+	}
+
+	res, err := ModuleCdc.MarshalBinaryLengthPrefixed(orderIDs)
+	if err != nil {
+		return nil, fmt.Errorf("result marshal: %w", err)
+	}
+
+	ctx.EventManager().EmitEvent(dnTypes.NewModuleNameEvent(ModuleName))
+
+	return &sdk.Result{
+		Data:   res,
+		Events: ctx.EventManager().Events(),
+	}, nil
+}
+
+// handleMsgRevokeAllOrders handles MsgRevokeAllOrders message which revokes all owner orders (optionally filtered).
+// Result data contains revoked order IDs.
+func handleMsgRevokeAllOrders(ctx sdk.Context, k Keeper, msg MsgRevokeAllOrders) (*sdk.Result, error) {
+	var marketID *dnTypes.ID
+	if msg.MarketIDFilter() {
+		id, err := dnTypes.NewIDFromString(msg.MarketID)
+		if err != nil {
+			return nil, sdkErrors.Wrap(ErrWrongMarketID, err.Error())
+		}
+		marketID = &id
+	}
+
+	orders, err := k.RevokeAllOrders(ctx, msg.Owner, marketID, msg.Direction)
+	if err != nil {
+		return nil, err
+	}
+
+	orderIDs := make([]dnTypes.ID, 0, len(orders))
+	for _, order := range orders {
+		orderIDs = append(orderIDs, order.ID)
+	}
+
+	res, err := ModuleCdc.MarshalBinaryLengthPrefixed(orderIDs)
+	if err != nil {
+		return nil, fmt.Errorf("result marshal: %w", err)
+	}
+
+	ctx.EventManager().EmitEvent(dnTypes.NewModuleNameEvent(ModuleName))
+
+	return &sdk.Result{
+		Data:   res,
+		Events: ctx.EventManager().Events(),
+	}, nil
+}
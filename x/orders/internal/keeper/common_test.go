@@ -126,10 +126,11 @@ func NewTestInput(t *testing.T, customMarketsPerms perms.Permissions) TestInput
 	input.marketKeeper = markets.NewKeeper(
 		input.cdc,
 		input.keyMarkets,
+		input.paramsKeeper.Subspace(markets.DefaultParamspace),
 		input.ccsKeeper,
 		marketsRequester,
 	)
-	input.keeper = NewKeeper(input.cdc, input.keyOrders, input.bankKeeper, input.supplyKeeper, input.marketKeeper)
+	input.keeper = NewKeeper(input.cdc, input.keyOrders, input.paramsKeeper.Subspace(types.DefaultParamspace), input.bankKeeper, input.supplyKeeper, input.marketKeeper)
 
 	// create context
 	input.ctx = sdk.NewContext(mstore, abci.Header{ChainID: "test-chain-id"}, false, log.NewNopLogger())
@@ -137,6 +138,7 @@ func NewTestInput(t *testing.T, customMarketsPerms perms.Permissions) TestInput
 	// init genesis / params
 	input.ccsKeeper.InitDefaultGenesis(input.ctx)
 	input.marketKeeper.InitDefaultGenesis(input.ctx)
+	input.keeper.InitDefaultGenesis(input.ctx)
 
 	return input
 }
@@ -155,6 +157,15 @@ func (i *TestInput) GetAccountBalance(address sdk.AccAddress, baseDenom string)
 	return
 }
 
+// AddMockMarkets creates markets used by mock orders: btc-xfi (ID 0) and eth-xfi (ID 1).
+// Markets must exist as the stored orders are resolved with their markets on read.
+func (i *TestInput) AddMockMarkets(t *testing.T) {
+	_, err := i.marketKeeper.Add(i.ctx, i.baseBtcDenom, i.quoteDenom)
+	require.NoError(t, err)
+	_, err = i.marketKeeper.Add(i.ctx, i.baseEthDenom, i.quoteDenom)
+	require.NoError(t, err)
+}
+
 func NewBtcXfiMockOrder(direction types.Direction) types.Order {
 	now := time.Now()
 
@@ -0,0 +1,210 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	dnTypes "github.com/dfinance/dnode/helpers/types"
+	"github.com/dfinance/dnode/x/orders/internal/types"
+)
+
+// GetFillRecord returns fill record by ID.
+func (k Keeper) GetFillRecord(ctx sdk.Context, id dnTypes.ID) (types.FillRecord, error) {
+	k.modulePerms.AutoCheck(types.PermRead)
+
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetFillRecordKey(id))
+	if bz == nil {
+		return types.FillRecord{}, sdkErrors.Wrap(types.ErrWrongFillRecordID, "not found")
+	}
+
+	record := types.FillRecord{}
+	if err := k.cdc.UnmarshalBinaryLengthPrefixed(bz, &record); err != nil {
+		return types.FillRecord{}, fmt.Errorf("fill record unmarshal: %w", err)
+	}
+
+	return record, nil
+}
+
+// GetFillRecordsList returns all fill records (sorted by ID).
+func (k Keeper) GetFillRecordsList(ctx sdk.Context) (types.FillRecords, error) {
+	k.modulePerms.AutoCheck(types.PermRead)
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.FillRecordKeyPrefix)
+	defer iterator.Close()
+
+	records := make(types.FillRecords, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		record := types.FillRecord{}
+		if err := k.cdc.UnmarshalBinaryLengthPrefixed(iterator.Value(), &record); err != nil {
+			return nil, fmt.Errorf("fill record unmarshal: %w", err)
+		}
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+// GetFillRecordsFiltered returns fill records filtered by owner / market with pagination (newest records first).
+// Owner index is used if owner filter is set, market index is used for market only filter.
+func (k Keeper) GetFillRecordsFiltered(ctx sdk.Context, params types.FillRecordsReq) (types.FillRecords, error) {
+	k.modulePerms.AutoCheck(types.PermRead)
+
+	if params.Page.IsZero() {
+		return types.FillRecords{}, fmt.Errorf("page: is zero")
+	}
+	if params.Limit.IsZero() {
+		return types.FillRecords{}, fmt.Errorf("limit: is zero")
+	}
+
+	paramsMarketID := dnTypes.ID{}
+	if params.MarketIDFilter() {
+		id, err := dnTypes.NewIDFromString(params.MarketID)
+		if err != nil {
+			return types.FillRecords{}, nil
+		}
+		paramsMarketID = id
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	var iterator sdk.Iterator
+	isIndex := true
+	switch {
+	case params.OwnerFilter():
+		iterator = sdk.KVStoreReversePrefixIterator(store, types.GetFillRecordOwnerPrefix(params.Owner))
+	case params.MarketIDFilter():
+		iterator = sdk.KVStoreReversePrefixIterator(store, types.GetFillRecordMarketPrefix(paramsMarketID))
+	default:
+		iterator = sdk.KVStoreReversePrefixIterator(store, types.FillRecordKeyPrefix)
+		isIndex = false
+	}
+	defer iterator.Close()
+
+	skipCnt := (params.Page.Uint64() - 1) * params.Limit.Uint64()
+	limit := params.Limit.Uint64()
+	records := make(types.FillRecords, 0)
+	for ; iterator.Valid() && uint64(len(records)) < limit; iterator.Next() {
+		record := types.FillRecord{}
+		if isIndex {
+			id := dnTypes.ID{}
+			k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &id)
+
+			r, err := k.GetFillRecord(ctx, id)
+			if err != nil {
+				return types.FillRecords{}, err
+			}
+			record = r
+		} else {
+			if err := k.cdc.UnmarshalBinaryLengthPrefixed(iterator.Value(), &record); err != nil {
+				return types.FillRecords{}, fmt.Errorf("fill record unmarshal: %w", err)
+			}
+		}
+
+		if params.MarketIDFilter() && !record.MarketID.Equal(paramsMarketID) {
+			continue
+		}
+
+		if skipCnt > 0 {
+			skipCnt--
+			continue
+		}
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+// PruneFillRecords removes fill records older than the retention period param.
+// Records are stored in the creation order, so iteration stops at the first record to keep.
+// Number of removed per block records is limited by the prune limit param, leftovers are removed on the next calls.
+// Returns number of removed records.
+func (k Keeper) PruneFillRecords(ctx sdk.Context) int {
+	params := k.GetParams(ctx)
+	if params.FillRecordRetention == 0 {
+		return 0
+	}
+	pruneBefore := ctx.BlockTime().Add(-params.FillRecordRetention)
+	limit := int(params.FillRecordPruneLimit)
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.FillRecordKeyPrefix)
+	defer iterator.Close()
+
+	records := make(types.FillRecords, 0)
+	for ; iterator.Valid() && len(records) < limit; iterator.Next() {
+		record := types.FillRecord{}
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &record)
+
+		if !record.Timestamp.Before(pruneBefore) {
+			break
+		}
+		records = append(records, record)
+	}
+
+	for _, record := range records {
+		k.delFillRecord(ctx, record)
+	}
+
+	return len(records)
+}
+
+// addFillRecord creates a new fill record for the order fill.
+func (k Keeper) addFillRecord(ctx sdk.Context, orderFill types.OrderFill, fee sdk.Coin, refund sdk.Coins) {
+	id := k.nextFillRecordID(ctx)
+	k.setFillRecord(ctx, types.NewFillRecord(ctx, id, orderFill, fee, refund))
+	k.setLastFillRecordID(ctx, id)
+}
+
+// setFillRecord sets fill record object and its indexes to the storage.
+func (k Keeper) setFillRecord(ctx sdk.Context, record types.FillRecord) {
+	store := ctx.KVStore(k.storeKey)
+	idBz := k.cdc.MustMarshalBinaryLengthPrefixed(record.ID)
+
+	store.Set(types.GetFillRecordKey(record.ID), k.cdc.MustMarshalBinaryLengthPrefixed(record))
+	store.Set(types.GetFillRecordOwnerKey(record.Owner, record.ID), idBz)
+	store.Set(types.GetFillRecordMarketKey(record.MarketID, record.ID), idBz)
+}
+
+// delFillRecord removes fill record object and its indexes from the storage.
+func (k Keeper) delFillRecord(ctx sdk.Context, record types.FillRecord) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Delete(types.GetFillRecordKey(record.ID))
+	store.Delete(types.GetFillRecordOwnerKey(record.Owner, record.ID))
+	store.Delete(types.GetFillRecordMarketKey(record.MarketID, record.ID))
+}
+
+// nextFillRecordID returns next unique fill record ID.
+func (k Keeper) nextFillRecordID(ctx sdk.Context) dnTypes.ID {
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has(types.LastFillRecordIDKey) {
+		return dnTypes.NewIDFromUint64(0)
+	}
+
+	return k.getLastFillRecordID(ctx).Incr()
+}
+
+// getLastFillRecordID gets the last fill record ID from the storage.
+func (k Keeper) getLastFillRecordID(ctx sdk.Context) dnTypes.ID {
+	store := ctx.KVStore(k.storeKey)
+	id := dnTypes.ID{}
+	k.cdc.MustUnmarshalBinaryBare(store.Get(types.LastFillRecordIDKey), &id)
+
+	return id
+}
+
+// hasLastFillRecordID checks that the last fill record ID exists in the storage.
+func (k Keeper) hasLastFillRecordID(ctx sdk.Context) bool {
+	store := ctx.KVStore(k.storeKey)
+
+	return store.Has(types.LastFillRecordIDKey)
+}
+
+// setLastFillRecordID sets the last fill record ID.
+func (k Keeper) setLastFillRecordID(ctx sdk.Context, id dnTypes.ID) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.LastFillRecordIDKey, k.cdc.MustMarshalBinaryBare(id))
+}
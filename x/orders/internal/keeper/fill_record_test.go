@@ -0,0 +1,124 @@
+// +build unit
+
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	dnTypes "github.com/dfinance/dnode/helpers/types"
+	"github.com/dfinance/dnode/x/orders/internal/types"
+)
+
+func TestOrdersKeeper_FillRecords(t *testing.T) {
+	input := NewTestInput(t, nil)
+
+	startTime := time.Date(2020, 9, 14, 0, 0, 0, 0, time.UTC)
+	btcOrder, ethOrder := NewBtcXfiMockOrder(types.Ask), NewEthXfiMockOrder(types.Bid)
+
+	addFill := func(blockHeight int64, blockTime time.Time, order types.Order) {
+		ctx := input.ctx.WithBlockHeight(blockHeight).WithBlockTime(blockTime)
+		fill := types.OrderFill{
+			Order:            order,
+			ClearancePrice:   order.Price,
+			QuantityFilled:   order.Quantity,
+			QuantityUnfilled: sdk.ZeroUint(),
+		}
+		input.keeper.addFillRecord(ctx, fill, sdk.NewCoin(order.Market.QuoteDenom(), sdk.ZeroInt()), sdk.NewCoins())
+	}
+
+	getFills := func(page, limit uint64, owner sdk.AccAddress, marketID string) types.FillRecords {
+		records, err := input.keeper.GetFillRecordsFiltered(input.ctx, types.FillRecordsReq{
+			Page:     sdk.NewUint(page),
+			Limit:    sdk.NewUint(limit),
+			Owner:    owner,
+			MarketID: marketID,
+		})
+		require.NoError(t, err)
+
+		return records
+	}
+
+	getIDs := func(records types.FillRecords) []uint64 {
+		ids := make([]uint64, 0, len(records))
+		for _, r := range records {
+			ids = append(ids, r.ID.UInt64())
+		}
+
+		return ids
+	}
+
+	// fill records: btc at 1, eth at 2, btc at 3 (1 hour interval)
+	addFill(1, startTime, btcOrder)
+	addFill(2, startTime.Add(time.Hour), ethOrder)
+	addFill(3, startTime.Add(2*time.Hour), btcOrder)
+
+	// check record fields
+	{
+		record, err := input.keeper.GetFillRecord(input.ctx, dnTypes.NewIDFromUint64(1))
+		require.NoError(t, err)
+		require.NoError(t, record.Valid())
+		require.True(t, record.OrderID.Equal(ethOrder.ID))
+		require.Equal(t, ethOrder.Owner.String(), record.Owner.String())
+		require.True(t, record.MarketID.Equal(ethOrder.Market.ID))
+		require.Equal(t, ethOrder.Direction, record.Direction)
+		require.True(t, record.ClearancePrice.Equal(ethOrder.Price))
+		require.True(t, record.QuantityFilled.Equal(ethOrder.Quantity))
+		require.EqualValues(t, 2, record.BlockHeight)
+		require.True(t, record.Timestamp.Equal(startTime.Add(time.Hour)))
+
+		_, err = input.keeper.GetFillRecord(input.ctx, dnTypes.NewIDFromUint64(3))
+		require.Error(t, err)
+		require.True(t, types.ErrWrongFillRecordID.Is(err))
+	}
+
+	// check filters and pagination (newest first)
+	{
+		require.Equal(t, []uint64{2, 1, 0}, getIDs(getFills(1, 10, nil, "")))
+		require.Equal(t, []uint64{1}, getIDs(getFills(2, 1, nil, "")))
+		require.Empty(t, getFills(2, 10, nil, ""))
+
+		require.Equal(t, []uint64{2, 0}, getIDs(getFills(1, 10, btcOrder.Owner, "")))
+		require.Equal(t, []uint64{0}, getIDs(getFills(2, 1, btcOrder.Owner, "")))
+		require.Equal(t, []uint64{2, 0}, getIDs(getFills(1, 10, nil, btcOrder.Market.ID.String())))
+		require.Equal(t, []uint64{1}, getIDs(getFills(1, 10, ethOrder.Owner, ethOrder.Market.ID.String())))
+		require.Empty(t, getFills(1, 10, ethOrder.Owner, btcOrder.Market.ID.String()))
+
+		_, err := input.keeper.GetFillRecordsFiltered(input.ctx, types.FillRecordsReq{Page: sdk.ZeroUint(), Limit: sdk.OneUint()})
+		require.Error(t, err)
+	}
+
+	// check pruning
+	{
+		// retention disabled
+		params := input.keeper.GetParams(input.ctx)
+		params.FillRecordRetention = 0
+		input.keeper.SetParams(input.ctx, params)
+		require.Equal(t, 0, input.keeper.PruneFillRecords(input.ctx.WithBlockTime(startTime.Add(24*time.Hour))))
+
+		// records older than 1 hour are pruned (only the first one)
+		params.FillRecordRetention = time.Hour
+		input.keeper.SetParams(input.ctx, params)
+		require.Equal(t, 1, input.keeper.PruneFillRecords(input.ctx.WithBlockTime(startTime.Add(90*time.Minute))))
+
+		require.Equal(t, []uint64{2, 1}, getIDs(getFills(1, 10, nil, "")))
+		require.Equal(t, []uint64{2}, getIDs(getFills(1, 10, btcOrder.Owner, "")))
+		require.Equal(t, []uint64{2}, getIDs(getFills(1, 10, nil, btcOrder.Market.ID.String())))
+
+		// IDs sequence is kept
+		addFill(4, startTime.Add(3*time.Hour), ethOrder)
+		require.Equal(t, []uint64{3, 2, 1}, getIDs(getFills(1, 10, nil, "")))
+
+		// number of pruned per block records is limited
+		params.FillRecordPruneLimit = 2
+		input.keeper.SetParams(input.ctx, params)
+		pruneCtx := input.ctx.WithBlockTime(startTime.Add(24 * time.Hour))
+		require.Equal(t, 2, input.keeper.PruneFillRecords(pruneCtx))
+		require.Equal(t, []uint64{3}, getIDs(getFills(1, 10, nil, "")))
+		require.Equal(t, 1, input.keeper.PruneFillRecords(pruneCtx))
+		require.Empty(t, getFills(1, 10, nil, ""))
+	}
+}
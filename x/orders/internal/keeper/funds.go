@@ -7,10 +7,12 @@ import (
 	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	dnTypes "github.com/dfinance/dnode/helpers/types"
+	"github.com/dfinance/dnode/x/markets"
 	"github.com/dfinance/dnode/x/orders/internal/types"
 )
 
 // LockOrderCoins locks account funds defined by order on order posting.
+// Order deposit (if any) is locked along with the lock coin.
 // Coins transfer from Account to Module.
 func (k Keeper) LockOrderCoins(ctx sdk.Context, order types.Order) error {
 	k.modulePerms.AutoCheck(types.PermOrderLock)
@@ -20,14 +22,15 @@ func (k Keeper) LockOrderCoins(ctx sdk.Context, order types.Order) error {
 		return sdkErrors.Wrap(err, "creating lock coin")
 	}
 
-	if err = k.supplyKeeper.SendCoinsFromAccountToModule(ctx, order.Owner, types.ModuleName, sdk.NewCoins(coin)); err != nil {
+	if err = k.supplyKeeper.SendCoinsFromAccountToModule(ctx, order.Owner, types.ModuleName, sdk.NewCoins(coin).Add(order.Deposit...)); err != nil {
 		return sdkErrors.Wrapf(types.ErrInternal, "locking coins: %v", err)
 	}
 
 	return nil
 }
 
-// LockOrderCoins locks account funds defined by order on order canceling.
+// UnlockOrderCoins unlocks account funds defined by order on order canceling.
+// Order deposit is not unlocked (refunded on the first fill or burned).
 // Coins transfer from Module to Account.
 func (k Keeper) UnlockOrderCoins(ctx sdk.Context, order types.Order) error {
 	k.modulePerms.AutoCheck(types.PermOrderUnlock)
@@ -44,13 +47,117 @@ func (k Keeper) UnlockOrderCoins(ctx sdk.Context, order types.Order) error {
 	return nil
 }
 
+// RefundOrderDeposit returns order deposit to the owner (order got its first fill).
+// Coins transfer from Module to Account.
+func (k Keeper) RefundOrderDeposit(ctx sdk.Context, order types.Order) error {
+	k.modulePerms.AutoCheck(types.PermOrderUnlock)
+
+	if order.Deposit.Empty() {
+		return nil
+	}
+
+	if err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, order.Owner, order.Deposit); err != nil {
+		return sdkErrors.Wrapf(types.ErrInternal, "refunding deposit: %v", err)
+	}
+
+	ctx.EventManager().EmitEvent(types.NewOrderDepositRefundedEvent(order))
+
+	return nil
+}
+
+// BurnOrderDeposit burns order deposit (order is canceled without being filled).
+func (k Keeper) BurnOrderDeposit(ctx sdk.Context, order types.Order) error {
+	k.modulePerms.AutoCheck(types.PermOrderUnlock)
+
+	if order.Deposit.Empty() {
+		return nil
+	}
+
+	if err := k.supplyKeeper.BurnCoins(ctx, types.ModuleName, order.Deposit); err != nil {
+		return sdkErrors.Wrapf(types.ErrInternal, "burning deposit: %v", err)
+	}
+
+	ctx.EventManager().EmitEvent(types.NewOrderDepositBurnedEvent(order))
+
+	return nil
+}
+
+// AmendOrderCoins locks / unlocks account funds difference defined by order lock coins change on order amend.
+func (k Keeper) AmendOrderCoins(ctx sdk.Context, oldOrder, newOrder types.Order) error {
+	k.modulePerms.AutoCheck(types.PermOrderLock)
+	k.modulePerms.AutoCheck(types.PermOrderUnlock)
+
+	oldCoin, err := oldOrder.LockCoin()
+	if err != nil {
+		return sdkErrors.Wrap(err, "creating current lock coin")
+	}
+	newCoin, err := newOrder.LockCoin()
+	if err != nil {
+		return sdkErrors.Wrap(err, "creating amended lock coin")
+	}
+
+	switch {
+	case newCoin.IsGTE(oldCoin) && !newCoin.IsEqual(oldCoin):
+		diffCoin := newCoin.Sub(oldCoin)
+		if err = k.supplyKeeper.SendCoinsFromAccountToModule(ctx, newOrder.Owner, types.ModuleName, sdk.NewCoins(diffCoin)); err != nil {
+			return sdkErrors.Wrapf(types.ErrInternal, "locking coins: %v", err)
+		}
+	case oldCoin.IsGTE(newCoin) && !oldCoin.IsEqual(newCoin):
+		diffCoin := oldCoin.Sub(newCoin)
+		if err = k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, newOrder.Owner, sdk.NewCoins(diffCoin)); err != nil {
+			return sdkErrors.Wrapf(types.ErrInternal, "unlocking coins: %v", err)
+		}
+	}
+
+	return nil
+}
+
+// transferBatchCoins locks / unlocks account funds difference for the batch orders request.
+// Only the net amount per denom is transferred: Module to Account for unlock, Account to Module for lock.
+func (k Keeper) transferBatchCoins(ctx sdk.Context, owner sdk.AccAddress, lockCoins, unlockCoins sdk.Coins) error {
+	netLockCoins, netUnlockCoins := sdk.NewCoins(), sdk.NewCoins()
+	for _, coin := range lockCoins {
+		if diff := coin.Amount.Sub(unlockCoins.AmountOf(coin.Denom)); diff.IsPositive() {
+			netLockCoins = netLockCoins.Add(sdk.NewCoin(coin.Denom, diff))
+		}
+	}
+	for _, coin := range unlockCoins {
+		if diff := coin.Amount.Sub(lockCoins.AmountOf(coin.Denom)); diff.IsPositive() {
+			netUnlockCoins = netUnlockCoins.Add(sdk.NewCoin(coin.Denom, diff))
+		}
+	}
+
+	if !netUnlockCoins.Empty() {
+		if err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, owner, netUnlockCoins); err != nil {
+			return sdkErrors.Wrapf(types.ErrInternal, "unlocking coins: %v", err)
+		}
+	}
+	if !netLockCoins.Empty() {
+		if err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, owner, types.ModuleName, netLockCoins); err != nil {
+			return sdkErrors.Wrapf(types.ErrInternal, "locking coins: %v", err)
+		}
+	}
+
+	return nil
+}
+
 // ExecuteOrderFills processes orderFills transfers fund on full / partial order execution.
+// Lock coins spent by the fill are withdrawn from the module account (module balance always matches open orders).
 // Refunding is done for bid order if clearancePrice is less that order target price.
+// Market maker / taker fee is taken from the fill coin and transferred to the fee collector module account.
 // Order is removed from the store on full order fill.
 // Order stays active on partial order fill (order quantity is reduced).
-func (k Keeper) ExecuteOrderFills(ctx sdk.Context, orderFills types.OrderFills) {
+// Order leftover which lock coin can't be computed is canceled and its lock coin part is returned to the owner.
+// Order deposit is refunded on the first fill.
+// Fill record is stored for every order fill (account trade history).
+// Returns fees collected.
+func (k Keeper) ExecuteOrderFills(ctx sdk.Context, orderFills types.OrderFills) sdk.Coins {
 	k.modulePerms.AutoCheck(types.PermExecFill)
 
+	collectedFees := sdk.NewCoins()
+	marketsCache := make(map[string]markets.Market)
+	moduleAddr := k.supplyKeeper.GetModuleAddress(types.ModuleName)
+
 	for _, orderFill := range orderFills {
 		fillCoin, err := orderFill.FillCoin()
 		if err != nil {
@@ -58,10 +165,29 @@ func (k Keeper) ExecuteOrderFills(ctx sdk.Context, orderFills types.OrderFills)
 			k.GetLogger(ctx).Error(fmt.Sprintf("creating fill coin: %v", err))
 			continue
 		}
-		if _, err = k.bankKeeper.AddCoins(ctx, orderFill.Order.Owner, sdk.NewCoins(fillCoin)); err != nil {
+
+		spentCoin, dustCoin, err := orderFill.SpentCoin()
+		if err != nil {
+			k.GetLogger(ctx).Debug(orderFill.String())
+			k.GetLogger(ctx).Error(fmt.Sprintf("creating spent coin: %v", err))
+			continue
+		}
+		if spentCoin.IsPositive() {
+			if _, err = k.bankKeeper.SubtractCoins(ctx, moduleAddr, sdk.NewCoins(spentCoin)); err != nil {
+				k.GetLogger(ctx).Debug(orderFill.String())
+				panic(fmt.Sprintf("withdrawing spent coins: %v", err))
+			}
+		}
+
+		feeCoin := orderFill.FeeCoin(fillCoin, k.getFillFeeRate(ctx, orderFill, marketsCache))
+		if _, err = k.bankKeeper.AddCoins(ctx, orderFill.Order.Owner, sdk.NewCoins(fillCoin.Sub(feeCoin))); err != nil {
 			k.GetLogger(ctx).Debug(orderFill.String())
 			panic(fmt.Sprintf("transfering fill coins: %v", err))
 		}
+		if feeCoin.IsPositive() {
+			k.collectFee(ctx, feeCoin)
+			collectedFees = collectedFees.Add(feeCoin)
+		}
 
 		doRefund, refundCoin, err := orderFill.RefundCoin()
 		if err != nil {
@@ -69,9 +195,11 @@ func (k Keeper) ExecuteOrderFills(ctx sdk.Context, orderFills types.OrderFills)
 			k.GetLogger(ctx).Error(fmt.Sprintf("creating refund coin: %v", err))
 			continue
 		}
+		refundCoins := sdk.NewCoins()
 		if doRefund {
 			if refundCoin != nil {
-				if _, err = k.bankKeeper.AddCoins(ctx, orderFill.Order.Owner, sdk.NewCoins(*refundCoin)); err != nil {
+				refundCoins = sdk.NewCoins(*refundCoin)
+				if _, err = k.bankKeeper.AddCoins(ctx, orderFill.Order.Owner, refundCoins); err != nil {
 					k.GetLogger(ctx).Debug(orderFill.String())
 					panic(fmt.Sprintf("adding refund coins: %v", err))
 				}
@@ -81,21 +209,82 @@ func (k Keeper) ExecuteOrderFills(ctx sdk.Context, orderFills types.OrderFills)
 			}
 		}
 
+		if dustCoin != nil {
+			k.GetLogger(ctx).Info(fmt.Sprintf("order leftover is too small to be locked, returning it: %s", orderFill.Order.ID))
+			if dustCoin.IsPositive() {
+				if _, err = k.bankKeeper.AddCoins(ctx, orderFill.Order.Owner, sdk.NewCoins(*dustCoin)); err != nil {
+					k.GetLogger(ctx).Debug(orderFill.String())
+					panic(fmt.Sprintf("returning order leftover coins: %v", err))
+				}
+				refundCoins = refundCoins.Add(*dustCoin)
+			}
+		}
+
+		k.addFillRecord(ctx, orderFill, feeCoin, refundCoins)
+
+		if err := k.RefundOrderDeposit(ctx, orderFill.Order); err != nil {
+			k.GetLogger(ctx).Debug(orderFill.String())
+			panic(fmt.Sprintf("refunding order deposit: %v", err))
+		}
+		orderFill.Order.Deposit = nil
+
 		eventManager := ctx.EventManager()
 		if orderFill.QuantityUnfilled.IsZero() {
 			k.GetLogger(ctx).Info(fmt.Sprintf("order completely filled: %s", orderFill.Order.ID))
 			k.del(ctx, orderFill.Order.ID)
-			eventManager.EmitEvent(types.NewFullyFilledOrderEvent(orderFill.Order))
+			eventManager.EmitEvent(types.NewFullyFilledOrderEvent(orderFill.Order, feeCoin))
+		} else if dustCoin != nil {
+			k.GetLogger(ctx).Info(fmt.Sprintf("order partially filled, leftover canceled: %s", orderFill.Order.ID))
+			orderFill.Order.Quantity = orderFill.QuantityUnfilled
+			orderFill.Order.UpdatedAt = ctx.BlockTime()
+			k.del(ctx, orderFill.Order.ID)
+			eventManager.EmitEvent(types.NewPartiallyFilledOrderEvent(orderFill.Order, feeCoin))
+			eventManager.EmitEvent(types.NewOrderCanceledEvent(orderFill.Order))
 		} else {
 			k.GetLogger(ctx).Info(fmt.Sprintf("order partially filled: %s", orderFill.Order.ID))
 			orderFill.Order.Quantity = orderFill.QuantityUnfilled
 			orderFill.Order.UpdatedAt = ctx.BlockTime()
 			k.set(ctx, orderFill.Order)
-			eventManager.EmitEvent(types.NewPartiallyFilledOrderEvent(orderFill.Order))
+			eventManager.EmitEvent(types.NewPartiallyFilledOrderEvent(orderFill.Order, feeCoin))
 		}
 	}
 
 	if len(orderFills) > 0 {
 		ctx.EventManager().EmitEvent(dnTypes.NewModuleNameEvent(types.ModuleName))
 	}
+
+	return collectedFees
+}
+
+// getFillFeeRate returns market fee rate for the order fill.
+// Order is a maker if it was posted before the current block, otherwise it is a taker (triggered stop order is a taker
+// in the block following the trigger one).
+// Markets are cached as fills for the same market are processed in a row.
+func (k Keeper) getFillFeeRate(ctx sdk.Context, orderFill types.OrderFill, cache map[string]markets.Market) sdk.Dec {
+	marketID := orderFill.Order.Market.ID
+	market, ok := cache[marketID.String()]
+	if !ok {
+		m, err := k.marketKeeper.Get(ctx, marketID)
+		if err != nil {
+			k.GetLogger(ctx).Error(fmt.Sprintf("order %s: market %s for fee rate: %v", orderFill.Order.ID, marketID, err))
+			return sdk.ZeroDec()
+		}
+		market, cache[marketID.String()] = m, m
+	}
+
+	isMaker := orderFill.Order.IsMaker(ctx.BlockTime(), ctx.BlockHeight())
+
+	return market.GetFeeRate(isMaker)
+}
+
+// collectFee transfers fee coin to the fee collector module account.
+func (k Keeper) collectFee(ctx sdk.Context, feeCoin sdk.Coin) {
+	feeCollector := k.supplyKeeper.GetModuleAccount(ctx, types.FeeCollectorName)
+	if feeCollector == nil {
+		panic(fmt.Sprintf("module account %q: not registered", types.FeeCollectorName))
+	}
+
+	if _, err := k.bankKeeper.AddCoins(ctx, feeCollector.GetAddress(), sdk.NewCoins(feeCoin)); err != nil {
+		panic(fmt.Sprintf("transfering fee coins: %v", err))
+	}
 }
@@ -215,3 +215,252 @@ func TestOrdersKeeper_OrderFill(t *testing.T) {
 		}
 	}
 }
+
+func TestOrdersKeeper_OrderFillFees(t *testing.T) {
+	input := NewTestInput(
+		t,
+		perms.Permissions{
+			marketsClient.PermCreate,
+			marketsClient.PermRead,
+		},
+	)
+
+	// create market with fees
+	market, err := input.marketKeeper.Add(input.ctx, input.baseBtcDenom, input.quoteDenom)
+	require.NoError(t, err)
+
+	makerFee, takerFee := sdk.NewDecWithPrec(1, 2), sdk.NewDecWithPrec(2, 2) // 1%, 2%
+	_, err = input.marketKeeper.SetFees(input.ctx, market.ID, makerFee, takerFee)
+	require.NoError(t, err)
+
+	// create account with supplies
+	_, _, addr := authTypes.KeyTestPubAddr()
+	curBaseBalance, ok := sdk.NewIntFromString("100000000000") // 1000 btc
+	require.True(t, ok)
+	curQuoteBalance, ok := sdk.NewIntFromString("1000000000000000000000") // 1000 xfi
+	require.True(t, ok)
+
+	acc := input.accountKeeper.NewAccountWithAddress(input.ctx, addr)
+	err = acc.SetCoins(
+		sdk.Coins{
+			sdk.NewCoin(input.baseBtcDenom, curBaseBalance),
+			sdk.NewCoin(input.quoteDenom, curQuoteBalance),
+		},
+	)
+	require.NoError(t, err)
+	input.accountKeeper.SetAccount(input.ctx, acc)
+
+	assetCode := helperTypes.AssetCode(market.GetAssetCode())
+
+	// post ask order (maker) in the previous block and bid order (taker) in the current one
+	now := time.Now()
+	input.ctx = input.ctx.WithBlockTime(now.Add(-5 * time.Second))
+
+	askPrice := sdk.NewUintFromString("10000000000000000000") // 10 xfi
+	askQuantity := sdk.NewUintFromString("1000000000")        // 10 btc
+	askOrder, err := input.keeper.PostOrder(input.ctx, addr, assetCode, types.Ask, askPrice, askQuantity, 60)
+	require.NoError(t, err)
+
+	input.ctx = input.ctx.WithBlockTime(now)
+
+	bidPrice := sdk.NewUintFromString("10000000000000000000") // 10 xfi
+	bidQuantity := sdk.NewUintFromString("1000000000")        // 10 btc
+	bidOrder, err := input.keeper.PostOrder(input.ctx, addr, assetCode, types.Bid, bidPrice, bidQuantity, 60)
+	require.NoError(t, err)
+
+	curBaseBalance, curQuoteBalance = input.GetAccountBalance(addr, input.baseBtcDenom)
+
+	// fill orders
+	askFill := types.OrderFill{
+		Order:            askOrder,
+		ClearancePrice:   askPrice,
+		QuantityFilled:   askQuantity,
+		QuantityUnfilled: sdk.ZeroUint(),
+	}
+	bidFill := types.OrderFill{
+		Order:            bidOrder,
+		ClearancePrice:   bidPrice,
+		QuantityFilled:   bidQuantity,
+		QuantityUnfilled: sdk.ZeroUint(),
+	}
+	fees := input.keeper.ExecuteOrderFills(input.ctx, types.OrderFills{askFill, bidFill})
+
+	askFillCoin, err := askFill.FillCoin()
+	require.NoError(t, err)
+	askFeeCoin := askFill.FeeCoin(askFillCoin, makerFee)
+	require.True(t, askFeeCoin.IsPositive())
+
+	bidFillCoin, err := bidFill.FillCoin()
+	require.NoError(t, err)
+	bidFeeCoin := bidFill.FeeCoin(bidFillCoin, takerFee)
+	require.True(t, bidFeeCoin.IsPositive())
+
+	// check fees collected
+	expectedFees := sdk.NewCoins(askFeeCoin, bidFeeCoin)
+	require.True(t, fees.IsEqual(expectedFees), "fees: %s / %s", fees, expectedFees)
+
+	feeCollector := input.supplyKeeper.GetModuleAccount(input.ctx, types.FeeCollectorName)
+	require.NotNil(t, feeCollector)
+	require.True(t, feeCollector.GetCoins().IsEqual(expectedFees))
+
+	// check account balance
+	orderBaseBalance, orderQuoteBalance := input.GetAccountBalance(addr, input.baseBtcDenom)
+	require.True(t, orderBaseBalance.Equal(curBaseBalance.Add(bidFillCoin.Amount).Sub(bidFeeCoin.Amount)))
+	require.True(t, orderQuoteBalance.Equal(curQuoteBalance.Add(askFillCoin.Amount).Sub(askFeeCoin.Amount)))
+}
+
+func TestOrdersKeeper_OrderFillFeesTriggeredStop(t *testing.T) {
+	input := NewTestInput(
+		t,
+		perms.Permissions{
+			marketsClient.PermCreate,
+			marketsClient.PermRead,
+		},
+	)
+
+	// create market with fees
+	market, err := input.marketKeeper.Add(input.ctx, input.baseBtcDenom, input.quoteDenom)
+	require.NoError(t, err)
+
+	makerFee, takerFee := sdk.NewDecWithPrec(1, 2), sdk.NewDecWithPrec(2, 2) // 1%, 2%
+	_, err = input.marketKeeper.SetFees(input.ctx, market.ID, makerFee, takerFee)
+	require.NoError(t, err)
+
+	// create account with supplies
+	_, _, addr := authTypes.KeyTestPubAddr()
+	curBaseBalance, ok := sdk.NewIntFromString("100000000000") // 1000 btc
+	require.True(t, ok)
+	curQuoteBalance, ok := sdk.NewIntFromString("1000000000000000000000") // 1000 xfi
+	require.True(t, ok)
+
+	acc := input.accountKeeper.NewAccountWithAddress(input.ctx, addr)
+	err = acc.SetCoins(
+		sdk.Coins{
+			sdk.NewCoin(input.baseBtcDenom, curBaseBalance),
+			sdk.NewCoin(input.quoteDenom, curQuoteBalance),
+		},
+	)
+	require.NoError(t, err)
+	input.accountKeeper.SetAccount(input.ctx, acc)
+
+	assetCode := helperTypes.AssetCode(market.GetAssetCode())
+
+	// post ask order (maker) and stop bid order in the previous block, stop order is triggered at the end of it
+	now := time.Now()
+	input.ctx = input.ctx.WithBlockTime(now.Add(-5 * time.Second)).WithBlockHeight(10)
+
+	price := sdk.NewUintFromString("10000000000000000000") // 10 xfi
+	quantity := sdk.NewUintFromString("1000000000")        // 10 btc
+	askOrder, err := input.keeper.PostOrder(input.ctx, addr, assetCode, types.Ask, price, quantity, 60)
+	require.NoError(t, err)
+
+	stopOrder, err := input.keeper.PostStopOrder(input.ctx, addr, assetCode, types.Bid, price, quantity, 60, price, types.StopTriggerClearance)
+	require.NoError(t, err)
+
+	bidOrder, err := input.keeper.TriggerStopOrder(input.ctx, stopOrder.ID, price)
+	require.NoError(t, err)
+	require.EqualValues(t, 10, bidOrder.TriggeredAt)
+
+	// triggered order is a taker in the next block only
+	input.ctx = input.ctx.WithBlockTime(now).WithBlockHeight(11)
+	require.True(t, askOrder.IsMaker(input.ctx.BlockTime(), input.ctx.BlockHeight()))
+	require.False(t, bidOrder.IsMaker(input.ctx.BlockTime(), input.ctx.BlockHeight()))
+	require.True(t, bidOrder.IsMaker(now.Add(5*time.Second), 12))
+
+	// fill orders
+	askFill := types.OrderFill{
+		Order:            askOrder,
+		ClearancePrice:   price,
+		QuantityFilled:   quantity,
+		QuantityUnfilled: sdk.ZeroUint(),
+	}
+	bidFill := types.OrderFill{
+		Order:            bidOrder,
+		ClearancePrice:   price,
+		QuantityFilled:   quantity,
+		QuantityUnfilled: sdk.ZeroUint(),
+	}
+	fees := input.keeper.ExecuteOrderFills(input.ctx, types.OrderFills{askFill, bidFill})
+
+	askFillCoin, err := askFill.FillCoin()
+	require.NoError(t, err)
+	askFeeCoin := askFill.FeeCoin(askFillCoin, makerFee)
+	require.True(t, askFeeCoin.IsPositive())
+
+	bidFillCoin, err := bidFill.FillCoin()
+	require.NoError(t, err)
+	bidFeeCoin := bidFill.FeeCoin(bidFillCoin, takerFee)
+	require.True(t, bidFeeCoin.IsPositive())
+
+	// check fees collected
+	expectedFees := sdk.NewCoins(askFeeCoin, bidFeeCoin)
+	require.True(t, fees.IsEqual(expectedFees), "fees: %s / %s", fees, expectedFees)
+}
+
+func TestOrdersKeeper_OrderFillLeftoverDust(t *testing.T) {
+	input := NewTestInput(
+		t,
+		perms.Permissions{
+			marketsClient.PermCreate,
+			marketsClient.PermRead,
+		},
+	)
+
+	// create market
+	market, err := input.marketKeeper.Add(input.ctx, input.baseEthDenom, input.quoteDenom)
+	require.NoError(t, err)
+
+	// create account with supplies
+	_, _, addr := authTypes.KeyTestPubAddr()
+	curBaseBalance, ok := sdk.NewIntFromString("1000000000000000000000") // 1000 eth
+	require.True(t, ok)
+	curQuoteBalance, ok := sdk.NewIntFromString("1000000000000000000000") // 1000 xfi
+	require.True(t, ok)
+
+	acc := input.accountKeeper.NewAccountWithAddress(input.ctx, addr)
+	err = acc.SetCoins(
+		sdk.Coins{
+			sdk.NewCoin(input.baseEthDenom, curBaseBalance),
+			sdk.NewCoin(input.quoteDenom, curQuoteBalance),
+		},
+	)
+	require.NoError(t, err)
+	input.accountKeeper.SetAccount(input.ctx, acc)
+
+	assetCode := helperTypes.AssetCode(market.GetAssetCode())
+
+	// post bid order: lock coin is 1.7 xfi base units rounded to 2
+	bidPrice := sdk.OneUint()                                   // 0.000000000000000001 xfi
+	bidQuantity := sdk.NewUintFromString("1700000000000000000") // 1.7 eth
+	bidOrder, err := input.keeper.PostOrder(input.ctx, addr, assetCode, types.Bid, bidPrice, bidQuantity, 60)
+	require.NoError(t, err)
+
+	lockCoin, err := bidOrder.LockCoin()
+	require.NoError(t, err)
+	require.Equal(t, int64(2), lockCoin.Amount.Int64())
+
+	// partial fill: leftover lock coin (0.4 xfi base units) is too small to be computed
+	fill := types.OrderFill{
+		Order:            bidOrder,
+		ClearancePrice:   bidPrice,
+		QuantityFilled:   sdk.NewUintFromString("1300000000000000000"), // 1.3 eth
+		QuantityUnfilled: sdk.NewUintFromString("400000000000000000"),  // 0.4 eth
+	}
+	_, dustCoin, err := fill.SpentCoin()
+	require.NoError(t, err)
+	require.NotNil(t, dustCoin)
+
+	input.keeper.ExecuteOrderFills(input.ctx, types.OrderFills{fill})
+
+	// check order leftover is canceled
+	require.False(t, input.keeper.Has(input.ctx, bidOrder.ID))
+
+	// check account balance: filled quantity lock coin is spent (1.3 rounded to 1), dust is returned
+	orderBaseBalance, orderQuoteBalance := input.GetAccountBalance(addr, input.baseEthDenom)
+	require.True(t, orderBaseBalance.Equal(curBaseBalance.Add(sdk.NewIntFromBigInt(fill.QuantityFilled.BigInt()))))
+	require.True(t, orderQuoteBalance.Equal(curQuoteBalance.SubRaw(1)), "quote balance: %s", orderQuoteBalance)
+
+	// check module account balance matches open orders
+	msg, broken := LockedCoins(input.keeper)(input.ctx)
+	require.False(t, broken, msg)
+}
@@ -9,7 +9,7 @@ import (
 	"github.com/dfinance/dnode/x/orders/internal/types"
 )
 
-// InitGenesis inits module genesis state: creates currencies.
+// InitGenesis inits module genesis state: creates orders, their indexes and expiry queue entries.
 func (k Keeper) InitGenesis(ctx sdk.Context, data json.RawMessage) {
 	k.modulePerms.AutoCheck(types.PermInit)
 
@@ -20,37 +20,72 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data json.RawMessage) {
 		panic(err)
 	}
 
-	for _, order := range state.Orders {
-		if _, err := k.marketKeeper.Get(ctx, order.Market.ID); err != nil {
-			panic(fmt.Errorf("market id: %d not found: %v", order.Market.ID.UInt64(), err))
+	k.SetParams(ctx, state.Params)
+
+	for _, storedOrder := range state.Orders {
+		market, err := k.marketKeeper.GetExtended(ctx, storedOrder.MarketID)
+		if err != nil {
+			panic(fmt.Errorf("market id: %d not found: %v", storedOrder.MarketID.UInt64(), err))
+		}
+
+		k.set(ctx, storedOrder.ToOrder(market))
+	}
+
+	for _, storedOrder := range state.StopOrders {
+		market, err := k.marketKeeper.GetExtended(ctx, storedOrder.MarketID)
+		if err != nil {
+			panic(fmt.Errorf("market id: %d not found: %v", storedOrder.MarketID.UInt64(), err))
 		}
 
-		k.set(ctx, order)
+		k.setStopOrder(ctx, storedOrder.ToOrder(market))
 	}
 
 	if state.LastOrderId != nil {
 		k.setID(ctx, *state.LastOrderId)
 	}
+
+	for _, record := range state.FillRecords {
+		k.setFillRecord(ctx, record)
+		if !k.hasLastFillRecordID(ctx) || record.ID.GT(k.getLastFillRecordID(ctx)) {
+			k.setLastFillRecordID(ctx, record.ID)
+		}
+	}
 }
 
 // ExportGenesis exports module genesis state using current params state.
 func (k Keeper) ExportGenesis(ctx sdk.Context) json.RawMessage {
 	k.modulePerms.AutoCheck(types.PermRead)
 
-	state := types.GenesisState{}
+	state := types.GenesisState{
+		Params: k.GetParams(ctx),
+	}
 
 	orders, err := k.GetList(ctx)
 	if err != nil {
 		panic(err)
 	}
 
-	state.Orders = append(state.Orders, orders...)
+	state.Orders = append(state.Orders, types.NewStoredOrders(orders)...)
+
+	stopOrders, err := k.GetStopOrdersList(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	state.StopOrders = append(state.StopOrders, types.NewStoredOrders(stopOrders)...)
 
 	if ok := k.hasLastOrderID(ctx); ok {
 		lastID := k.getLastOrderID(ctx)
 		state.LastOrderId = &lastID
 	}
 
+	fillRecords, err := k.GetFillRecordsList(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	state.FillRecords = append(state.FillRecords, fillRecords...)
+
 	return k.cdc.MustMarshalJSON(state)
 }
 
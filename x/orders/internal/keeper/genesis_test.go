@@ -6,9 +6,11 @@ import (
 	"testing"
 	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/dfinance/dnode/helpers/perms"
+	dnTypes "github.com/dfinance/dnode/helpers/types"
 	marketsClient "github.com/dfinance/dnode/x/markets/client"
 	"github.com/dfinance/dnode/x/orders/internal/types"
 )
@@ -56,9 +58,18 @@ func TestOrdersKeeper_Genesis_Init(t *testing.T) {
 
 		lastId := keeper.getLastOrderID(ctx)
 
+		fillRecord := types.NewFillRecord(
+			ctx.WithBlockHeight(1),
+			dnTypes.NewIDFromUint64(5),
+			types.OrderFill{Order: order, ClearancePrice: order.Price, QuantityFilled: order.Quantity, QuantityUnfilled: sdk.ZeroUint()},
+			sdk.NewCoin(exM.QuoteDenom(), sdk.ZeroInt()),
+			sdk.NewCoins(),
+		)
+
 		state := types.GenesisState{
-			Orders:      types.Orders{order, order2},
+			Orders:      types.NewStoredOrders(types.Orders{order, order2}),
 			LastOrderId: &lastId,
+			FillRecords: types.FillRecords{fillRecord},
 		}
 
 		keeper.InitGenesis(ctx, cdc.MustMarshalJSON(state))
@@ -66,6 +77,18 @@ func TestOrdersKeeper_Genesis_Init(t *testing.T) {
 		require.Nil(t, err)
 		require.Len(t, orders, len(state.Orders))
 
+		// markets are resolved on read
+		for _, order := range orders {
+			require.Equal(t, exM, order.Market)
+		}
+
+		// imported orders are added to the expiry queue
+		input.ctx = ctx
+		require.Equal(t, []uint64{order.ID.UInt64(), order2.ID.UInt64()}, ReadExpiryQueue(input, ctx.BlockTime()))
+
+		// fill records IDs sequence is continued
+		require.True(t, keeper.nextFillRecordID(ctx).Equal(dnTypes.NewIDFromUint64(6)))
+
 		var exportedState types.GenesisState
 		cdc.MustUnmarshalJSON(keeper.ExportGenesis(ctx), &exportedState)
 
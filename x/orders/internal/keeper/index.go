@@ -0,0 +1,262 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	dnTypes "github.com/dfinance/dnode/helpers/types"
+	"github.com/dfinance/dnode/x/markets"
+	"github.com/dfinance/dnode/x/orders/internal/types"
+)
+
+// GetMarketOrders returns all active orders for the market (sorted by direction, price and ID).
+func (k Keeper) GetMarketOrders(ctx sdk.Context, marketID dnTypes.ID) (types.Orders, error) {
+	k.modulePerms.AutoCheck(types.PermRead)
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.OrderIndexes.GetMarketPrefix(marketID))
+	defer iterator.Close()
+
+	orders := make(types.Orders, 0)
+	marketsCache := make(map[string]markets.MarketExtended)
+	for _, id := range k.readIndexIDs(iterator) {
+		bz := store.Get(types.GetOrderKey(id))
+		if bz == nil {
+			return nil, sdkErrors.Wrapf(types.ErrWrongOrderID, "indexed order %s: not found", id)
+		}
+
+		order, err := k.unmarshalOrder(ctx, bz, marketsCache)
+		if err != nil {
+			return nil, err
+		}
+		orders = append(orders, order)
+	}
+
+	return orders, nil
+}
+
+// GetMarketOrderIDs returns active and stop order IDs for the market.
+func (k Keeper) GetMarketOrderIDs(ctx sdk.Context, marketID dnTypes.ID) []dnTypes.ID {
+	k.modulePerms.AutoCheck(types.PermRead)
+
+	store := ctx.KVStore(k.storeKey)
+	ids := make([]dnTypes.ID, 0)
+	for _, prefixes := range []types.OrderIndexPrefixes{types.OrderIndexes, types.StopOrderIndexes} {
+		iterator := sdk.KVStorePrefixIterator(store, prefixes.GetMarketPrefix(marketID))
+		ids = append(ids, k.readIndexIDs(iterator)...)
+		iterator.Close()
+	}
+
+	return ids
+}
+
+// GetOwnerOrderIDs returns active and stop order IDs for the owner (all markets if {marketID} is nil).
+func (k Keeper) GetOwnerOrderIDs(ctx sdk.Context, owner sdk.AccAddress, marketID *dnTypes.ID) []dnTypes.ID {
+	k.modulePerms.AutoCheck(types.PermRead)
+
+	store := ctx.KVStore(k.storeKey)
+	ids := make([]dnTypes.ID, 0)
+	for _, prefixes := range []types.OrderIndexPrefixes{types.OrderIndexes, types.StopOrderIndexes} {
+		prefix := prefixes.GetOwnerPrefix(owner)
+		if marketID != nil {
+			prefix = prefixes.GetOwnerMarketPrefix(owner, *marketID)
+		}
+
+		iterator := sdk.KVStorePrefixIterator(store, prefix)
+		ids = append(ids, k.readIndexIDs(iterator)...)
+		iterator.Close()
+	}
+
+	return ids
+}
+
+// GetOwnerOpenOrdersCount returns active and stop orders count for the owner and market.
+// Iteration stops once {limit} is reached (0 - count all), only index keys are read.
+func (k Keeper) GetOwnerOpenOrdersCount(ctx sdk.Context, owner sdk.AccAddress, marketID dnTypes.ID, limit uint64) uint64 {
+	k.modulePerms.AutoCheck(types.PermRead)
+
+	store := ctx.KVStore(k.storeKey)
+	count := uint64(0)
+	for _, prefixes := range []types.OrderIndexPrefixes{types.OrderIndexes, types.StopOrderIndexes} {
+		iterator := sdk.KVStorePrefixIterator(store, prefixes.GetOwnerMarketPrefix(owner, marketID))
+		for ; iterator.Valid() && (limit == 0 || count < limit); iterator.Next() {
+			count++
+		}
+		iterator.Close()
+	}
+
+	return count
+}
+
+// GetGoodTillBlockExpiredOrderIDs returns active and stop good-till-block order IDs expired at {height}.
+func (k Keeper) GetGoodTillBlockExpiredOrderIDs(ctx sdk.Context, height int64) []dnTypes.ID {
+	k.modulePerms.AutoCheck(types.PermRead)
+
+	store := ctx.KVStore(k.storeKey)
+	ids := make([]dnTypes.ID, 0)
+	for _, prefixes := range []types.OrderIndexPrefixes{types.OrderIndexes, types.StopOrderIndexes} {
+		iterator := store.Iterator(prefixes.Height, prefixes.GetHeightPrefix(height))
+		ids = append(ids, k.readIndexIDs(iterator)...)
+		iterator.Close()
+	}
+
+	return ids
+}
+
+// GetTriggeredStopOrderIDs returns stop order IDs for the market and trigger source which stop price is crossed by {price}.
+// Bid stop orders with stop price LTE {price} and ask stop orders with stop price GTE {price} are read from the stop price index.
+func (k Keeper) GetTriggeredStopOrderIDs(ctx sdk.Context, marketID dnTypes.ID, trigger types.StopTrigger, price sdk.Uint) []dnTypes.ID {
+	k.modulePerms.AutoCheck(types.PermRead)
+
+	ids := make([]dnTypes.ID, 0)
+	if price.IsZero() {
+		return ids
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	prefixes := types.StopOrderIndexes
+
+	bidPrefix := prefixes.GetStopPricePrefix(marketID, trigger, types.Bid)
+	bidIterator := store.Iterator(bidPrefix, sdk.PrefixEndBytes(prefixes.GetStopPriceBoundKey(marketID, trigger, types.Bid, price)))
+	ids = append(ids, k.readIndexIDs(bidIterator)...)
+	bidIterator.Close()
+
+	askPrefix := prefixes.GetStopPricePrefix(marketID, trigger, types.Ask)
+	askIterator := store.Iterator(prefixes.GetStopPriceBoundKey(marketID, trigger, types.Ask, price), sdk.PrefixEndBytes(askPrefix))
+	ids = append(ids, k.readIndexIDs(askIterator)...)
+	askIterator.Close()
+
+	return ids
+}
+
+// getListFiltered returns order objects filtered by params using the most selective index.
+// Pagination is done during the iteration, so only the requested page orders are read.
+// Orders are sorted by ID, except for the market filtered request (sorted by direction, price and ID).
+func (k Keeper) getListFiltered(
+	ctx sdk.Context,
+	params types.OrdersReq,
+	prefixes types.OrderIndexPrefixes,
+	orderPrefix []byte,
+	getOrder func(ctx sdk.Context, id dnTypes.ID) (types.Order, error)) (types.Orders, error) {
+
+	if params.Page.IsZero() {
+		return types.Orders{}, fmt.Errorf("page: is zero")
+	}
+	if params.Limit.IsZero() {
+		return types.Orders{}, fmt.Errorf("limit: is zero")
+	}
+
+	paramsMarketID := dnTypes.ID{}
+	if params.MarketIDFilter() {
+		id, err := dnTypes.NewIDFromString(params.MarketID)
+		if err != nil {
+			return types.Orders{}, nil
+		}
+		paramsMarketID = id
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	var iterator sdk.Iterator
+	isIndex := true
+	switch {
+	case params.OwnerFilter():
+		iterator = sdk.KVStorePrefixIterator(store, prefixes.GetOwnerPrefix(params.Owner))
+	case params.MarketIDFilter() && params.DirectionFilter():
+		iterator = sdk.KVStorePrefixIterator(store, prefixes.GetMarketDirectionPrefix(paramsMarketID, params.Direction))
+	case params.MarketIDFilter():
+		iterator = sdk.KVStorePrefixIterator(store, prefixes.GetMarketPrefix(paramsMarketID))
+	default:
+		iterator = sdk.KVStorePrefixIterator(store, orderPrefix)
+		isIndex = false
+	}
+	defer iterator.Close()
+
+	skipCnt := (params.Page.Uint64() - 1) * params.Limit.Uint64()
+	limit := params.Limit.Uint64()
+	orders := make(types.Orders, 0)
+	marketsCache := make(map[string]markets.MarketExtended)
+	for ; iterator.Valid() && uint64(len(orders)) < limit; iterator.Next() {
+		order := types.Order{}
+		if isIndex {
+			id := dnTypes.ID{}
+			k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &id)
+
+			o, err := getOrder(ctx, id)
+			if err != nil {
+				return types.Orders{}, err
+			}
+			order = o
+		} else {
+			o, err := k.unmarshalOrder(ctx, iterator.Value(), marketsCache)
+			if err != nil {
+				return types.Orders{}, err
+			}
+			order = o
+		}
+
+		if params.OwnerFilter() && !order.Owner.Equals(params.Owner) {
+			continue
+		}
+		if params.MarketIDFilter() && !order.Market.ID.Equal(paramsMarketID) {
+			continue
+		}
+		if params.DirectionFilter() && !order.Direction.Equal(params.Direction) {
+			continue
+		}
+
+		if skipCnt > 0 {
+			skipCnt--
+			continue
+		}
+		orders = append(orders, order)
+	}
+
+	return orders, nil
+}
+
+// setIndexes creates order secondary indexes.
+func (k Keeper) setIndexes(store sdk.KVStore, prefixes types.OrderIndexPrefixes, order types.Order) {
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(order.ID)
+	for _, key := range getIndexKeys(prefixes, order) {
+		store.Set(key, bz)
+	}
+}
+
+// delIndexes removes order secondary indexes.
+func (k Keeper) delIndexes(store sdk.KVStore, prefixes types.OrderIndexPrefixes, order types.Order) {
+	for _, key := range getIndexKeys(prefixes, order) {
+		store.Delete(key)
+	}
+}
+
+// readIndexIDs reads order IDs from the index iterator.
+func (k Keeper) readIndexIDs(iterator sdk.Iterator) []dnTypes.ID {
+	ids := make([]dnTypes.ID, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		id := dnTypes.ID{}
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &id)
+		ids = append(ids, id)
+	}
+
+	return ids
+}
+
+// getIndexKeys returns all secondary index keys for the order.
+func getIndexKeys(prefixes types.OrderIndexPrefixes, order types.Order) [][]byte {
+	keys := [][]byte{
+		prefixes.GetOwnerKey(order.Owner, order.ID),
+		prefixes.GetOwnerMarketKey(order.Owner, order.Market.ID, order.ID),
+		prefixes.GetMarketKey(order.Market.ID, order.Direction, order.Price, order.ID),
+	}
+
+	if order.GetTimeInForce() == types.TimeInForceGTB {
+		keys = append(keys, prefixes.GetHeightKey(order.GoodTillBlock, order.ID))
+	}
+	if len(prefixes.StopPrice) > 0 && order.IsStop() {
+		keys = append(keys, prefixes.GetStopPriceKey(order.Market.ID, order.StopTrigger, order.Direction, order.GetStopPrice(), order.ID))
+	}
+
+	return keys
+}
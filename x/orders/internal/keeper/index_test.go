@@ -0,0 +1,194 @@
+// +build unit
+
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dfinance/dnode/helpers/perms"
+	dnTypes "github.com/dfinance/dnode/helpers/types"
+	marketsClient "github.com/dfinance/dnode/x/markets/client"
+	"github.com/dfinance/dnode/x/orders/internal/types"
+)
+
+func TestOrdersKeeper_Indexes(t *testing.T) {
+	input := NewTestInput(
+		t,
+		perms.Permissions{
+			marketsClient.PermCreate,
+			marketsClient.PermRead,
+		},
+	)
+	input.AddMockMarkets(t)
+
+	now := time.Now()
+	ownerA, ownerB := sdk.AccAddress("wallet13jyjuz3kkdvqw"), sdk.AccAddress("wallet13jyjuz3kkdvqx")
+	marketID0, marketID1 := dnTypes.NewIDFromUint64(0), dnTypes.NewIDFromUint64(1)
+
+	newOrder := func(id uint64, owner sdk.AccAddress, marketID dnTypes.ID, direction types.Direction, price uint64) types.Order {
+		order := NewBtcXfiMockOrder(direction)
+		order.ID = dnTypes.NewIDFromUint64(id)
+		order.Owner = owner
+		order.Market.ID = marketID
+		order.Price = sdk.NewUint(price)
+		order.Ttl = time.Minute
+		order.CreatedAt = now
+
+		return order
+	}
+
+	checkIDs := func(orders types.Orders, expectedIDs ...uint64) {
+		require.Len(t, orders, len(expectedIDs))
+		for i, id := range expectedIDs {
+			require.Equal(t, id, orders[i].ID.UInt64(), "order %d", i)
+		}
+	}
+
+	order0 := newOrder(0, ownerA, marketID0, types.Bid, 3)
+	order1 := newOrder(1, ownerB, marketID0, types.Ask, 1)
+	order1.TimeInForce, order1.GoodTillBlock = types.TimeInForceGTB, 10
+	order2 := newOrder(2, ownerA, marketID1, types.Bid, 2)
+	order2.Ttl = 2 * time.Minute
+	order3 := newOrder(3, ownerA, marketID0, types.Bid, 1)
+
+	for _, order := range []types.Order{order0, order1, order2, order3} {
+		input.keeper.set(input.ctx, order)
+	}
+
+	// market orders are sorted by direction and price
+	{
+		orders, err := input.keeper.GetMarketOrders(input.ctx, marketID0)
+		require.NoError(t, err)
+		checkIDs(orders, 1, 3, 0)
+
+		require.Len(t, input.keeper.GetMarketOrderIDs(input.ctx, marketID1), 1)
+	}
+
+	// good-till-block expiration
+	{
+		require.Len(t, input.keeper.GetGoodTillBlockExpiredOrderIDs(input.ctx, 10), 0)
+
+		ids := input.keeper.GetGoodTillBlockExpiredOrderIDs(input.ctx, 11)
+		require.Len(t, ids, 1)
+		require.Equal(t, uint64(1), ids[0].UInt64())
+	}
+
+	// filtered list with pagination
+	{
+		orders, err := input.keeper.GetListFiltered(input.ctx, types.OrdersReq{Page: sdk.NewUint(1), Limit: sdk.NewUint(10), Owner: ownerA})
+		require.NoError(t, err)
+		checkIDs(orders, 0, 2, 3)
+
+		orders, err = input.keeper.GetListFiltered(input.ctx, types.OrdersReq{Page: sdk.NewUint(2), Limit: sdk.NewUint(1), Owner: ownerA})
+		require.NoError(t, err)
+		checkIDs(orders, 2)
+
+		orders, err = input.keeper.GetListFiltered(input.ctx, types.OrdersReq{Page: sdk.NewUint(3), Limit: sdk.NewUint(2), Owner: ownerA})
+		require.NoError(t, err)
+		checkIDs(orders)
+
+		orders, err = input.keeper.GetListFiltered(input.ctx, types.OrdersReq{Page: sdk.NewUint(1), Limit: sdk.NewUint(10), Owner: ownerA, MarketID: marketID0.String()})
+		require.NoError(t, err)
+		checkIDs(orders, 0, 3)
+
+		orders, err = input.keeper.GetListFiltered(input.ctx, types.OrdersReq{Page: sdk.NewUint(1), Limit: sdk.NewUint(10), MarketID: marketID0.String(), Direction: types.Bid})
+		require.NoError(t, err)
+		checkIDs(orders, 3, 0)
+
+		orders, err = input.keeper.GetListFiltered(input.ctx, types.OrdersReq{Page: sdk.NewUint(1), Limit: sdk.NewUint(10), Direction: types.Ask})
+		require.NoError(t, err)
+		checkIDs(orders, 1)
+
+		_, err = input.keeper.GetListFiltered(input.ctx, types.OrdersReq{Page: sdk.ZeroUint(), Limit: sdk.NewUint(10)})
+		require.Error(t, err)
+	}
+
+	// overwrite: indexes are updated
+	{
+		order0.Price = sdk.NewUint(2)
+		order0.Ttl = 3 * time.Minute
+		input.keeper.set(input.ctx, order0)
+
+		orders, err := input.keeper.GetMarketOrders(input.ctx, marketID0)
+		require.NoError(t, err)
+		checkIDs(orders, 1, 3, 0)
+		require.True(t, orders[2].Price.Equal(order0.Price))
+	}
+
+	// delete: indexes are removed
+	{
+		input.keeper.del(input.ctx, order3.ID)
+
+		orders, err := input.keeper.GetMarketOrders(input.ctx, marketID0)
+		require.NoError(t, err)
+		checkIDs(orders, 1, 0)
+
+		orders, err = input.keeper.GetListFiltered(input.ctx, types.OrdersReq{Page: sdk.NewUint(1), Limit: sdk.NewUint(10), Owner: ownerA})
+		require.NoError(t, err)
+		checkIDs(orders, 0, 2)
+	}
+}
+
+func TestOrdersKeeper_StopPriceIndex(t *testing.T) {
+	input := NewTestInput(
+		t,
+		perms.Permissions{
+			marketsClient.PermCreate,
+			marketsClient.PermRead,
+		},
+	)
+	input.AddMockMarkets(t)
+
+	now := time.Now()
+	marketID0, marketID1 := dnTypes.NewIDFromUint64(0), dnTypes.NewIDFromUint64(1)
+
+	newStopOrder := func(id uint64, marketID dnTypes.ID, direction types.Direction, stopPrice uint64, trigger types.StopTrigger) types.Order {
+		order := NewBtcXfiMockOrder(direction)
+		order.ID = dnTypes.NewIDFromUint64(id)
+		order.Market.ID = marketID
+		order.StopPrice, order.StopTrigger = sdk.NewUint(stopPrice), trigger
+		order.Ttl = time.Minute
+		order.CreatedAt = now
+
+		return order
+	}
+
+	checkIDs := func(ids []dnTypes.ID, expectedIDs ...uint64) {
+		require.Len(t, ids, len(expectedIDs))
+		for i, id := range expectedIDs {
+			require.Equal(t, id, ids[i].UInt64(), "id %d", i)
+		}
+	}
+
+	// bid stops at 10 and 20, ask stops at 5 and 15, other trigger / market stops
+	for _, order := range []types.Order{
+		newStopOrder(0, marketID0, types.Bid, 20, types.StopTriggerClearance),
+		newStopOrder(1, marketID0, types.Bid, 10, types.StopTriggerClearance),
+		newStopOrder(2, marketID0, types.Ask, 5, types.StopTriggerClearance),
+		newStopOrder(3, marketID0, types.Ask, 15, types.StopTriggerClearance),
+		newStopOrder(4, marketID0, types.Bid, 10, types.StopTriggerOracle),
+		newStopOrder(5, marketID1, types.Bid, 10, types.StopTriggerClearance),
+	} {
+		input.keeper.setStopOrder(input.ctx, order)
+	}
+
+	// price between stops
+	checkIDs(input.keeper.GetTriggeredStopOrderIDs(input.ctx, marketID0, types.StopTriggerClearance, sdk.NewUint(9)), 3)
+	// price crosses bid and ask stops (stop price is inclusive)
+	checkIDs(input.keeper.GetTriggeredStopOrderIDs(input.ctx, marketID0, types.StopTriggerClearance, sdk.NewUint(15)), 1, 3)
+	checkIDs(input.keeper.GetTriggeredStopOrderIDs(input.ctx, marketID0, types.StopTriggerClearance, sdk.NewUint(100)), 1, 0)
+	checkIDs(input.keeper.GetTriggeredStopOrderIDs(input.ctx, marketID0, types.StopTriggerClearance, sdk.NewUint(5)), 2, 3)
+	// other trigger source and market
+	checkIDs(input.keeper.GetTriggeredStopOrderIDs(input.ctx, marketID0, types.StopTriggerOracle, sdk.NewUint(10)), 4)
+	checkIDs(input.keeper.GetTriggeredStopOrderIDs(input.ctx, marketID1, types.StopTriggerClearance, sdk.NewUint(10)), 5)
+	// zero price: nothing is triggered
+	checkIDs(input.keeper.GetTriggeredStopOrderIDs(input.ctx, marketID0, types.StopTriggerClearance, sdk.ZeroUint()))
+
+	// delete: index is removed
+	input.keeper.delStopOrder(input.ctx, dnTypes.NewIDFromUint64(1))
+	checkIDs(input.keeper.GetTriggeredStopOrderIDs(input.ctx, marketID0, types.StopTriggerClearance, sdk.NewUint(15)), 3)
+}
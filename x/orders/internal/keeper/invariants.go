@@ -0,0 +1,124 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	dnTypes "github.com/dfinance/dnode/helpers/types"
+	"github.com/dfinance/dnode/x/markets"
+	"github.com/dfinance/dnode/x/orders/internal/types"
+)
+
+// RegisterInvariants registers all module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "locked-coins", LockedCoins(k))
+	ir.RegisterRoute(types.ModuleName, "last-ids", LastIDs(k))
+}
+
+// LockedCoins checks that the module account balance equals to lock coins and deposits of all active / stop orders.
+func LockedCoins(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		moduleCoins := k.bankKeeper.GetCoins(ctx, k.supplyKeeper.GetModuleAddress(types.ModuleName))
+
+		ordersCoins, irComment := sdk.NewCoins(), ""
+		err := k.iterateAllOrders(ctx, func(order types.Order) error {
+			lockCoin, err := order.LockCoin()
+			if err != nil {
+				return fmt.Errorf("order %s: lock coin: %w", order.ID, err)
+			}
+			ordersCoins = ordersCoins.Add(lockCoin).Add(order.Deposit...)
+
+			return nil
+		})
+		if err != nil {
+			irComment = fmt.Sprintf("\t%v\n", err)
+		}
+
+		broken := err != nil || !moduleCoins.IsEqual(ordersCoins)
+		irComment += fmt.Sprintf(
+			"\tmodule account coins: %s\n\torders lock coins: %s\n",
+			moduleCoins.String(), ordersCoins.String(),
+		)
+
+		return sdk.FormatInvariant(types.ModuleName, "locked-coins", irComment), broken
+	}
+}
+
+// LastIDs checks that order / order priority / fill record IDs don't exceed the last ID counters.
+func LastIDs(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var lastOrderID, lastFillRecordID *dnTypes.ID
+		if k.hasLastOrderID(ctx) {
+			id := k.getLastOrderID(ctx)
+			lastOrderID = &id
+		}
+		if k.hasLastFillRecordID(ctx) {
+			id := k.getLastFillRecordID(ctx)
+			lastFillRecordID = &id
+		}
+
+		isAbove := func(id dnTypes.ID, lastID *dnTypes.ID) bool {
+			return lastID == nil || id.GT(*lastID)
+		}
+
+		irComment := ""
+		err := k.iterateAllOrders(ctx, func(order types.Order) error {
+			if isAbove(order.ID, lastOrderID) {
+				irComment += fmt.Sprintf("\torder %s: ID is above the last order ID\n", order.ID)
+			}
+			if order.PriorityID.Valid() == nil && isAbove(order.PriorityID, lastOrderID) {
+				irComment += fmt.Sprintf("\torder %s: priority ID %s is above the last order ID\n", order.ID, order.PriorityID)
+			}
+
+			return nil
+		})
+		if err != nil {
+			irComment += fmt.Sprintf("\t%v\n", err)
+		}
+
+		records, err := k.GetFillRecordsList(ctx)
+		if err != nil {
+			irComment += fmt.Sprintf("\t%v\n", err)
+		}
+		for _, record := range records {
+			if isAbove(record.ID, lastFillRecordID) {
+				irComment += fmt.Sprintf("\tfill record %s: ID is above the last fill record ID\n", record.ID)
+			}
+		}
+
+		broken := irComment != ""
+		irComment += fmt.Sprintf("\tlast order ID: %v\n\tlast fill record ID: %v\n", lastOrderID, lastFillRecordID)
+
+		return sdk.FormatInvariant(types.ModuleName, "last-ids", irComment), broken
+	}
+}
+
+// iterateAllOrders iterates over active and stop orders and execs handler on each (stops on the first error).
+func (k Keeper) iterateAllOrders(ctx sdk.Context, handler func(order types.Order) error) error {
+	marketsCache := make(map[string]markets.MarketExtended)
+	for _, getIterator := range []func(ctx sdk.Context) sdk.Iterator{k.GetIterator, k.GetStopOrderIterator} {
+		if err := k.iterateOrders(ctx, getIterator(ctx), marketsCache, handler); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// iterateOrders iterates over orders with the iterator (closed on exit) and execs handler on each (stops on the first error).
+func (k Keeper) iterateOrders(ctx sdk.Context, iterator sdk.Iterator, marketsCache map[string]markets.MarketExtended, handler func(order types.Order) error) error {
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		order, err := k.unmarshalOrder(ctx, iterator.Value(), marketsCache)
+		if err != nil {
+			return err
+		}
+		if err := handler(order); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,100 @@
+// +build unit
+
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authTypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dfinance/dnode/helpers/perms"
+	marketsClient "github.com/dfinance/dnode/x/markets/client"
+	"github.com/dfinance/dnode/x/orders/internal/types"
+)
+
+func TestOrdersKeeper_Invariants(t *testing.T) {
+	input := NewTestInput(
+		t,
+		perms.Permissions{
+			marketsClient.PermCreate,
+			marketsClient.PermRead,
+		},
+	)
+
+	checkInvariant := func(invariant sdk.Invariant, expBroken bool) {
+		msg, broken := invariant(input.ctx)
+		require.Equal(t, expBroken, broken, msg)
+	}
+
+	// create market
+	market, err := input.marketKeeper.Add(input.ctx, input.baseBtcDenom, input.quoteDenom)
+	require.NoError(t, err)
+
+	// create account with supplies
+	_, _, addr := authTypes.KeyTestPubAddr()
+	baseBalance, ok := sdk.NewIntFromString("100000000000") // 1000 btc
+	require.True(t, ok)
+	quoteBalance, ok := sdk.NewIntFromString("1000000000000000000000") // 1000 xfi
+	require.True(t, ok)
+
+	acc := input.accountKeeper.NewAccountWithAddress(input.ctx, addr)
+	require.NoError(t, acc.SetCoins(sdk.NewCoins(sdk.NewCoin(input.baseBtcDenom, baseBalance), sdk.NewCoin(input.quoteDenom, quoteBalance))))
+	input.accountKeeper.SetAccount(input.ctx, acc)
+
+	// empty state
+	checkInvariant(LockedCoins(input.keeper), false)
+	checkInvariant(LastIDs(input.keeper), false)
+
+	// post active and stop orders
+	price := sdk.NewUintFromString("10000000000000000000") // 10 xfi
+	quantity := sdk.NewUintFromString("1000000000")        // 10 btc
+
+	askOrder, err := input.keeper.PostOrder(input.ctx, addr, market.GetAssetCode(), types.Ask, price, quantity, 60)
+	require.NoError(t, err)
+	bidOrder, err := input.keeper.PostOrder(input.ctx, addr, market.GetAssetCode(), types.Bid, price, quantity, 60)
+	require.NoError(t, err)
+	_, err = input.keeper.PostStopOrder(input.ctx, addr, market.GetAssetCode(), types.Bid, price, quantity, 60, price, types.StopTriggerClearance)
+	require.NoError(t, err)
+
+	checkInvariant(LockedCoins(input.keeper), false)
+	checkInvariant(LastIDs(input.keeper), false)
+
+	// partial and full fills
+	{
+		halfQuantity := quantity.QuoUint64(2)
+		input.keeper.ExecuteOrderFills(input.ctx, types.OrderFills{
+			{Order: askOrder, ClearancePrice: price, QuantityFilled: halfQuantity, QuantityUnfilled: quantity.Sub(halfQuantity)},
+			{Order: bidOrder, ClearancePrice: price.QuoUint64(2), QuantityFilled: quantity, QuantityUnfilled: sdk.ZeroUint()},
+		})
+
+		checkInvariant(LockedCoins(input.keeper), false)
+		checkInvariant(LastIDs(input.keeper), false)
+	}
+
+	// revoke
+	{
+		require.NoError(t, input.keeper.RevokeOrder(input.ctx, askOrder.ID))
+
+		checkInvariant(LockedCoins(input.keeper), false)
+	}
+
+	// module account balance mismatch
+	{
+		moduleAddr := input.supplyKeeper.GetModuleAddress(types.ModuleName)
+		_, err := input.bankKeeper.AddCoins(input.ctx, moduleAddr, sdk.NewCoins(sdk.NewCoin(input.quoteDenom, sdk.OneInt())))
+		require.NoError(t, err)
+
+		checkInvariant(LockedCoins(input.keeper), true)
+	}
+
+	// order ID above the last ID
+	{
+		order := NewBtcXfiMockOrder(types.Ask)
+		order.ID = input.keeper.nextID(input.ctx).Incr()
+		input.keeper.set(input.ctx, order)
+
+		checkInvariant(LastIDs(input.keeper), true)
+	}
+}
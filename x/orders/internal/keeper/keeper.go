@@ -9,6 +9,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/params"
 	"github.com/cosmos/cosmos-sdk/x/supply"
 	"github.com/tendermint/tendermint/libs/log"
 
@@ -22,13 +23,14 @@ import (
 type Keeper struct {
 	cdc          *codec.Codec
 	storeKey     sdk.StoreKey
+	paramStore   params.Subspace
 	bankKeeper   bank.Keeper
 	supplyKeeper supply.Keeper
 	marketKeeper markets.Keeper
 	modulePerms  perms.ModulePermissions
 }
 
-// PostOrder creates a new order object and locks account funds (coins).
+// PostOrder creates a new good-till-canceled order object and locks account funds (coins).
 func (k Keeper) PostOrder(
 	ctx sdk.Context,
 	owner sdk.AccAddress,
@@ -38,60 +40,416 @@ func (k Keeper) PostOrder(
 	quantity sdk.Uint,
 	ttlInSec uint64) (types.Order, error) {
 
+	return k.PostOrderWithTimeInForce(ctx, owner, assetCode, direction, price, quantity, ttlInSec, types.TimeInForceGTC, 0)
+}
+
+// PostOrderWithTimeInForce creates a new order object with time-in-force policy and locks account funds (coins).
+func (k Keeper) PostOrderWithTimeInForce(
+	ctx sdk.Context,
+	owner sdk.AccAddress,
+	assetCode dnTypes.AssetCode,
+	direction types.Direction,
+	price sdk.Uint,
+	quantity sdk.Uint,
+	ttlInSec uint64,
+	timeInForce types.TimeInForce,
+	goodTillBlock int64) (types.Order, error) {
+
+	return k.PostOrderWithPolicies(ctx, owner, assetCode, direction, price, quantity, ttlInSec, timeInForce, goodTillBlock, types.SelfTradePreventionNone)
+}
+
+// PostOrderWithPolicies creates a new order object with time-in-force and self-trade prevention policies and locks account funds (coins).
+// Immediate (ioc/fok) orders are only accepted by markets that allow matching.
+// Order deposit (if enabled by params) is locked along with the order funds.
+func (k Keeper) PostOrderWithPolicies(
+	ctx sdk.Context,
+	owner sdk.AccAddress,
+	assetCode dnTypes.AssetCode,
+	direction types.Direction,
+	price sdk.Uint,
+	quantity sdk.Uint,
+	ttlInSec uint64,
+	timeInForce types.TimeInForce,
+	goodTillBlock int64,
+	selfTradePrevention types.SelfTradePrevention) (types.Order, error) {
+
 	k.modulePerms.AutoCheck(types.PermOrderPost)
 
-	filter := markets.NewMarketsFilter(1, 1)
-	filter.AssetCode = assetCode.String()
+	order, err := k.buildOrder(ctx, owner, assetCode, direction, price, quantity, ttlInSec, timeInForce, goodTillBlock, selfTradePrevention)
+	if err != nil {
+		return types.Order{}, err
+	}
 
-	marketsList := k.marketKeeper.GetListFiltered(ctx, filter)
+	if err := k.LockOrderCoins(ctx, order); err != nil {
+		return types.Order{}, err
+	}
+	k.addOrder(ctx, order)
 
-	if len(marketsList) == 0 {
-		return types.Order{}, sdkErrors.Wrap(types.ErrWrongAssetCode, "not found")
+	return order, nil
+}
+
+// RevokeOrder removes an order / stop order object and unlocks account funds (coins).
+// Used for owner cancels and order expiry (TTL, good-till-block height, immediate order leftover, self-trade prevention policy).
+// Order deposit is burned as the order has never been filled (deposit is refunded on the first fill).
+func (k Keeper) RevokeOrder(ctx sdk.Context, id dnTypes.ID) error {
+	k.modulePerms.AutoCheck(types.PermOrderRevoke)
+
+	return k.revokeOrder(ctx, id, false)
+}
+
+// RevokeOrderRefundDeposit removes an order / stop order object and unlocks account funds (coins) along with the order deposit.
+// Used for revokes the owner hasn't chosen (market delisting).
+func (k Keeper) RevokeOrderRefundDeposit(ctx sdk.Context, id dnTypes.ID) error {
+	k.modulePerms.AutoCheck(types.PermOrderRevoke)
+
+	return k.revokeOrder(ctx, id, true)
+}
+
+// RevokeAllOrders removes all active and stop orders of the owner and unlocks account funds (coins) per order.
+// Orders could be filtered by market ({marketID} is nil - all markets) and direction (empty - both directions).
+// Owner secondary indexes are used, so only the owner orders are read.
+func (k Keeper) RevokeAllOrders(ctx sdk.Context, owner sdk.AccAddress, marketID *dnTypes.ID, direction types.Direction) (types.Orders, error) {
+	k.modulePerms.AutoCheck(types.PermOrderRevoke)
+
+	revokedOrders := make(types.Orders, 0)
+	for _, id := range k.GetOwnerOrderIDs(ctx, owner, marketID) {
+		order, isStop, err := k.GetOpenOrder(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if direction != "" && !order.Direction.Equal(direction) {
+			continue
+		}
+
+		if err := k.UnlockOrderCoins(ctx, order); err != nil {
+			return nil, err
+		}
+		if err := k.removeOrder(ctx, order, isStop, false); err != nil {
+			return nil, err
+		}
+		revokedOrders = append(revokedOrders, order)
 	}
 
-	market, err := k.marketKeeper.GetExtended(ctx, marketsList[0].ID)
+	k.GetLogger(ctx).Debug(fmt.Sprintf("orders from %s: %d revoked", owner, len(revokedOrders)))
+
+	return revokedOrders, nil
+}
+
+// BatchOrders revokes and posts orders of the owner atomically (cancels are processed before posts).
+// Orders funds are locked / unlocked once for the whole batch (net coins difference per denom).
+// If any of the batch items fails, no changes are made.
+func (k Keeper) BatchOrders(ctx sdk.Context, owner sdk.AccAddress, cancelIDs []dnTypes.ID, posts []types.BatchOrderPost) (types.Orders, error) {
+	k.modulePerms.AutoCheck(types.PermOrderPost)
+	k.modulePerms.AutoCheck(types.PermOrderRevoke)
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithEventManager(sdk.NewEventManager())
+
+	lockCoins, unlockCoins := sdk.NewCoins(), sdk.NewCoins()
+
+	for i, id := range cancelIDs {
+		order, isStop, err := k.GetOpenOrder(cacheCtx, id)
+		if err != nil {
+			return nil, sdkErrors.Wrapf(err, "cancels[%d]", i)
+		}
+		if !order.Owner.Equals(owner) {
+			return nil, sdkErrors.Wrapf(types.ErrWrongOwner, "cancels[%d]: order owner mismatch", i)
+		}
+
+		coin, err := order.LockCoin()
+		if err != nil {
+			return nil, sdkErrors.Wrapf(err, "cancels[%d]: creating unlock coin", i)
+		}
+		unlockCoins = unlockCoins.Add(coin)
+
+		if err := k.removeOrder(cacheCtx, order, isStop, false); err != nil {
+			return nil, sdkErrors.Wrapf(err, "cancels[%d]", i)
+		}
+	}
+
+	newOrders := make(types.Orders, 0, len(posts))
+	for i, post := range posts {
+		order, err := k.buildOrder(cacheCtx, owner, post.AssetCode, post.Direction, post.Price, post.Quantity, post.TtlInSec, post.TimeInForce, post.GoodTillBlock, post.SelfTradePrevention)
+		if err != nil {
+			return nil, sdkErrors.Wrapf(err, "posts[%d]", i)
+		}
+
+		coin, err := order.LockCoin()
+		if err != nil {
+			return nil, sdkErrors.Wrapf(err, "posts[%d]: creating lock coin", i)
+		}
+		lockCoins = lockCoins.Add(coin).Add(order.Deposit...)
+
+		k.addOrder(cacheCtx, order)
+		newOrders = append(newOrders, order)
+	}
+
+	if err := k.transferBatchCoins(cacheCtx, owner, lockCoins, unlockCoins); err != nil {
+		return nil, err
+	}
+
+	writeCache()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	return newOrders, nil
+}
+
+// AmendOrder changes an order price and / or quantity (zero value keeps the current one).
+// Only the lock coin difference is locked / unlocked.
+// Order keeps its matching priority if only quantity goes down, otherwise it is moved to the end of the queue.
+func (k Keeper) AmendOrder(ctx sdk.Context, id dnTypes.ID, price sdk.Uint, quantity sdk.Uint) (types.Order, error) {
+	k.modulePerms.AutoCheck(types.PermOrderAmend)
+
+	order, err := k.Get(ctx, id)
+	if err != nil {
+		return types.Order{}, sdkErrors.Wrap(types.ErrWrongOrderID, "not found")
+	}
+
+	market, err := k.marketKeeper.Get(ctx, order.Market.ID)
 	if err != nil {
 		return types.Order{}, err
 	}
+	if status := market.GetStatus(); !status.AcceptsOrders() {
+		return types.Order{}, sdkErrors.Wrapf(types.ErrWrongMarketStatus, "market %s status: %s", market.ID, status)
+	}
 
-	id := k.nextID(ctx)
-	order := types.NewOrder(ctx, id, owner, market, direction, price, quantity, ttlInSec)
-	if err := order.ValidatePriceQuantity(); err != nil {
+	marketExt, err := k.marketKeeper.GetExtended(ctx, order.Market.ID)
+	if err != nil {
 		return types.Order{}, err
 	}
 
-	if err := k.LockOrderCoins(ctx, order); err != nil {
+	amendedOrder := order
+	amendedOrder.Market = marketExt
+	if !price.IsZero() {
+		amendedOrder.Price = price
+	}
+	if !quantity.IsZero() {
+		amendedOrder.Quantity = quantity
+	}
+	if amendedOrder.Price.Equal(order.Price) && amendedOrder.Quantity.Equal(order.Quantity) {
+		return types.Order{}, sdkErrors.Wrap(types.ErrWrongAmend, "price and quantity are not changed")
+	}
+	if err := amendedOrder.ValidatePriceQuantity(); err != nil {
 		return types.Order{}, err
 	}
-	k.set(ctx, order)
-	k.setID(ctx, id)
 
-	ctx.EventManager().EmitEvent(types.NewOrderPostedEvent(order))
+	if err := k.AmendOrderCoins(ctx, order, amendedOrder); err != nil {
+		return types.Order{}, err
+	}
 
-	k.GetLogger(ctx).Debug(fmt.Sprintf("order %s from %s: posted", id, owner))
+	if !amendedOrder.Price.Equal(order.Price) || amendedOrder.Quantity.GT(order.Quantity) {
+		priorityID := k.nextID(ctx)
+		k.setID(ctx, priorityID)
+		amendedOrder.PriorityID = priorityID
+	}
+	amendedOrder.UpdatedAt = ctx.BlockTime()
+	k.set(ctx, amendedOrder)
 
-	return order, nil
+	ctx.EventManager().EmitEvent(types.NewOrderAmendedEvent(amendedOrder))
+
+	k.GetLogger(ctx).Debug(fmt.Sprintf("order %s from %s: amended", id, order.Owner))
+
+	return amendedOrder, nil
 }
 
-// RevokeOrder removes an order object and unlocks account funds (coins).
-func (k Keeper) RevokeOrder(ctx sdk.Context, id dnTypes.ID) error {
-	k.modulePerms.AutoCheck(types.PermOrderRevoke)
+// ReduceOrder decreases an active order quantity and unlocks the lock coin difference.
+// Used by the matching engine (self-trade prevention), so order keeps its matching priority and
+// market lot size / min notional limits are not checked (same as for a partially filled order).
+func (k Keeper) ReduceOrder(ctx sdk.Context, id dnTypes.ID, quantity sdk.Uint) (types.Order, error) {
+	k.modulePerms.AutoCheck(types.PermOrderReduce)
 
 	order, err := k.Get(ctx, id)
 	if err != nil {
-		return sdkErrors.Wrap(types.ErrWrongOrderID, "not found")
+		return types.Order{}, sdkErrors.Wrap(types.ErrWrongOrderID, "not found")
+	}
+	if quantity.IsZero() || quantity.GTE(order.Quantity) {
+		return types.Order{}, sdkErrors.Wrapf(types.ErrWrongQuantity, "should be LT current quantity %s", order.Quantity)
+	}
+
+	reducedOrder := order
+	reducedOrder.Quantity = quantity
+	if err := k.AmendOrderCoins(ctx, order, reducedOrder); err != nil {
+		return types.Order{}, err
+	}
+
+	reducedOrder.UpdatedAt = ctx.BlockTime()
+	k.set(ctx, reducedOrder)
+
+	ctx.EventManager().EmitEvent(types.NewOrderAmendedEvent(reducedOrder))
+
+	k.GetLogger(ctx).Debug(fmt.Sprintf("order %s from %s: reduced", id, order.Owner))
+
+	return reducedOrder, nil
+}
+
+// buildOrder creates a new order object with time-in-force and self-trade prevention policies checking market and params limits.
+// Order is not stored and funds are not locked.
+func (k Keeper) buildOrder(
+	ctx sdk.Context,
+	owner sdk.AccAddress,
+	assetCode dnTypes.AssetCode,
+	direction types.Direction,
+	price sdk.Uint,
+	quantity sdk.Uint,
+	ttlInSec uint64,
+	timeInForce types.TimeInForce,
+	goodTillBlock int64,
+	selfTradePrevention types.SelfTradePrevention) (types.Order, error) {
+
+	selfTradePrevention = types.NewSelfTradePreventionRaw(selfTradePrevention.String())
+	if !selfTradePrevention.IsValid() {
+		return types.Order{}, sdkErrors.Wrap(types.ErrWrongSelfTradePrevention, selfTradePrevention.String())
+	}
+
+	timeInForce = types.NewTimeInForceRaw(timeInForce.String())
+	if !timeInForce.IsValid() {
+		return types.Order{}, sdkErrors.Wrap(types.ErrWrongTimeInForce, timeInForce.String())
+	}
+	if timeInForce == types.TimeInForceGTB && goodTillBlock < ctx.BlockHeight() {
+		return types.Order{}, sdkErrors.Wrapf(types.ErrWrongGoodTillBlock, "should be GTE than current block height %d", ctx.BlockHeight())
+	}
+
+	marketStatus, market, err := k.getOrderMarket(ctx, assetCode)
+	if err != nil {
+		return types.Order{}, err
+	}
+	if timeInForce.IsImmediate() && !marketStatus.AllowsMatching() {
+		return types.Order{}, sdkErrors.Wrapf(types.ErrWrongMarketStatus, "market %s status %s doesn't allow %s orders", market.ID, marketStatus, timeInForce)
+	}
+
+	params := k.GetParams(ctx)
+	if err := k.checkOpenOrdersLimit(ctx, params, owner, market.ID); err != nil {
+		return types.Order{}, err
+	}
+
+	order := types.NewOrder(ctx, k.nextID(ctx), owner, market, direction, price, quantity, ttlInSec)
+	order.Deposit = params.OrderDeposit
+	order.TimeInForce = timeInForce
+	if timeInForce == types.TimeInForceGTB {
+		order.GoodTillBlock = goodTillBlock
+	}
+	if selfTradePrevention != types.SelfTradePreventionNone {
+		order.SelfTradePrevention = selfTradePrevention
+	}
+	if err := order.ValidatePriceQuantity(); err != nil {
+		return types.Order{}, err
+	}
+
+	return order, nil
+}
+
+// addOrder stores a new order (funds are expected to be locked) and emits the posted event.
+func (k Keeper) addOrder(ctx sdk.Context, order types.Order) {
+	k.set(ctx, order)
+	k.setID(ctx, order.ID)
+
+	ctx.EventManager().EmitEvent(types.NewOrderPostedEvent(order))
+
+	k.GetLogger(ctx).Debug(fmt.Sprintf("order %s from %s: posted", order.ID, order.Owner))
+}
+
+// GetOpenOrder returns an active or a stop order by ID, bool flag is set for a stop order.
+func (k Keeper) GetOpenOrder(ctx sdk.Context, id dnTypes.ID) (types.Order, bool, error) {
+	k.modulePerms.AutoCheck(types.PermRead)
+
+	if order, err := k.Get(ctx, id); err == nil {
+		return order, false, nil
+	}
+
+	if order, err := k.GetStopOrder(ctx, id); err == nil {
+		return order, true, nil
+	}
+
+	return types.Order{}, false, sdkErrors.Wrap(types.ErrWrongOrderID, "not found")
+}
+
+// revokeOrder unlocks account funds and removes an order / stop order refunding or burning the order deposit.
+func (k Keeper) revokeOrder(ctx sdk.Context, id dnTypes.ID, refundDeposit bool) error {
+	order, isStop, err := k.GetOpenOrder(ctx, id)
+	if err != nil {
+		return err
 	}
 
 	if err := k.UnlockOrderCoins(ctx, order); err != nil {
 		return err
 	}
-	k.del(ctx, id)
+
+	return k.removeOrder(ctx, order, isStop, refundDeposit)
+}
+
+// removeOrder refunds / burns the order deposit, removes an order / stop order (funds are expected to be unlocked) and emits the canceled event.
+func (k Keeper) removeOrder(ctx sdk.Context, order types.Order, isStop, refundDeposit bool) error {
+	if refundDeposit {
+		if err := k.RefundOrderDeposit(ctx, order); err != nil {
+			return err
+		}
+	} else {
+		if err := k.BurnOrderDeposit(ctx, order); err != nil {
+			return err
+		}
+	}
+	if isStop {
+		k.delStopOrder(ctx, order.ID)
+	} else {
+		k.del(ctx, order.ID)
+	}
 
 	ctx.EventManager().EmitEvent(types.NewOrderCanceledEvent(order))
 
 	return nil
 }
 
+// checkOpenOrdersLimit checks that owner has not reached the max open (active and stop) orders limit for the market.
+func (k Keeper) checkOpenOrdersLimit(ctx sdk.Context, params types.Params, owner sdk.AccAddress, marketID dnTypes.ID) error {
+	if params.MaxOpenOrders == 0 {
+		return nil
+	}
+
+	if cnt := k.GetOwnerOpenOrdersCount(ctx, owner, marketID, params.MaxOpenOrders); cnt >= params.MaxOpenOrders {
+		return sdkErrors.Wrapf(types.ErrMaxOpenOrders, "market %s: %d open orders", marketID, cnt)
+	}
+
+	return nil
+}
+
+// getOrderMarket returns market status and extended market by asset code checking that market accepts new orders.
+func (k Keeper) getOrderMarket(ctx sdk.Context, assetCode dnTypes.AssetCode) (markets.MarketStatus, markets.MarketExtended, error) {
+	filter := markets.NewMarketsFilter(1, 1)
+	filter.AssetCode = assetCode.String()
+
+	marketsList := k.marketKeeper.GetListFiltered(ctx, filter)
+
+	if len(marketsList) == 0 {
+		return "", markets.MarketExtended{}, sdkErrors.Wrap(types.ErrWrongAssetCode, "not found")
+	}
+
+	status := marketsList[0].GetStatus()
+	if !status.AcceptsOrders() {
+		return "", markets.MarketExtended{}, sdkErrors.Wrapf(types.ErrWrongMarketStatus, "market %s status: %s", marketsList[0].ID, status)
+	}
+
+	market, err := k.marketKeeper.GetExtended(ctx, marketsList[0].ID)
+	if err != nil {
+		return "", markets.MarketExtended{}, err
+	}
+
+	return status, market, nil
+}
+
+// GetMarket returns market the order belongs to.
+func (k Keeper) GetMarket(ctx sdk.Context, marketID dnTypes.ID) (markets.Market, error) {
+	k.modulePerms.AutoCheck(types.PermRead)
+
+	return k.marketKeeper.Get(ctx, marketID)
+}
+
+// GetMarkets returns all markets.
+func (k Keeper) GetMarkets(ctx sdk.Context) markets.Markets {
+	k.modulePerms.AutoCheck(types.PermRead)
+
+	return k.marketKeeper.GetList(ctx)
+}
+
 // GetLogger gets logger with keeper context.
 func (k Keeper) GetLogger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+types.ModuleName)
@@ -135,6 +493,7 @@ func (k Keeper) setID(ctx sdk.Context, id dnTypes.ID) {
 func NewKeeper(
 	cdc *codec.Codec,
 	storeKey sdk.StoreKey,
+	paramStore params.Subspace,
 	bk bank.Keeper,
 	sk supply.Keeper,
 	mk markets.Keeper,
@@ -143,6 +502,7 @@ func NewKeeper(
 	k := Keeper{
 		cdc:          cdc,
 		storeKey:     storeKey,
+		paramStore:   paramStore.WithKeyTable(types.ParamKeyTable()),
 		bankKeeper:   bk,
 		supplyKeeper: sk,
 		marketKeeper: mk,
@@ -7,10 +7,12 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authTypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/supply"
 	"github.com/stretchr/testify/require"
 
 	"github.com/dfinance/dnode/helpers/perms"
 	dnTypes "github.com/dfinance/dnode/helpers/types"
+	"github.com/dfinance/dnode/x/markets"
 	marketsClient "github.com/dfinance/dnode/x/markets/client"
 	"github.com/dfinance/dnode/x/orders/internal/types"
 )
@@ -196,3 +198,643 @@ func TestOrdersKeeper_PostRevokeOrder(t *testing.T) {
 		}
 	}
 }
+
+func TestOrdersKeeper_AmendOrder(t *testing.T) {
+	input := NewTestInput(
+		t,
+		perms.Permissions{
+			marketsClient.PermCreate,
+			marketsClient.PermRead,
+		},
+	)
+
+	// create market
+	market, err := input.marketKeeper.Add(input.ctx, input.baseBtcDenom, input.quoteDenom)
+	require.NoError(t, err)
+
+	// create account with supplies
+	_, _, addr := authTypes.KeyTestPubAddr()
+	baseBalance, ok := sdk.NewIntFromString("100000000000") // 1000 btc
+	require.True(t, ok)
+	quoteBalance, ok := sdk.NewIntFromString("1000000000000000000000") // 1000 xfi
+	require.True(t, ok)
+
+	acc := input.accountKeeper.NewAccountWithAddress(input.ctx, addr)
+	require.NoError(t, acc.SetCoins(sdk.NewCoins(sdk.NewCoin(input.baseBtcDenom, baseBalance), sdk.NewCoin(input.quoteDenom, quoteBalance))))
+	input.accountKeeper.SetAccount(input.ctx, acc)
+
+	checkLockedBalance := func(order types.Order) {
+		lockCoin, err := order.LockCoin()
+		require.NoError(t, err)
+
+		curBaseBalance, curQuoteBalance := input.GetAccountBalance(addr, input.baseBtcDenom)
+		require.True(t, curBaseBalance.Equal(baseBalance))
+		require.True(t, curQuoteBalance.Equal(quoteBalance.Sub(lockCoin.Amount)), "%s / %s", curQuoteBalance, quoteBalance.Sub(lockCoin.Amount))
+	}
+
+	price := sdk.NewUintFromString("10000000000000000000") // 10 xfi
+	quantity := sdk.NewUintFromString("1000000000")        // 10 btc
+	order, err := input.keeper.PostOrder(input.ctx, addr, market.GetAssetCode(), types.Bid, price, quantity, 60)
+	require.NoError(t, err)
+	require.True(t, order.GetPriorityID().Equal(order.ID))
+	checkLockedBalance(order)
+
+	// non-existing order
+	{
+		_, err := input.keeper.AmendOrder(input.ctx, dnTypes.NewIDFromUint64(1), price, quantity)
+		require.Error(t, err)
+	}
+
+	// nothing changed
+	{
+		_, err := input.keeper.AmendOrder(input.ctx, order.ID, price, sdk.ZeroUint())
+		require.Error(t, err)
+		require.True(t, types.ErrWrongAmend.Is(err))
+	}
+
+	// quantity goes down: priority is kept, funds are partially released
+	{
+		newQuantity := sdk.NewUintFromString("500000000") // 5 btc
+		amendedOrder, err := input.keeper.AmendOrder(input.ctx, order.ID, sdk.ZeroUint(), newQuantity)
+		require.NoError(t, err)
+		require.True(t, amendedOrder.Price.Equal(price))
+		require.True(t, amendedOrder.Quantity.Equal(newQuantity))
+		require.True(t, amendedOrder.GetPriorityID().Equal(order.ID))
+		checkLockedBalance(amendedOrder)
+
+		readOrder, err := input.keeper.Get(input.ctx, order.ID)
+		require.NoError(t, err)
+		CompareOrders(t, amendedOrder, readOrder)
+		order = readOrder
+	}
+
+	// price goes up: priority is lost, extra funds are locked
+	{
+		newPrice := sdk.NewUintFromString("20000000000000000000") // 20 xfi
+		amendedOrder, err := input.keeper.AmendOrder(input.ctx, order.ID, newPrice, sdk.ZeroUint())
+		require.NoError(t, err)
+		require.True(t, amendedOrder.Price.Equal(newPrice))
+		require.True(t, amendedOrder.GetPriorityID().GT(order.ID))
+		checkLockedBalance(amendedOrder)
+
+		// next order ID follows the priority sequence
+		nextOrder, err := input.keeper.PostOrder(input.ctx, addr, market.GetAssetCode(), types.Bid, price, quantity, 60)
+		require.NoError(t, err)
+		require.True(t, nextOrder.ID.GT(amendedOrder.GetPriorityID()))
+		require.NoError(t, input.keeper.RevokeOrder(input.ctx, nextOrder.ID))
+	}
+
+	// not enough funds to lock
+	{
+		_, err := input.keeper.AmendOrder(input.ctx, order.ID, sdk.ZeroUint(), sdk.NewUintFromString("10000000000000")) // 100000 btc
+		require.Error(t, err)
+	}
+}
+
+func TestOrdersKeeper_ReduceOrder(t *testing.T) {
+	input := NewTestInput(
+		t,
+		perms.Permissions{
+			marketsClient.PermCreate,
+			marketsClient.PermRead,
+		},
+	)
+
+	// create market
+	market, err := input.marketKeeper.Add(input.ctx, input.baseBtcDenom, input.quoteDenom)
+	require.NoError(t, err)
+
+	// create account with supplies
+	_, _, addr := authTypes.KeyTestPubAddr()
+	baseBalance, ok := sdk.NewIntFromString("100000000000") // 1000 btc
+	require.True(t, ok)
+	quoteBalance, ok := sdk.NewIntFromString("1000000000000000000000") // 1000 xfi
+	require.True(t, ok)
+
+	acc := input.accountKeeper.NewAccountWithAddress(input.ctx, addr)
+	require.NoError(t, acc.SetCoins(sdk.NewCoins(sdk.NewCoin(input.baseBtcDenom, baseBalance), sdk.NewCoin(input.quoteDenom, quoteBalance))))
+	input.accountKeeper.SetAccount(input.ctx, acc)
+
+	price := sdk.NewUintFromString("10000000000000000000") // 10 xfi
+	quantity := sdk.NewUintFromString("1000000000")        // 10 btc
+
+	// invalid self-trade prevention policy
+	{
+		_, err := input.keeper.PostOrderWithPolicies(input.ctx, addr, market.GetAssetCode(), types.Bid, price, quantity, 60, types.TimeInForceGTC, 0, "invalid")
+		require.Error(t, err)
+		require.True(t, types.ErrWrongSelfTradePrevention.Is(err))
+	}
+
+	order, err := input.keeper.PostOrderWithPolicies(input.ctx, addr, market.GetAssetCode(), types.Bid, price, quantity, 60, types.TimeInForceGTC, 0, types.SelfTradePreventionDecrementBoth)
+	require.NoError(t, err)
+	require.Equal(t, types.SelfTradePreventionDecrementBoth, order.GetSelfTradePrevention())
+
+	// non-existing order
+	{
+		_, err := input.keeper.ReduceOrder(input.ctx, dnTypes.NewIDFromUint64(1), sdk.OneUint())
+		require.Error(t, err)
+	}
+
+	// invalid quantity
+	{
+		_, err := input.keeper.ReduceOrder(input.ctx, order.ID, sdk.ZeroUint())
+		require.Error(t, err)
+		require.True(t, types.ErrWrongQuantity.Is(err))
+
+		_, err = input.keeper.ReduceOrder(input.ctx, order.ID, quantity)
+		require.Error(t, err)
+		require.True(t, types.ErrWrongQuantity.Is(err))
+	}
+
+	// ok: priority is kept, funds are partially released
+	{
+		newQuantity := sdk.NewUintFromString("300000000") // 3 btc
+		reducedOrder, err := input.keeper.ReduceOrder(input.ctx, order.ID, newQuantity)
+		require.NoError(t, err)
+		require.True(t, reducedOrder.Quantity.Equal(newQuantity))
+		require.True(t, reducedOrder.GetPriorityID().Equal(order.ID))
+		require.Equal(t, types.SelfTradePreventionDecrementBoth, reducedOrder.GetSelfTradePrevention())
+
+		lockCoin, err := reducedOrder.LockCoin()
+		require.NoError(t, err)
+		_, curQuoteBalance := input.GetAccountBalance(addr, input.baseBtcDenom)
+		require.True(t, curQuoteBalance.Equal(quoteBalance.Sub(lockCoin.Amount)))
+
+		readOrder, err := input.keeper.Get(input.ctx, order.ID)
+		require.NoError(t, err)
+		CompareOrders(t, reducedOrder, readOrder)
+	}
+}
+
+func TestOrdersKeeper_StopOrder(t *testing.T) {
+	input := NewTestInput(
+		t,
+		perms.Permissions{
+			marketsClient.PermCreate,
+			marketsClient.PermRead,
+		},
+	)
+
+	// create market
+	market, err := input.marketKeeper.Add(input.ctx, input.baseBtcDenom, input.quoteDenom)
+	require.NoError(t, err)
+
+	// create account with supplies
+	_, _, addr := authTypes.KeyTestPubAddr()
+	baseBalance, ok := sdk.NewIntFromString("100000000000") // 1000 btc
+	require.True(t, ok)
+	quoteBalance, ok := sdk.NewIntFromString("1000000000000000000000") // 1000 xfi
+	require.True(t, ok)
+
+	acc := input.accountKeeper.NewAccountWithAddress(input.ctx, addr)
+	require.NoError(t, acc.SetCoins(sdk.NewCoins(sdk.NewCoin(input.baseBtcDenom, baseBalance), sdk.NewCoin(input.quoteDenom, quoteBalance))))
+	input.accountKeeper.SetAccount(input.ctx, acc)
+
+	price := sdk.NewUintFromString("10000000000000000000")    // 10 xfi
+	stopPrice := sdk.NewUintFromString("9000000000000000000") // 9 xfi
+	quantity := sdk.NewUintFromString("1000000000")           // 10 btc
+
+	// invalid stop params
+	{
+		_, err := input.keeper.PostStopOrder(input.ctx, addr, market.GetAssetCode(), types.Bid, price, quantity, 60, sdk.ZeroUint(), types.StopTriggerClearance)
+		require.Error(t, err)
+		require.True(t, types.ErrWrongStopPrice.Is(err))
+
+		_, err = input.keeper.PostStopOrder(input.ctx, addr, market.GetAssetCode(), types.Bid, price, quantity, 60, stopPrice, types.StopTrigger("invalid"))
+		require.Error(t, err)
+		require.True(t, types.ErrWrongStopTrigger.Is(err))
+	}
+
+	// post: funds are locked, order is not active
+	order, err := input.keeper.PostStopOrder(input.ctx, addr, market.GetAssetCode(), types.Bid, price, quantity, 60, stopPrice, types.StopTriggerClearance)
+	require.NoError(t, err)
+	require.True(t, order.IsStop())
+	{
+		lockCoin, err := order.LockCoin()
+		require.NoError(t, err)
+
+		curBaseBalance, curQuoteBalance := input.GetAccountBalance(addr, input.baseBtcDenom)
+		require.True(t, curBaseBalance.Equal(baseBalance))
+		require.True(t, curQuoteBalance.Equal(quoteBalance.Sub(lockCoin.Amount)))
+
+		require.False(t, input.keeper.Has(input.ctx, order.ID))
+		require.True(t, input.keeper.HasStopOrder(input.ctx, order.ID))
+
+		readOrder, err := input.keeper.GetStopOrder(input.ctx, order.ID)
+		require.NoError(t, err)
+		CompareOrders(t, order, readOrder)
+		require.True(t, readOrder.StopPrice.Equal(stopPrice))
+		require.Equal(t, types.StopTriggerClearance, readOrder.StopTrigger)
+
+		list, err := input.keeper.GetStopOrdersList(input.ctx)
+		require.NoError(t, err)
+		require.Len(t, list, 1)
+	}
+
+	// trigger price doesn't cross the stop price
+	{
+		_, err := input.keeper.TriggerStopOrder(input.ctx, order.ID, sdk.NewUintFromString("8000000000000000000"))
+		require.Error(t, err)
+		require.True(t, types.ErrWrongStopPrice.Is(err))
+		require.True(t, input.keeper.HasStopOrder(input.ctx, order.ID))
+	}
+
+	// trigger: order becomes active with a new priority
+	{
+		triggeredOrder, err := input.keeper.TriggerStopOrder(input.ctx, order.ID, stopPrice)
+		require.NoError(t, err)
+		require.True(t, triggeredOrder.GetPriorityID().GT(order.ID))
+
+		require.False(t, input.keeper.HasStopOrder(input.ctx, order.ID))
+		readOrder, err := input.keeper.Get(input.ctx, order.ID)
+		require.NoError(t, err)
+		require.True(t, readOrder.GetPriorityID().Equal(triggeredOrder.GetPriorityID()))
+
+		require.NoError(t, input.keeper.RevokeOrder(input.ctx, order.ID))
+	}
+
+	// revoke not triggered stop order: funds are released
+	{
+		stopOrder, err := input.keeper.PostStopOrder(input.ctx, addr, market.GetAssetCode(), types.Ask, price, quantity, 60, stopPrice, types.StopTriggerOracle)
+		require.NoError(t, err)
+		require.NoError(t, input.keeper.RevokeOrder(input.ctx, stopOrder.ID))
+		require.False(t, input.keeper.HasStopOrder(input.ctx, stopOrder.ID))
+
+		curBaseBalance, curQuoteBalance := input.GetAccountBalance(addr, input.baseBtcDenom)
+		require.True(t, curBaseBalance.Equal(baseBalance))
+		require.True(t, curQuoteBalance.Equal(quoteBalance))
+	}
+}
+
+func TestOrdersKeeper_OrderParams(t *testing.T) {
+	input := NewTestInput(
+		t,
+		perms.Permissions{
+			marketsClient.PermCreate,
+			marketsClient.PermRead,
+		},
+	)
+
+	// create market
+	market, err := input.marketKeeper.Add(input.ctx, input.baseBtcDenom, input.quoteDenom)
+	require.NoError(t, err)
+
+	// create account with supplies
+	_, _, addr := authTypes.KeyTestPubAddr()
+	baseBalance, ok := sdk.NewIntFromString("100000000000") // 1000 btc
+	require.True(t, ok)
+	quoteBalance, ok := sdk.NewIntFromString("1000000000000000000000") // 1000 xfi
+	require.True(t, ok)
+
+	accCoins := sdk.NewCoins(sdk.NewCoin(input.baseBtcDenom, baseBalance), sdk.NewCoin(input.quoteDenom, quoteBalance))
+	acc := input.accountKeeper.NewAccountWithAddress(input.ctx, addr)
+	require.NoError(t, acc.SetCoins(accCoins))
+	input.accountKeeper.SetAccount(input.ctx, acc)
+	input.supplyKeeper.SetSupply(input.ctx, supply.NewSupply(accCoins))
+
+	// set params: 2 open orders per market, 1 xfi deposit
+	depositAmount, ok := sdk.NewIntFromString("1000000000000000000") // 1 xfi
+	require.True(t, ok)
+	deposit := sdk.NewCoins(sdk.NewCoin(input.quoteDenom, depositAmount))
+	input.keeper.SetParams(input.ctx, types.NewParams(2, deposit, types.DefFillRecordRetention, types.DefFillRecordPruneLimit))
+	require.Equal(t, uint64(2), input.keeper.GetParams(input.ctx).MaxOpenOrders)
+
+	price := sdk.NewUintFromString("10000000000000000000") // 10 xfi
+	quantity := sdk.NewUintFromString("1000000000")        // 10 btc
+	stopPrice := sdk.NewUintFromString("9000000000000000000")
+
+	getQuoteSupply := func() sdk.Int {
+		return input.supplyKeeper.GetSupply(input.ctx).GetTotal().AmountOf(input.quoteDenom)
+	}
+
+	// post active and stop orders: deposits are locked
+	order, err := input.keeper.PostOrder(input.ctx, addr, market.GetAssetCode(), types.Ask, price, quantity, 60)
+	require.NoError(t, err)
+	require.True(t, order.Deposit.IsEqual(deposit))
+
+	stopOrder, err := input.keeper.PostStopOrder(input.ctx, addr, market.GetAssetCode(), types.Ask, price, quantity, 60, stopPrice, types.StopTriggerClearance)
+	require.NoError(t, err)
+	require.True(t, stopOrder.Deposit.IsEqual(deposit))
+
+	_, curQuoteBalance := input.GetAccountBalance(addr, input.baseBtcDenom)
+	require.True(t, curQuoteBalance.Equal(quoteBalance.Sub(depositAmount.MulRaw(2))))
+
+	// max open orders limit is reached (stop orders are counted as well)
+	{
+		require.Equal(t, uint64(2), input.keeper.GetOwnerOpenOrdersCount(input.ctx, addr, market.ID, 0))
+
+		_, err := input.keeper.PostOrder(input.ctx, addr, market.GetAssetCode(), types.Ask, price, quantity, 60)
+		require.Error(t, err)
+		require.True(t, types.ErrMaxOpenOrders.Is(err))
+
+		_, err = input.keeper.PostStopOrder(input.ctx, addr, market.GetAssetCode(), types.Ask, price, quantity, 60, stopPrice, types.StopTriggerClearance)
+		require.Error(t, err)
+		require.True(t, types.ErrMaxOpenOrders.Is(err))
+	}
+
+	// revoke never filled order: deposit is burned
+	{
+		prevQuoteSupply := getQuoteSupply()
+		require.NoError(t, input.keeper.RevokeOrder(input.ctx, stopOrder.ID))
+
+		_, curQuoteBalance := input.GetAccountBalance(addr, input.baseBtcDenom)
+		require.True(t, curQuoteBalance.Equal(quoteBalance.Sub(depositAmount.MulRaw(2))))
+		require.True(t, getQuoteSupply().Equal(prevQuoteSupply.Sub(depositAmount)))
+		require.Equal(t, uint64(1), input.keeper.GetOwnerOpenOrdersCount(input.ctx, addr, market.ID, 0))
+	}
+
+	// revoke never filled order by market delisting: deposit is refunded
+	{
+		prevQuoteSupply := getQuoteSupply()
+		_, prevQuoteBalance := input.GetAccountBalance(addr, input.baseBtcDenom)
+
+		delistedOrder, err := input.keeper.PostStopOrder(input.ctx, addr, market.GetAssetCode(), types.Ask, price, quantity, 60, stopPrice, types.StopTriggerClearance)
+		require.NoError(t, err)
+		require.NoError(t, input.keeper.RevokeOrderRefundDeposit(input.ctx, delistedOrder.ID))
+
+		_, curQuoteBalance := input.GetAccountBalance(addr, input.baseBtcDenom)
+		require.True(t, curQuoteBalance.Equal(prevQuoteBalance))
+		require.True(t, getQuoteSupply().Equal(prevQuoteSupply))
+		require.Equal(t, uint64(1), input.keeper.GetOwnerOpenOrdersCount(input.ctx, addr, market.ID, 0))
+	}
+
+	// partial fill: deposit is refunded
+	{
+		fill := types.OrderFill{
+			Order:            order,
+			ClearancePrice:   price,
+			QuantityFilled:   quantity.QuoUint64(2),
+			QuantityUnfilled: quantity.Sub(quantity.QuoUint64(2)),
+		}
+		fillCoin, err := fill.FillCoin()
+		require.NoError(t, err)
+		feeCoin := fill.FeeCoin(fillCoin, market.GetFeeRate(false))
+
+		_, prevQuoteBalance := input.GetAccountBalance(addr, input.baseBtcDenom)
+		input.keeper.ExecuteOrderFills(input.ctx, types.OrderFills{fill})
+
+		_, curQuoteBalance := input.GetAccountBalance(addr, input.baseBtcDenom)
+		require.True(t, curQuoteBalance.Equal(prevQuoteBalance.Add(fillCoin.Amount).Sub(feeCoin.Amount).Add(depositAmount)))
+
+		filledOrder, err := input.keeper.Get(input.ctx, order.ID)
+		require.NoError(t, err)
+		require.True(t, filledOrder.Deposit.Empty())
+	}
+
+	// revoke filled order: nothing is burned
+	{
+		prevQuoteSupply := getQuoteSupply()
+		_, prevQuoteBalance := input.GetAccountBalance(addr, input.baseBtcDenom)
+		require.NoError(t, input.keeper.RevokeOrder(input.ctx, order.ID))
+
+		_, curQuoteBalance := input.GetAccountBalance(addr, input.baseBtcDenom)
+		require.True(t, curQuoteBalance.Equal(prevQuoteBalance))
+		require.True(t, getQuoteSupply().Equal(prevQuoteSupply))
+		require.Equal(t, uint64(0), input.keeper.GetOwnerOpenOrdersCount(input.ctx, addr, market.ID, 0))
+	}
+}
+
+func TestOrdersKeeper_BatchOrders(t *testing.T) {
+	input := NewTestInput(
+		t,
+		perms.Permissions{
+			marketsClient.PermCreate,
+			marketsClient.PermRead,
+		},
+	)
+
+	// create market
+	market, err := input.marketKeeper.Add(input.ctx, input.baseBtcDenom, input.quoteDenom)
+	require.NoError(t, err)
+
+	// create accounts with supplies
+	baseBalance, ok := sdk.NewIntFromString("100000000000") // 1000 btc
+	require.True(t, ok)
+	quoteBalance, ok := sdk.NewIntFromString("1000000000000000000000") // 1000 xfi
+	require.True(t, ok)
+
+	accCoins := sdk.NewCoins(sdk.NewCoin(input.baseBtcDenom, baseBalance), sdk.NewCoin(input.quoteDenom, quoteBalance))
+	addrs := make([]sdk.AccAddress, 0, 2)
+	for i := 0; i < 2; i++ {
+		_, _, addr := authTypes.KeyTestPubAddr()
+		acc := input.accountKeeper.NewAccountWithAddress(input.ctx, addr)
+		require.NoError(t, acc.SetCoins(accCoins))
+		input.accountKeeper.SetAccount(input.ctx, acc)
+		addrs = append(addrs, addr)
+	}
+	input.supplyKeeper.SetSupply(input.ctx, supply.NewSupply(accCoins.Add(accCoins...)))
+	addr, otherAddr := addrs[0], addrs[1]
+
+	price := sdk.NewUintFromString("10000000000000000000") // 10 xfi
+	quantity := sdk.NewUintFromString("1000000000")        // 10 btc
+	newPost := func(direction types.Direction, quantity sdk.Uint) types.BatchOrderPost {
+		return types.BatchOrderPost{
+			AssetCode: market.GetAssetCode(),
+			Direction: direction,
+			Price:     price,
+			Quantity:  quantity,
+			TtlInSec:  60,
+		}
+	}
+
+	// post orders to cancel
+	bidOrder, err := input.keeper.PostOrder(input.ctx, addr, market.GetAssetCode(), types.Bid, price, quantity, 60)
+	require.NoError(t, err)
+	askOrder, err := input.keeper.PostOrder(input.ctx, addr, market.GetAssetCode(), types.Ask, price, quantity, 60)
+	require.NoError(t, err)
+
+	bidLockCoin, err := bidOrder.LockCoin()
+	require.NoError(t, err)
+	askLockCoin, err := askOrder.LockCoin()
+	require.NoError(t, err)
+
+	curBaseBalance, curQuoteBalance := input.GetAccountBalance(addr, input.baseBtcDenom)
+	require.True(t, curBaseBalance.Equal(baseBalance.Sub(askLockCoin.Amount)))
+	require.True(t, curQuoteBalance.Equal(quoteBalance.Sub(bidLockCoin.Amount)))
+
+	// fail: owner mismatch
+	{
+		_, err := input.keeper.BatchOrders(input.ctx, otherAddr, []dnTypes.ID{bidOrder.ID}, nil)
+		require.Error(t, err)
+		require.True(t, types.ErrWrongOwner.Is(err))
+	}
+
+	// fail: non-existing order
+	{
+		_, err := input.keeper.BatchOrders(input.ctx, addr, []dnTypes.ID{dnTypes.NewIDFromUint64(10)}, nil)
+		require.Error(t, err)
+		require.True(t, types.ErrWrongOrderID.Is(err))
+	}
+
+	// fail: a failing post reverts cancels and previous posts
+	{
+		invalidPost := newPost(types.Bid, quantity)
+		invalidPost.AssetCode = "eth_xfi"
+
+		ctx := input.ctx.WithEventManager(sdk.NewEventManager())
+		_, err := input.keeper.BatchOrders(ctx, addr, []dnTypes.ID{bidOrder.ID}, []types.BatchOrderPost{newPost(types.Ask, quantity), invalidPost})
+		require.Error(t, err)
+		require.True(t, types.ErrWrongAssetCode.Is(err))
+		require.Empty(t, ctx.EventManager().Events())
+
+		require.True(t, input.keeper.Has(input.ctx, bidOrder.ID))
+		require.Equal(t, askOrder.ID.UInt64(), input.keeper.getLastOrderID(input.ctx).UInt64())
+
+		curBaseBalance, curQuoteBalance := input.GetAccountBalance(addr, input.baseBtcDenom)
+		require.True(t, curBaseBalance.Equal(baseBalance.Sub(askLockCoin.Amount)))
+		require.True(t, curQuoteBalance.Equal(quoteBalance.Sub(bidLockCoin.Amount)))
+	}
+
+	// fail: insufficient funds for the net lock amount
+	{
+		hugeQuantity := sdk.NewUintFromBigInt(baseBalance.MulRaw(2).BigInt())
+		_, err := input.keeper.BatchOrders(input.ctx, addr, []dnTypes.ID{askOrder.ID}, []types.BatchOrderPost{newPost(types.Ask, hugeQuantity)})
+		require.Error(t, err)
+		require.True(t, input.keeper.Has(input.ctx, askOrder.ID))
+	}
+
+	// ok: cancel both orders, re-post the bid and a half-sized ask (only the net difference is transferred)
+	{
+		ctx := input.ctx.WithEventManager(sdk.NewEventManager())
+		newOrders, err := input.keeper.BatchOrders(ctx, addr, []dnTypes.ID{bidOrder.ID, askOrder.ID}, []types.BatchOrderPost{
+			newPost(types.Bid, quantity),
+			newPost(types.Ask, quantity.QuoUint64(2)),
+		})
+		require.NoError(t, err)
+		require.Len(t, newOrders, 2)
+		require.NotEmpty(t, ctx.EventManager().Events())
+
+		require.False(t, input.keeper.Has(input.ctx, bidOrder.ID))
+		require.False(t, input.keeper.Has(input.ctx, askOrder.ID))
+		for i, order := range newOrders {
+			require.EqualValues(t, askOrder.ID.UInt64()+uint64(i)+1, order.ID.UInt64())
+
+			storedOrder, err := input.keeper.Get(input.ctx, order.ID)
+			require.NoError(t, err)
+			require.True(t, storedOrder.Owner.Equals(addr))
+		}
+		require.Equal(t, types.Bid, newOrders[0].Direction)
+		require.Equal(t, types.Ask, newOrders[1].Direction)
+
+		newAskLockCoin, err := newOrders[1].LockCoin()
+		require.NoError(t, err)
+
+		curBaseBalance, curQuoteBalance := input.GetAccountBalance(addr, input.baseBtcDenom)
+		require.True(t, curBaseBalance.Equal(baseBalance.Sub(newAskLockCoin.Amount)))
+		require.True(t, curQuoteBalance.Equal(quoteBalance.Sub(bidLockCoin.Amount)))
+	}
+}
+
+func TestOrdersKeeper_RevokeAllOrders(t *testing.T) {
+	input := NewTestInput(
+		t,
+		perms.Permissions{
+			marketsClient.PermCreate,
+			marketsClient.PermRead,
+		},
+	)
+
+	// create markets
+	btcMarket, err := input.marketKeeper.Add(input.ctx, input.baseBtcDenom, input.quoteDenom)
+	require.NoError(t, err)
+	ethMarket, err := input.marketKeeper.Add(input.ctx, input.baseEthDenom, input.quoteDenom)
+	require.NoError(t, err)
+
+	// create accounts with supplies
+	btcBalance, ok := sdk.NewIntFromString("100000000000") // 1000 btc
+	require.True(t, ok)
+	ethBalance, ok := sdk.NewIntFromString("1000000000000000000000") // 1000 eth
+	require.True(t, ok)
+	quoteBalance, ok := sdk.NewIntFromString("1000000000000000000000") // 1000 xfi
+	require.True(t, ok)
+
+	accCoins := sdk.NewCoins(
+		sdk.NewCoin(input.baseBtcDenom, btcBalance),
+		sdk.NewCoin(input.baseEthDenom, ethBalance),
+		sdk.NewCoin(input.quoteDenom, quoteBalance),
+	)
+	addrs := make([]sdk.AccAddress, 0, 2)
+	for i := 0; i < 2; i++ {
+		_, _, addr := authTypes.KeyTestPubAddr()
+		acc := input.accountKeeper.NewAccountWithAddress(input.ctx, addr)
+		require.NoError(t, acc.SetCoins(accCoins))
+		input.accountKeeper.SetAccount(input.ctx, acc)
+		addrs = append(addrs, addr)
+	}
+	input.supplyKeeper.SetSupply(input.ctx, supply.NewSupply(accCoins.Add(accCoins...)))
+	addr, otherAddr := addrs[0], addrs[1]
+
+	price := sdk.NewUintFromString("10000000000000000000") // 10 xfi
+	quantity := sdk.NewUintFromString("100000000")         // 1 btc / 0.0000000001 eth
+	stopPrice := sdk.NewUintFromString("9000000000000000000")
+
+	postOrders := func(owner sdk.AccAddress) []dnTypes.ID {
+		ids := make([]dnTypes.ID, 0)
+		for _, market := range []markets.Market{btcMarket, ethMarket} {
+			for _, direction := range []types.Direction{types.Bid, types.Ask} {
+				order, err := input.keeper.PostOrder(input.ctx, owner, market.GetAssetCode(), direction, price, quantity, 60)
+				require.NoError(t, err)
+				ids = append(ids, order.ID)
+			}
+		}
+		stopOrder, err := input.keeper.PostStopOrder(input.ctx, owner, btcMarket.GetAssetCode(), types.Ask, price, quantity, 60, stopPrice, types.StopTriggerClearance)
+		require.NoError(t, err)
+
+		return append(ids, stopOrder.ID)
+	}
+
+	getIDs := func(orders types.Orders) []uint64 {
+		ids := make([]uint64, 0, len(orders))
+		for _, order := range orders {
+			ids = append(ids, order.ID.UInt64())
+		}
+
+		return ids
+	}
+
+	// orders: btc bid 0, btc ask 1, eth bid 2, eth ask 3, btc stop ask 4 (other owner: 5-9)
+	postOrders(addr)
+	otherIDs := postOrders(otherAddr)
+	require.Len(t, input.keeper.GetOwnerOrderIDs(input.ctx, addr, nil), 5)
+	require.Len(t, input.keeper.GetOwnerOrderIDs(input.ctx, addr, &btcMarket.ID), 3)
+
+	// nothing to revoke for an unknown market
+	{
+		unknownMarketID := dnTypes.NewIDFromUint64(10)
+		orders, err := input.keeper.RevokeAllOrders(input.ctx, addr, &unknownMarketID, "")
+		require.NoError(t, err)
+		require.Empty(t, orders)
+	}
+
+	// market and direction filter (stop order is revoked as well)
+	{
+		orders, err := input.keeper.RevokeAllOrders(input.ctx, addr, &btcMarket.ID, types.Ask)
+		require.NoError(t, err)
+		require.ElementsMatch(t, []uint64{1, 4}, getIDs(orders))
+		require.False(t, input.keeper.HasStopOrder(input.ctx, dnTypes.NewIDFromUint64(4)))
+	}
+
+	// direction filter
+	{
+		orders, err := input.keeper.RevokeAllOrders(input.ctx, addr, nil, types.Bid)
+		require.NoError(t, err)
+		require.ElementsMatch(t, []uint64{0, 2}, getIDs(orders))
+	}
+
+	// no filters: the rest is revoked, all funds are unlocked
+	{
+		orders, err := input.keeper.RevokeAllOrders(input.ctx, addr, nil, "")
+		require.NoError(t, err)
+		require.ElementsMatch(t, []uint64{3}, getIDs(orders))
+		require.Empty(t, input.keeper.GetOwnerOrderIDs(input.ctx, addr, nil))
+
+		acc := input.accountKeeper.GetAccount(input.ctx, addr)
+		require.True(t, acc.GetCoins().IsEqual(accCoins))
+	}
+
+	// other owner orders are not affected
+	for _, id := range otherIDs[:4] {
+		require.True(t, input.keeper.Has(input.ctx, id))
+	}
+	require.True(t, input.keeper.HasStopOrder(input.ctx, otherIDs[4]))
+}
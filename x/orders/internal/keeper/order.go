@@ -5,8 +5,8 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
-	"github.com/dfinance/dnode/helpers"
 	dnTypes "github.com/dfinance/dnode/helpers/types"
+	"github.com/dfinance/dnode/x/markets"
 	"github.com/dfinance/dnode/x/orders/internal/types"
 )
 
@@ -29,9 +29,9 @@ func (k Keeper) Get(ctx sdk.Context, id dnTypes.ID) (types.Order, error) {
 		return types.Order{}, types.ErrWrongOrderID
 	}
 
-	order := types.Order{}
-	if err := k.cdc.UnmarshalBinaryLengthPrefixed(bz, &order); err != nil {
-		panic(fmt.Errorf("order unmarshal: %w", err))
+	order, err := k.unmarshalOrder(ctx, bz, nil)
+	if err != nil {
+		panic(err)
 	}
 
 	return order, nil
@@ -44,10 +44,11 @@ func (k Keeper) GetList(ctx sdk.Context) (retOrders types.Orders, retErr error)
 	iterator := k.GetIterator(ctx)
 	defer iterator.Close()
 
+	marketsCache := make(map[string]markets.MarketExtended)
 	for ; iterator.Valid(); iterator.Next() {
-		order := types.Order{}
-		if err := k.cdc.UnmarshalBinaryLengthPrefixed(iterator.Value(), &order); err != nil {
-			retErr = fmt.Errorf("order unmarshal: %w", err)
+		order, err := k.unmarshalOrder(ctx, iterator.Value(), marketsCache)
+		if err != nil {
+			retErr = err
 			return
 		}
 		retOrders = append(retOrders, order)
@@ -60,40 +61,11 @@ func (k Keeper) GetList(ctx sdk.Context) (retOrders types.Orders, retErr error)
 func (k Keeper) GetListFiltered(ctx sdk.Context, params types.OrdersReq) (types.Orders, error) {
 	k.modulePerms.AutoCheck(types.PermRead)
 
-	orders, err := k.GetList(ctx)
-	if err != nil {
-		return types.Orders{}, err
-	}
-
-	paramsMarketID, _ := dnTypes.NewIDFromString(params.MarketID)
-	filteredOrders := make(types.Orders, 0, len(orders))
-	for _, o := range orders {
-		match := true
-
-		if params.OwnerFilter() && !o.Owner.Equals(params.Owner) {
-			match = false
-		}
-		if params.MarketIDFilter() && !o.Market.ID.Equal(paramsMarketID) {
-			match = false
-		}
-		if params.DirectionFilter() && !o.Direction.Equal(params.Direction) {
-			match = false
-		}
-
-		if match {
-			filteredOrders = append(filteredOrders, o)
-		}
-	}
-
-	start, end, err := helpers.PaginateSlice(len(filteredOrders), params.Page, params.Limit)
-	if err != nil {
-		return types.Orders{}, err
-	}
-
-	return filteredOrders[start:end], nil
+	return k.getListFiltered(ctx, params, types.OrderIndexes, types.OrderKeyPrefix, k.Get)
 }
 
 // GetIterator return order object iterator (direct sort order).
+// Iterator values are StoredOrder objects (market is not resolved).
 func (k Keeper) GetIterator(ctx sdk.Context) sdk.Iterator {
 	k.modulePerms.AutoCheck(types.PermRead)
 
@@ -103,6 +75,7 @@ func (k Keeper) GetIterator(ctx sdk.Context) sdk.Iterator {
 }
 
 // GetIterator return order object iterator (reverse sort order).
+// Iterator values are StoredOrder objects (market is not resolved).
 func (k Keeper) GetReverseIterator(ctx sdk.Context) sdk.Iterator {
 	k.modulePerms.AutoCheck(types.PermRead)
 
@@ -112,17 +85,64 @@ func (k Keeper) GetReverseIterator(ctx sdk.Context) sdk.Iterator {
 }
 
 
-// set creates / overwrites order object.
+// set creates / overwrites order object, its indexes and expiry queue entry.
 func (k Keeper) set(ctx sdk.Context, order types.Order) {
 	store := ctx.KVStore(k.storeKey)
 	key := types.GetOrderKey(order.ID)
-	bz := k.cdc.MustMarshalBinaryLengthPrefixed(order)
+	if bz := store.Get(key); bz != nil {
+		prevOrder := k.unmarshalOrderStub(bz)
+		k.delIndexes(store, types.OrderIndexes, prevOrder)
+		k.removeOrderFromExpiryQueue(store, prevOrder)
+	}
+
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(types.NewStoredOrder(order))
 	store.Set(key, bz)
+	k.setIndexes(store, types.OrderIndexes, order)
+	k.addOrderToExpiryQueue(store, order)
 }
 
-// del removes order object.
+// del removes order object, its indexes and expiry queue entry.
 func (k Keeper) del(ctx sdk.Context, id dnTypes.ID) {
 	store := ctx.KVStore(k.storeKey)
 	key := types.GetOrderKey(id)
+	if bz := store.Get(key); bz != nil {
+		order := k.unmarshalOrderStub(bz)
+		k.delIndexes(store, types.OrderIndexes, order)
+		k.removeOrderFromExpiryQueue(store, order)
+	}
+
 	store.Delete(key)
 }
+
+// unmarshalOrder decodes stored order object and resolves its market.
+// Optional {marketsCache} is used to resolve a market only once for multiple orders.
+func (k Keeper) unmarshalOrder(ctx sdk.Context, bz []byte, marketsCache map[string]markets.MarketExtended) (types.Order, error) {
+	storedOrder := types.StoredOrder{}
+	if err := k.cdc.UnmarshalBinaryLengthPrefixed(bz, &storedOrder); err != nil {
+		return types.Order{}, fmt.Errorf("order unmarshal: %w", err)
+	}
+
+	market, ok := marketsCache[storedOrder.MarketID.String()]
+	if !ok {
+		m, err := k.marketKeeper.GetExtended(ctx, storedOrder.MarketID)
+		if err != nil {
+			return types.Order{}, fmt.Errorf("order %s: market %s: %w", storedOrder.ID, storedOrder.MarketID, err)
+		}
+		market = m
+
+		if marketsCache != nil {
+			marketsCache[storedOrder.MarketID.String()] = market
+		}
+	}
+
+	return storedOrder.ToOrder(market), nil
+}
+
+// unmarshalOrderStub decodes stored order object without market resolving (MarketExtended has only ID set).
+// Used for indexes and expiry queue updates as they don't depend on market currencies.
+func (k Keeper) unmarshalOrderStub(bz []byte) types.Order {
+	storedOrder := types.StoredOrder{}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &storedOrder)
+
+	return storedOrder.ToOrder(markets.MarketExtended{ID: storedOrder.MarketID})
+}
@@ -8,12 +8,21 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
+	"github.com/dfinance/dnode/helpers/perms"
 	dnTypes "github.com/dfinance/dnode/helpers/types"
+	marketsClient "github.com/dfinance/dnode/x/markets/client"
 	"github.com/dfinance/dnode/x/orders/internal/types"
 )
 
 func TestOrdersKeeper_StoreIO(t *testing.T) {
-	input := NewTestInput(t, nil)
+	input := NewTestInput(
+		t,
+		perms.Permissions{
+			marketsClient.PermCreate,
+			marketsClient.PermRead,
+		},
+	)
+	input.AddMockMarkets(t)
 
 	// check non-existing
 	{
@@ -47,7 +56,14 @@ func TestOrdersKeeper_StoreIO(t *testing.T) {
 }
 
 func TestOrdersKeeper_List(t *testing.T) {
-	input := NewTestInput(t, nil)
+	input := NewTestInput(
+		t,
+		perms.Permissions{
+			marketsClient.PermCreate,
+			marketsClient.PermRead,
+		},
+	)
+	input.AddMockMarkets(t)
 
 	// get empty list
 	{
@@ -100,7 +116,7 @@ func TestOrdersKeeper_List(t *testing.T) {
 
 		i := uint64(0)
 		for ; iterator.Valid(); iterator.Next() {
-			order := types.Order{}
+			order := types.StoredOrder{}
 			require.NoError(t, input.cdc.UnmarshalBinaryLengthPrefixed(iterator.Value(), &order))
 			require.Equal(t, order.ID.UInt64(), i)
 			i++
@@ -114,7 +130,7 @@ func TestOrdersKeeper_List(t *testing.T) {
 
 		i := uint64(len(inOrders) - 1)
 		for ; iterator.Valid(); iterator.Next() {
-			order := types.Order{}
+			order := types.StoredOrder{}
 			require.NoError(t, input.cdc.UnmarshalBinaryLengthPrefixed(iterator.Value(), &order))
 			require.Equal(t, order.ID.UInt64(), i)
 			i--
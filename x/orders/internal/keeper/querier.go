@@ -19,6 +19,10 @@ func NewQuerier(k Keeper) sdk.Querier {
 			return queryList(ctx, k, req)
 		case types.QueryOrder:
 			return queryOrder(ctx, k, req)
+		case types.QueryStopList:
+			return queryStopList(ctx, k, req)
+		case types.QueryFills:
+			return queryFills(ctx, k, req)
 		default:
 			return nil, sdkErrors.Wrapf(sdkErrors.ErrUnknownRequest, "unsupported query endpoint %q for module %q", path[0], types.ModuleName)
 		}
@@ -67,3 +71,43 @@ func queryOrder(ctx sdk.Context, k Keeper, req abci.RequestQuery) ([]byte, error
 
 	return res, nil
 }
+
+// queryStopList handles stop_list query which return all not triggered stop order objects filtered.
+func queryStopList(ctx sdk.Context, k Keeper, req abci.RequestQuery) ([]byte, error) {
+	var params types.OrdersReq
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkErrors.Wrapf(types.ErrInternal, "failed to parse params: %v", err)
+	}
+
+	orders, err := k.GetStopOrdersListFiltered(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, orders)
+	if err != nil {
+		return nil, fmt.Errorf("stop orders marshal: %w", err)
+	}
+
+	return res, nil
+}
+
+// queryFills handles fills query which return fill records filtered (account / market trade history).
+func queryFills(ctx sdk.Context, k Keeper, req abci.RequestQuery) ([]byte, error) {
+	var params types.FillRecordsReq
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkErrors.Wrapf(types.ErrInternal, "failed to parse params: %v", err)
+	}
+
+	records, err := k.GetFillRecordsFiltered(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, records)
+	if err != nil {
+		return nil, fmt.Errorf("fill records marshal: %w", err)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dfinance/dnode/x/orders/internal/types"
+)
+
+// GetExpiryQueueIteratorTill returns TTL expiration queue iterator within [:endTime] range.
+// Iterator value is an active / stop order ID.
+func (k Keeper) GetExpiryQueueIteratorTill(ctx sdk.Context, endTime time.Time) sdk.Iterator {
+	k.modulePerms.AutoCheck(types.PermRead)
+
+	store := ctx.KVStore(k.storeKey)
+
+	return store.Iterator(types.ExpiryQueuePrefix, sdk.PrefixEndBytes(types.GetPrefixExpiryQueueKey(endTime)))
+}
+
+// addOrderToExpiryQueue adds good-till-canceled order to the TTL expiration queue.
+func (k Keeper) addOrderToExpiryQueue(store sdk.KVStore, order types.Order) {
+	if order.GetTimeInForce() != types.TimeInForceGTC {
+		return
+	}
+
+	store.Set(types.GetExpiryQueueKey(order.CreatedAt.Add(order.Ttl), order.ID), k.cdc.MustMarshalBinaryLengthPrefixed(order.ID))
+}
+
+// removeOrderFromExpiryQueue removes good-till-canceled order from the TTL expiration queue.
+func (k Keeper) removeOrderFromExpiryQueue(store sdk.KVStore, order types.Order) {
+	if order.GetTimeInForce() != types.TimeInForceGTC {
+		return
+	}
+
+	store.Delete(types.GetExpiryQueueKey(order.CreatedAt.Add(order.Ttl), order.ID))
+}
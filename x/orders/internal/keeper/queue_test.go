@@ -0,0 +1,76 @@
+// +build unit
+
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	dnTypes "github.com/dfinance/dnode/helpers/types"
+	"github.com/dfinance/dnode/x/orders/internal/types"
+)
+
+// ReadExpiryQueue reads order IDs from the expiry queue till the {endTime}.
+func ReadExpiryQueue(input TestInput, endTime time.Time) []uint64 {
+	iterator := input.keeper.GetExpiryQueueIteratorTill(input.ctx, endTime)
+	defer iterator.Close()
+
+	ids := make([]uint64, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		id := dnTypes.ID{}
+		input.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &id)
+		ids = append(ids, id.UInt64())
+	}
+
+	return ids
+}
+
+func TestOrdersKeeper_ExpiryQueue(t *testing.T) {
+	input := NewTestInput(t, nil)
+	now := time.Now()
+
+	newOrder := func(id uint64, ttl time.Duration) types.Order {
+		order := NewBtcXfiMockOrder(types.Bid)
+		order.ID = dnTypes.NewIDFromUint64(id)
+		order.Ttl = ttl
+		order.CreatedAt = now
+
+		return order
+	}
+
+	order0 := newOrder(0, 2*time.Minute)
+	order1 := newOrder(1, time.Minute)
+	order2 := newOrder(2, time.Minute)
+	order2.TimeInForce, order2.GoodTillBlock = types.TimeInForceGTB, 10
+	stopOrder := newOrder(3, time.Minute)
+
+	input.keeper.set(input.ctx, order0)
+	input.keeper.set(input.ctx, order1)
+	input.keeper.set(input.ctx, order2)
+	input.keeper.setStopOrder(input.ctx, stopOrder)
+
+	// queue is sorted by expiration time and ID, good-till-block orders are not queued
+	{
+		require.Empty(t, ReadExpiryQueue(input, now.Add(59*time.Second)))
+		require.Equal(t, []uint64{1, 3}, ReadExpiryQueue(input, now.Add(time.Minute)))
+		require.Equal(t, []uint64{1, 3, 0}, ReadExpiryQueue(input, now.Add(time.Hour)))
+	}
+
+	// overwrite with a new TTL: queue entry is moved
+	{
+		order1.Ttl = 3 * time.Minute
+		input.keeper.set(input.ctx, order1)
+
+		require.Equal(t, []uint64{3, 0, 1}, ReadExpiryQueue(input, now.Add(time.Hour)))
+	}
+
+	// delete: queue entries are removed
+	{
+		input.keeper.del(input.ctx, order0.ID)
+		input.keeper.delStopOrder(input.ctx, stopOrder.ID)
+
+		require.Equal(t, []uint64{1}, ReadExpiryQueue(input, now.Add(time.Hour)))
+	}
+}
@@ -0,0 +1,189 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	dnTypes "github.com/dfinance/dnode/helpers/types"
+	"github.com/dfinance/dnode/x/markets"
+	"github.com/dfinance/dnode/x/orders/internal/types"
+)
+
+// PostStopOrder creates a new stop order object and locks account funds (coins).
+// Stop order is stored separately from active orders and doesn't participate in matching until triggered.
+func (k Keeper) PostStopOrder(
+	ctx sdk.Context,
+	owner sdk.AccAddress,
+	assetCode dnTypes.AssetCode,
+	direction types.Direction,
+	price sdk.Uint,
+	quantity sdk.Uint,
+	ttlInSec uint64,
+	stopPrice sdk.Uint,
+	stopTrigger types.StopTrigger) (types.Order, error) {
+
+	k.modulePerms.AutoCheck(types.PermOrderPost)
+
+	if stopPrice.IsZero() {
+		return types.Order{}, types.ErrWrongStopPrice
+	}
+	if !stopTrigger.IsValid() {
+		return types.Order{}, sdkErrors.Wrap(types.ErrWrongStopTrigger, stopTrigger.String())
+	}
+
+	_, market, err := k.getOrderMarket(ctx, assetCode)
+	if err != nil {
+		return types.Order{}, err
+	}
+
+	params := k.GetParams(ctx)
+	if err := k.checkOpenOrdersLimit(ctx, params, owner, market.ID); err != nil {
+		return types.Order{}, err
+	}
+
+	id := k.nextID(ctx)
+	order := types.NewOrder(ctx, id, owner, market, direction, price, quantity, ttlInSec)
+	order.StopPrice, order.StopTrigger = stopPrice, stopTrigger
+	order.Deposit = params.OrderDeposit
+	if err := order.ValidatePriceQuantity(); err != nil {
+		return types.Order{}, err
+	}
+
+	if err := k.LockOrderCoins(ctx, order); err != nil {
+		return types.Order{}, err
+	}
+	k.setStopOrder(ctx, order)
+	k.setID(ctx, id)
+
+	ctx.EventManager().EmitEvent(types.NewStopOrderPostedEvent(order))
+
+	k.GetLogger(ctx).Debug(fmt.Sprintf("stop order %s from %s: posted", id, owner))
+
+	return order, nil
+}
+
+// TriggerStopOrder moves a stop order to active orders (order joins the next matching round).
+// Order gets a new matching priority as it is activated later than orders posted before the trigger,
+// trigger block height is saved to match the order as a taker in the next block.
+func (k Keeper) TriggerStopOrder(ctx sdk.Context, id dnTypes.ID, triggerPrice sdk.Uint) (types.Order, error) {
+	k.modulePerms.AutoCheck(types.PermOrderTrigger)
+
+	order, err := k.GetStopOrder(ctx, id)
+	if err != nil {
+		return types.Order{}, err
+	}
+
+	if !order.IsStopTriggered(triggerPrice) {
+		return types.Order{}, sdkErrors.Wrapf(types.ErrWrongStopPrice, "trigger price %s doesn't cross stop price %s", triggerPrice, order.GetStopPrice())
+	}
+
+	priorityID := k.nextID(ctx)
+	k.setID(ctx, priorityID)
+	order.PriorityID = priorityID
+	order.UpdatedAt = ctx.BlockTime()
+	order.TriggeredAt = ctx.BlockHeight()
+
+	k.delStopOrder(ctx, id)
+	k.set(ctx, order)
+
+	ctx.EventManager().EmitEvent(types.NewStopOrderTriggeredEvent(order, triggerPrice))
+
+	k.GetLogger(ctx).Debug(fmt.Sprintf("stop order %s from %s: triggered at %s", id, order.Owner, triggerPrice))
+
+	return order, nil
+}
+
+// HasStopOrder checks if stop order object with ID exists.
+func (k Keeper) HasStopOrder(ctx sdk.Context, id dnTypes.ID) bool {
+	k.modulePerms.AutoCheck(types.PermRead)
+
+	store := ctx.KVStore(k.storeKey)
+
+	return store.Has(types.GetStopOrderKey(id))
+}
+
+// GetStopOrder gets stop order object by ID.
+func (k Keeper) GetStopOrder(ctx sdk.Context, id dnTypes.ID) (types.Order, error) {
+	k.modulePerms.AutoCheck(types.PermRead)
+
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetStopOrderKey(id))
+	if bz == nil {
+		return types.Order{}, types.ErrWrongOrderID
+	}
+
+	order, err := k.unmarshalOrder(ctx, bz, nil)
+	if err != nil {
+		panic(fmt.Errorf("stop %w", err))
+	}
+
+	return order, nil
+}
+
+// GetStopOrdersList return all not triggered stop orders.
+func (k Keeper) GetStopOrdersList(ctx sdk.Context) (retOrders types.Orders, retErr error) {
+	k.modulePerms.AutoCheck(types.PermRead)
+
+	iterator := k.GetStopOrderIterator(ctx)
+	defer iterator.Close()
+
+	marketsCache := make(map[string]markets.MarketExtended)
+	for ; iterator.Valid(); iterator.Next() {
+		order, err := k.unmarshalOrder(ctx, iterator.Value(), marketsCache)
+		if err != nil {
+			retErr = fmt.Errorf("stop %w", err)
+			return
+		}
+		retOrders = append(retOrders, order)
+	}
+
+	return
+}
+
+// GetStopOrdersListFiltered returns stop order objects filtered by params.
+func (k Keeper) GetStopOrdersListFiltered(ctx sdk.Context, params types.OrdersReq) (types.Orders, error) {
+	k.modulePerms.AutoCheck(types.PermRead)
+
+	return k.getListFiltered(ctx, params, types.StopOrderIndexes, types.StopOrderKeyPrefix, k.GetStopOrder)
+}
+
+// GetStopOrderIterator return stop order object iterator (direct sort order).
+// Iterator values are StoredOrder objects (market is not resolved).
+func (k Keeper) GetStopOrderIterator(ctx sdk.Context) sdk.Iterator {
+	k.modulePerms.AutoCheck(types.PermRead)
+
+	store := ctx.KVStore(k.storeKey)
+
+	return sdk.KVStorePrefixIterator(store, types.StopOrderKeyPrefix)
+}
+
+// setStopOrder creates / overwrites stop order object, its indexes and expiry queue entry.
+func (k Keeper) setStopOrder(ctx sdk.Context, order types.Order) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetStopOrderKey(order.ID)
+	if bz := store.Get(key); bz != nil {
+		prevOrder := k.unmarshalOrderStub(bz)
+		k.delIndexes(store, types.StopOrderIndexes, prevOrder)
+		k.removeOrderFromExpiryQueue(store, prevOrder)
+	}
+
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(types.NewStoredOrder(order))
+	store.Set(key, bz)
+	k.setIndexes(store, types.StopOrderIndexes, order)
+	k.addOrderToExpiryQueue(store, order)
+}
+
+// delStopOrder removes stop order object, its indexes and expiry queue entry.
+func (k Keeper) delStopOrder(ctx sdk.Context, id dnTypes.ID) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetStopOrderKey(id)
+	if bz := store.Get(key); bz != nil {
+		order := k.unmarshalOrderStub(bz)
+		k.delIndexes(store, types.StopOrderIndexes, order)
+		k.removeOrderFromExpiryQueue(store, order)
+	}
+
+	store.Delete(key)
+}
@@ -12,6 +12,10 @@ var ModuleCdc *codec.Codec
 func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgPostOrder{}, fmt.Sprintf("%s/MsgPostOrder", ModuleName), nil)
 	cdc.RegisterConcrete(MsgRevokeOrder{}, fmt.Sprintf("%s/MsgRevokeOrder", ModuleName), nil)
+	cdc.RegisterConcrete(MsgAmendOrder{}, fmt.Sprintf("%s/MsgAmendOrder", ModuleName), nil)
+	cdc.RegisterConcrete(MsgPostStopOrder{}, fmt.Sprintf("%s/MsgPostStopOrder", ModuleName), nil)
+	cdc.RegisterConcrete(MsgBatchOrders{}, fmt.Sprintf("%s/MsgBatchOrders", ModuleName), nil)
+	cdc.RegisterConcrete(MsgRevokeAllOrders{}, fmt.Sprintf("%s/MsgRevokeAllOrders", ModuleName), nil)
 }
 
 func init() {
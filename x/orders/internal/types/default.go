@@ -3,4 +3,8 @@ package types
 const (
 	ModuleName = "orders"
 	StoreKey   = ModuleName
+	// Module account collecting market trading fees
+	FeeCollectorName = ModuleName + "_fees"
+	// Default params subspace name
+	DefaultParamspace = ModuleName
 )
@@ -20,4 +20,24 @@ var (
 	ErrWrongOrderID = sdkErrors.Register(ModuleName, 107, "wrong orderID")
 	// Asset code not exists.
 	ErrWrongAssetCode = sdkErrors.Register(ModuleName, 108, "wrong asset code")
+	// Market doesn't accept new orders.
+	ErrWrongMarketStatus = sdkErrors.Register(ModuleName, 109, "market doesn't accept orders")
+	// Order amend request is invalid.
+	ErrWrongAmend = sdkErrors.Register(ModuleName, 110, "wrong order amend")
+	// TimeInForce enum is invalid.
+	ErrWrongTimeInForce = sdkErrors.Register(ModuleName, 111, "wrong time in force")
+	// Good-till-block height is invalid.
+	ErrWrongGoodTillBlock = sdkErrors.Register(ModuleName, 112, "wrong good till block height")
+	// Stop order trigger price is invalid.
+	ErrWrongStopPrice = sdkErrors.Register(ModuleName, 113, "wrong stop price, should be greater that 0")
+	// Stop order trigger source enum is invalid.
+	ErrWrongStopTrigger = sdkErrors.Register(ModuleName, 114, "wrong stop trigger")
+	// SelfTradePrevention enum is invalid.
+	ErrWrongSelfTradePrevention = sdkErrors.Register(ModuleName, 115, "wrong self-trade prevention")
+	// Owner open orders limit for the market is reached.
+	ErrMaxOpenOrders = sdkErrors.Register(ModuleName, 116, "max open orders limit reached")
+	// Fill record not exists.
+	ErrWrongFillRecordID = sdkErrors.Register(ModuleName, 117, "wrong fill record ID")
+	// Batch orders request is invalid.
+	ErrWrongBatch = sdkErrors.Register(ModuleName, 118, "wrong batch orders request")
 )
@@ -7,8 +7,13 @@ import (
 const (
 	EventTypeOrderPost            = ModuleName + ".post"
 	EventTypeOrderCancel          = ModuleName + ".cancel"
+	EventTypeOrderAmend           = ModuleName + ".amend"
 	EventTypeFullyFilledOrder     = ModuleName + ".full_fill"
 	EventTypePartiallyFilledOrder = ModuleName + ".partial_fill"
+	EventTypeStopOrderPost        = ModuleName + ".stop_post"
+	EventTypeStopOrderTrigger     = ModuleName + ".stop_trigger"
+	EventTypeDepositRefund        = ModuleName + ".deposit_refund"
+	EventTypeDepositBurn          = ModuleName + ".deposit_burn"
 	//
 	AttributeMarketId  = "market_id"
 	AttributeOrderId   = "order_id"
@@ -16,6 +21,11 @@ const (
 	AttributeDirection = "direction"
 	AttributePrice     = "price"
 	AttributeQuantity  = "quantity"
+	AttributeFee       = "fee"
+	AttributePriority  = "priority_id"
+	AttributeStopPrice = "stop_price"
+	AttributeTrigger   = "stop_trigger"
+	AttributeDeposit   = "deposit"
 )
 
 // NewOrderPostedEvent creates an Event on order post (creation).
@@ -44,8 +54,22 @@ func NewOrderCanceledEvent(order Order) sdk.Event {
 	)
 }
 
+// NewOrderAmendedEvent creates an Event on order price / quantity amend.
+func NewOrderAmendedEvent(order Order) sdk.Event {
+	return sdk.NewEvent(
+		EventTypeOrderAmend,
+		sdk.NewAttribute(AttributeOwner, order.Owner.String()),
+		sdk.NewAttribute(AttributeMarketId, order.Market.ID.String()),
+		sdk.NewAttribute(AttributeOrderId, order.ID.String()),
+		sdk.NewAttribute(AttributeDirection, order.Direction.String()),
+		sdk.NewAttribute(AttributePrice, order.Price.String()),
+		sdk.NewAttribute(AttributeQuantity, order.Quantity.String()),
+		sdk.NewAttribute(AttributePriority, order.GetPriorityID().String()),
+	)
+}
+
 // NewFullyFilledOrderEvent creates an Event on order fully filled (triggered by Matcher).
-func NewFullyFilledOrderEvent(order Order) sdk.Event {
+func NewFullyFilledOrderEvent(order Order, fee sdk.Coin) sdk.Event {
 	return sdk.NewEvent(
 		EventTypeFullyFilledOrder,
 		sdk.NewAttribute(AttributeOwner, order.Owner.String()),
@@ -54,11 +78,12 @@ func NewFullyFilledOrderEvent(order Order) sdk.Event {
 		sdk.NewAttribute(AttributeDirection, order.Direction.String()),
 		sdk.NewAttribute(AttributePrice, order.Price.String()),
 		sdk.NewAttribute(AttributeQuantity, order.Quantity.String()),
+		sdk.NewAttribute(AttributeFee, fee.String()),
 	)
 }
 
 // NewPartiallyFilledOrderEvent creates an Event on order partially filled (triggered by Matcher).
-func NewPartiallyFilledOrderEvent(order Order) sdk.Event {
+func NewPartiallyFilledOrderEvent(order Order, fee sdk.Coin) sdk.Event {
 	return sdk.NewEvent(
 		EventTypePartiallyFilledOrder,
 		sdk.NewAttribute(AttributeOwner, order.Owner.String()),
@@ -67,5 +92,58 @@ func NewPartiallyFilledOrderEvent(order Order) sdk.Event {
 		sdk.NewAttribute(AttributeDirection, order.Direction.String()),
 		sdk.NewAttribute(AttributePrice, order.Price.String()),
 		sdk.NewAttribute(AttributeQuantity, order.Quantity.String()),
+		sdk.NewAttribute(AttributeFee, fee.String()),
+	)
+}
+
+// NewStopOrderPostedEvent creates an Event on stop order post (creation).
+func NewStopOrderPostedEvent(order Order) sdk.Event {
+	return sdk.NewEvent(
+		EventTypeStopOrderPost,
+		sdk.NewAttribute(AttributeOwner, order.Owner.String()),
+		sdk.NewAttribute(AttributeMarketId, order.Market.ID.String()),
+		sdk.NewAttribute(AttributeOrderId, order.ID.String()),
+		sdk.NewAttribute(AttributeDirection, order.Direction.String()),
+		sdk.NewAttribute(AttributePrice, order.Price.String()),
+		sdk.NewAttribute(AttributeQuantity, order.Quantity.String()),
+		sdk.NewAttribute(AttributeStopPrice, order.GetStopPrice().String()),
+		sdk.NewAttribute(AttributeTrigger, order.StopTrigger.String()),
+	)
+}
+
+// NewStopOrderTriggeredEvent creates an Event on stop order activation (triggered by Matcher).
+func NewStopOrderTriggeredEvent(order Order, triggerPrice sdk.Uint) sdk.Event {
+	return sdk.NewEvent(
+		EventTypeStopOrderTrigger,
+		sdk.NewAttribute(AttributeOwner, order.Owner.String()),
+		sdk.NewAttribute(AttributeMarketId, order.Market.ID.String()),
+		sdk.NewAttribute(AttributeOrderId, order.ID.String()),
+		sdk.NewAttribute(AttributeDirection, order.Direction.String()),
+		sdk.NewAttribute(AttributePrice, triggerPrice.String()),
+		sdk.NewAttribute(AttributeStopPrice, order.GetStopPrice().String()),
+		sdk.NewAttribute(AttributeTrigger, order.StopTrigger.String()),
+		sdk.NewAttribute(AttributePriority, order.GetPriorityID().String()),
+	)
+}
+
+// NewOrderDepositRefundedEvent creates an Event on order deposit refund (order first fill).
+func NewOrderDepositRefundedEvent(order Order) sdk.Event {
+	return sdk.NewEvent(
+		EventTypeDepositRefund,
+		sdk.NewAttribute(AttributeOwner, order.Owner.String()),
+		sdk.NewAttribute(AttributeMarketId, order.Market.ID.String()),
+		sdk.NewAttribute(AttributeOrderId, order.ID.String()),
+		sdk.NewAttribute(AttributeDeposit, order.Deposit.String()),
+	)
+}
+
+// NewOrderDepositBurnedEvent creates an Event on order deposit burn (order canceled without fills).
+func NewOrderDepositBurnedEvent(order Order) sdk.Event {
+	return sdk.NewEvent(
+		EventTypeDepositBurn,
+		sdk.NewAttribute(AttributeOwner, order.Owner.String()),
+		sdk.NewAttribute(AttributeMarketId, order.Market.ID.String()),
+		sdk.NewAttribute(AttributeOrderId, order.ID.String()),
+		sdk.NewAttribute(AttributeDeposit, order.Deposit.String()),
 	)
 }
@@ -0,0 +1,167 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/olekukonko/tablewriter"
+
+	dnTypes "github.com/dfinance/dnode/helpers/types"
+)
+
+// FillRecord is a persistent order fill (trade) record.
+type FillRecord struct {
+	// Fill record unique ID
+	ID dnTypes.ID `json:"id" yaml:"id" example:"0" format:"string representation for big.Uint" swaggertype:"string"`
+	// Filled order ID
+	OrderID dnTypes.ID `json:"order_id" yaml:"order_id" example:"0" format:"string representation for big.Uint" swaggertype:"string"`
+	// Order owner account address
+	Owner sdk.AccAddress `json:"owner" yaml:"owner" swaggertype:"string" format:"bech32" example:"wallet13jyjuz3kkdvqw8u4qfkwd94emdl3vx394kn07h"`
+	// Market ID
+	MarketID dnTypes.ID `json:"market_id" yaml:"market_id" example:"0" format:"string representation for big.Uint" swaggertype:"string"`
+	// Order type (bid/ask)
+	Direction Direction `json:"direction" yaml:"direction" swaggertype:"string" example:"bid"`
+	// Clearance price (in quote asset denom)
+	ClearancePrice sdk.Uint `json:"clearance_price" yaml:"clearance_price" swaggertype:"string" example:"100"`
+	// Filled quantity (in base asset denom)
+	QuantityFilled sdk.Uint `json:"quantity_filled" yaml:"quantity_filled" swaggertype:"string" example:"50"`
+	// Market maker / taker fee taken from the fill coin
+	Fee sdk.Coin `json:"fee" yaml:"fee" swaggertype:"string" example:"1xfi"`
+	// Bid order price difference refund (empty if there was no refund)
+	Refund sdk.Coins `json:"refund" yaml:"refund" swaggertype:"string" example:"10xfi"`
+	// Fill block height
+	BlockHeight int64 `json:"block_height" yaml:"block_height" example:"100"`
+	// Fill block timestamp
+	Timestamp time.Time `json:"timestamp" yaml:"timestamp" format:"RFC 3339" example:"2020-03-27T13:45:15.293426Z"`
+}
+
+// Valid checks that FillRecord is valid (used for genesis ops).
+func (r FillRecord) Valid() error {
+	if err := r.ID.Valid(); err != nil {
+		return fmt.Errorf("id: %w", err)
+	}
+	if err := r.OrderID.Valid(); err != nil {
+		return fmt.Errorf("order_id: %w", err)
+	}
+	if r.Owner.Empty() {
+		return fmt.Errorf("owner: empty")
+	}
+	if err := r.MarketID.Valid(); err != nil {
+		return fmt.Errorf("market_id: %w", err)
+	}
+	if !r.Direction.IsValid() {
+		return fmt.Errorf("direction: invalid")
+	}
+	if r.ClearancePrice.IsZero() {
+		return fmt.Errorf("clearance_price: is zero")
+	}
+	if r.QuantityFilled.IsZero() {
+		return fmt.Errorf("quantity_filled: is zero")
+	}
+	if !r.Fee.IsValid() {
+		return fmt.Errorf("fee: invalid")
+	}
+	if !r.Refund.IsValid() {
+		return fmt.Errorf("refund: invalid")
+	}
+	if r.BlockHeight < 0 {
+		return fmt.Errorf("block_height: should be GTE 0")
+	}
+	if r.Timestamp.IsZero() {
+		return fmt.Errorf("timestamp: is zero")
+	}
+
+	return nil
+}
+
+// Strings returns multi-line text object representation.
+func (r FillRecord) String() string {
+	b := strings.Builder{}
+	b.WriteString("FillRecord:\n")
+	b.WriteString(fmt.Sprintf("  ID:             %s\n", r.ID.String()))
+	b.WriteString(fmt.Sprintf("  OrderID:        %s\n", r.OrderID.String()))
+	b.WriteString(fmt.Sprintf("  Owner:          %s\n", r.Owner.String()))
+	b.WriteString(fmt.Sprintf("  MarketID:       %s\n", r.MarketID.String()))
+	b.WriteString(fmt.Sprintf("  Direction:      %s\n", r.Direction.String()))
+	b.WriteString(fmt.Sprintf("  ClearancePrice: %s\n", r.ClearancePrice.String()))
+	b.WriteString(fmt.Sprintf("  Filled:         %s\n", r.QuantityFilled.String()))
+	b.WriteString(fmt.Sprintf("  Fee:            %s\n", r.Fee.String()))
+	b.WriteString(fmt.Sprintf("  Refund:         %s\n", r.Refund.String()))
+	b.WriteString(fmt.Sprintf("  BlockHeight:    %d\n", r.BlockHeight))
+	b.WriteString(fmt.Sprintf("  Timestamp:      %s\n", r.Timestamp.String()))
+
+	return b.String()
+}
+
+// TableHeaders returns table headers for multi-line text table object representation.
+func (r FillRecord) TableHeaders() []string {
+	return []string{
+		"FR.ID",
+		"FR.OrderID",
+		"FR.Owner",
+		"FR.MarketID",
+		"FR.Direction",
+		"FR.ClearancePrice",
+		"FR.Filled",
+		"FR.Fee",
+		"FR.Refund",
+		"FR.BlockHeight",
+		"FR.Timestamp",
+	}
+}
+
+// TableValues returns table rows for multi-line text table object representation.
+func (r FillRecord) TableValues() []string {
+	return []string{
+		r.ID.String(),
+		r.OrderID.String(),
+		r.Owner.String(),
+		r.MarketID.String(),
+		r.Direction.String(),
+		r.ClearancePrice.String(),
+		r.QuantityFilled.String(),
+		r.Fee.String(),
+		r.Refund.String(),
+		strconv.FormatInt(r.BlockHeight, 10),
+		r.Timestamp.String(),
+	}
+}
+
+// NewFillRecord creates a new fill record object for the order fill.
+func NewFillRecord(ctx sdk.Context, id dnTypes.ID, orderFill OrderFill, fee sdk.Coin, refund sdk.Coins) FillRecord {
+	return FillRecord{
+		ID:             id,
+		OrderID:        orderFill.Order.ID,
+		Owner:          orderFill.Order.Owner,
+		MarketID:       orderFill.Order.Market.ID,
+		Direction:      orderFill.Order.Direction,
+		ClearancePrice: orderFill.ClearancePrice,
+		QuantityFilled: orderFill.QuantityFilled,
+		Fee:            fee,
+		Refund:         refund,
+		BlockHeight:    ctx.BlockHeight(),
+		Timestamp:      ctx.BlockTime(),
+	}
+}
+
+// FillRecord slice type.
+type FillRecords []FillRecord
+
+// Strings returns multi-line text object representation.
+func (l FillRecords) String() string {
+	var buf bytes.Buffer
+
+	t := tablewriter.NewWriter(&buf)
+	t.SetHeader(FillRecord{}.TableHeaders())
+
+	for _, r := range l {
+		t.Append(r.TableValues())
+	}
+	t.Render()
+
+	return buf.String()
+}
@@ -6,34 +6,42 @@ import (
 	"time"
 
 	dnTypes "github.com/dfinance/dnode/helpers/types"
+	"github.com/dfinance/dnode/x/markets"
 )
 
 // GenesisState orders state that must be provided at genesis.
 type GenesisState struct {
-	Orders      Orders      `json:"orders" yaml:"orders"`
-	LastOrderId *dnTypes.ID `json:"last_order_id" yaml:"last_order_id"`
+	Params      Params       `json:"params" yaml:"params"`
+	Orders      StoredOrders `json:"orders" yaml:"orders"`
+	StopOrders  StoredOrders `json:"stop_orders" yaml:"stop_orders"`
+	LastOrderId *dnTypes.ID  `json:"last_order_id" yaml:"last_order_id"`
+	FillRecords FillRecords  `json:"fill_records" yaml:"fill_records"`
 }
 
 // Validate checks that genesis state is valid.
 func (gs GenesisState) Validate(blockTime time.Time) error {
+	if err := gs.Params.Validate(); err != nil {
+		return fmt.Errorf("params: %w", err)
+	}
+
 	maxOrderID := dnTypes.NewZeroID()
-	ordersIdsSet := make(map[string]bool, len(gs.Orders))
+	ordersIdsSet := make(map[string]bool, len(gs.Orders)+len(gs.StopOrders))
 
-	for i, order := range gs.Orders {
+	checkOrder := func(order StoredOrder) error {
 		if err := order.Valid(); err != nil {
-			return fmt.Errorf("order[%d]: %w", i, err)
+			return err
 		}
 
 		if !blockTime.IsZero() && order.CreatedAt.After(blockTime) {
-			return fmt.Errorf("order[%d]: create_at after block time", i)
+			return fmt.Errorf("create_at after block time")
 		}
 
 		if !blockTime.IsZero() && order.UpdatedAt.After(blockTime) {
-			return fmt.Errorf("order[%d]: updated_at after block time", i)
+			return fmt.Errorf("updated_at after block time")
 		}
 
 		if ordersIdsSet[order.ID.String()] {
-			return fmt.Errorf("order[%d]: duplicated ID %q", i, order.ID.String())
+			return fmt.Errorf("duplicated ID %q", order.ID.String())
 		}
 
 		ordersIdsSet[order.ID.String()] = true
@@ -41,12 +49,34 @@ func (gs GenesisState) Validate(blockTime time.Time) error {
 		if order.ID.GT(maxOrderID) {
 			maxOrderID = order.ID
 		}
+		// amended / triggered order priority is taken from the order IDs sequence
+		if priorityID := order.ToOrder(markets.MarketExtended{ID: order.MarketID}).GetPriorityID(); priorityID.GT(maxOrderID) {
+			maxOrderID = priorityID
+		}
+
+		return nil
+	}
+
+	for i, order := range gs.Orders {
+		if err := checkOrder(order); err != nil {
+			return fmt.Errorf("order[%d]: %w", i, err)
+		}
 	}
 
-	if gs.LastOrderId == nil && len(gs.Orders) != 0 {
+	for i, order := range gs.StopOrders {
+		if order.StopTrigger == "" {
+			return fmt.Errorf("stop_order[%d]: not a stop order", i)
+		}
+		if err := checkOrder(order); err != nil {
+			return fmt.Errorf("stop_order[%d]: %w", i, err)
+		}
+	}
+
+	ordersCnt := len(gs.Orders) + len(gs.StopOrders)
+	if gs.LastOrderId == nil && ordersCnt != 0 {
 		return fmt.Errorf("last_order_id: nil with existing orders")
 	}
-	if gs.LastOrderId != nil && len(gs.Orders) == 0 {
+	if gs.LastOrderId != nil && ordersCnt == 0 {
 		return fmt.Errorf("last_order_id: not nil without existing orders")
 	}
 	if gs.LastOrderId != nil {
@@ -59,6 +89,22 @@ func (gs GenesisState) Validate(blockTime time.Time) error {
 		}
 	}
 
+	fillRecordIdsSet := make(map[string]bool, len(gs.FillRecords))
+	for i, record := range gs.FillRecords {
+		if err := record.Valid(); err != nil {
+			return fmt.Errorf("fill_record[%d]: %w", i, err)
+		}
+
+		if !blockTime.IsZero() && record.Timestamp.After(blockTime) {
+			return fmt.Errorf("fill_record[%d]: timestamp after block time", i)
+		}
+
+		if fillRecordIdsSet[record.ID.String()] {
+			return fmt.Errorf("fill_record[%d]: duplicated ID %q", i, record.ID.String())
+		}
+		fillRecordIdsSet[record.ID.String()] = true
+	}
+
 	return nil
 }
 
@@ -77,6 +123,9 @@ func (gs GenesisState) IsEmpty() bool {
 // DefaultGenesisState defines default GenesisState for orders.
 func DefaultGenesisState() GenesisState {
 	return GenesisState{
-		Orders: Orders{},
+		Params:      DefaultParams(),
+		Orders:      StoredOrders{},
+		StopOrders:  StoredOrders{},
+		FillRecords: FillRecords{},
 	}
 }
@@ -16,7 +16,7 @@ import (
 func getTestGenesisState() GenesisState {
 	order := NewMockOrder()
 	return GenesisState{
-		Orders:      Orders{order},
+		Orders:      StoredOrders{NewStoredOrder(order)},
 		LastOrderId: &order.ID,
 	}
 }
@@ -28,7 +28,7 @@ func TestOrders_Genesis_Valid(t *testing.T) {
 		order.ID = types.NewIDFromUint64(0)
 		order2 := NewMockOrder()
 		order2.ID = types.NewIDFromUint64(1)
-		order2.Market.QuoteCurrency.Denom = "btc"
+		order2.Market.ID = types.NewIDFromUint64(1)
 
 		order3 := NewMockOrder()
 		order3.ID = types.NewIDFromUint64(2)
@@ -36,14 +36,89 @@ func TestOrders_Genesis_Valid(t *testing.T) {
 		order4 := *orderT
 
 		state := GenesisState{
-			Orders:      Orders{order, order2, order3},
+			Orders:      NewStoredOrders(Orders{order, order2, order3}),
 			LastOrderId: &order3.ID,
 		}
 		require.NoError(t, state.Validate(time.Now()))
 		require.False(t, state.IsEmpty())
 
-		require.False(t, GenesisState{Orders: Orders{order2}}.Equal(GenesisState{Orders: Orders{order3}}))
-		require.True(t, GenesisState{Orders: Orders{order3}}.Equal(GenesisState{Orders: Orders{order4}}))
+		require.False(t, GenesisState{Orders: NewStoredOrders(Orders{order2})}.Equal(GenesisState{Orders: NewStoredOrders(Orders{order3})}))
+		require.True(t, GenesisState{Orders: NewStoredOrders(Orders{order3})}.Equal(GenesisState{Orders: NewStoredOrders(Orders{order4})}))
+	}
+
+	// wrong params
+	{
+		state := getTestGenesisState()
+		state.Params.OrderDeposit = sdk.Coins{sdk.Coin{Denom: "xfi", Amount: sdk.ZeroInt()}}
+		err := state.Validate(time.Now())
+
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "params")
+		require.Contains(t, err.Error(), "order_deposit")
+	}
+
+	// wrong params: fill records are pruned without the prune limit
+	{
+		state := getTestGenesisState()
+		state.Params = DefaultParams()
+		require.NoError(t, state.Validate(time.Now()))
+
+		state.Params.FillRecordPruneLimit = 0
+		err := state.Validate(time.Now())
+
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "fill_record_prune_limit")
+	}
+
+	// wrong order deposit
+	{
+		state := getTestGenesisState()
+		state.Orders[0].Deposit = sdk.Coins{sdk.Coin{Denom: "xfi", Amount: sdk.ZeroInt()}}
+		err := state.Validate(time.Now())
+
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "deposit")
+	}
+
+	// wrong fill records
+	{
+		order := NewMockOrder()
+		record := FillRecord{
+			ID:             types.NewIDFromUint64(0),
+			OrderID:        order.ID,
+			Owner:          order.Owner,
+			MarketID:       order.Market.ID,
+			Direction:      order.Direction,
+			ClearancePrice: order.Price,
+			QuantityFilled: order.Quantity,
+			Fee:            sdk.NewCoin("xfi", sdk.ZeroInt()),
+			BlockHeight:    1,
+			Timestamp:      time.Now().Add(-time.Hour),
+		}
+
+		state := getTestGenesisState()
+		state.FillRecords = FillRecords{record}
+		require.NoError(t, state.Validate(time.Now()))
+
+		state.FillRecords = FillRecords{record, record}
+		err := state.Validate(time.Now())
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "fill_record[1]")
+		require.Contains(t, err.Error(), "duplicated")
+
+		invalidRecord := record
+		invalidRecord.QuantityFilled = sdk.ZeroUint()
+		state.FillRecords = FillRecords{invalidRecord}
+		err = state.Validate(time.Now())
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "quantity_filled")
+
+		futureRecord := record
+		futureRecord.Timestamp = time.Now().Add(time.Hour)
+		state.FillRecords = FillRecords{futureRecord}
+		err = state.Validate(time.Now())
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "timestamp")
 	}
 
 	// wrong id
@@ -168,7 +243,7 @@ func TestOrders_Genesis_Valid(t *testing.T) {
 	// wrong market id
 	{
 		state := getTestGenesisState()
-		state.Orders[0].Market.ID, _ = types.NewIDFromString("")
+		state.Orders[0].MarketID, _ = types.NewIDFromString("")
 		err := state.Validate(time.Now())
 
 		require.Error(t, err)
@@ -177,58 +252,6 @@ func TestOrders_Genesis_Valid(t *testing.T) {
 		require.Contains(t, err.Error(), "nil")
 	}
 
-	// empty market BaseCurrency Denom
-	{
-		state := getTestGenesisState()
-		state.Orders[0].Market.BaseCurrency.Denom = ""
-		err := state.Validate(time.Now())
-
-		require.Error(t, err)
-		require.Contains(t, err.Error(), "market")
-		require.Contains(t, err.Error(), "denom")
-		require.Contains(t, err.Error(), "base")
-		require.Contains(t, err.Error(), "empty")
-	}
-
-	// wrong market BaseCurrency Denom
-	{
-		state := getTestGenesisState()
-		state.Orders[0].Market.BaseCurrency.Denom = "wrong_denom"
-		err := state.Validate(time.Now())
-
-		require.Error(t, err)
-		require.Contains(t, err.Error(), "market")
-		require.Contains(t, err.Error(), "denom")
-		require.Contains(t, err.Error(), "base_currency")
-		require.Contains(t, err.Error(), "invalid")
-	}
-
-	// empty market QuoteCurrency Denom
-	{
-		state := getTestGenesisState()
-		state.Orders[0].Market.QuoteCurrency.Denom = ""
-		err := state.Validate(time.Now())
-
-		require.Error(t, err)
-		require.Contains(t, err.Error(), "market")
-		require.Contains(t, err.Error(), "denom")
-		require.Contains(t, err.Error(), "quote")
-		require.Contains(t, err.Error(), "empty")
-	}
-
-	// wrong market QuoteCurrency Denom
-	{
-		state := getTestGenesisState()
-		state.Orders[0].Market.QuoteCurrency.Denom = "wrong_denom"
-		err := state.Validate(time.Now())
-
-		require.Error(t, err)
-		require.Contains(t, err.Error(), "market")
-		require.Contains(t, err.Error(), "denom")
-		require.Contains(t, err.Error(), "quote_currency")
-		require.Contains(t, err.Error(), "invalid")
-	}
-
 	// empty orders, existiong lastId
 	{
 		id := types.NewIDFromUint64(1)
@@ -244,7 +267,7 @@ func TestOrders_Genesis_Valid(t *testing.T) {
 	// empty orders, without lastId
 	{
 		order := NewMockOrder()
-		err := GenesisState{Orders: Orders{order}}.Validate(time.Now())
+		err := GenesisState{Orders: StoredOrders{NewStoredOrder(order)}}.Validate(time.Now())
 
 		require.Error(t, err)
 		require.Contains(t, err.Error(), "last_order_id")
@@ -263,7 +286,7 @@ func TestOrders_Genesis_Valid(t *testing.T) {
 		id := types.NewIDFromUint64(2)
 
 		err := GenesisState{
-			Orders:      Orders{order, order2},
+			Orders:      NewStoredOrders(Orders{order, order2}),
 			LastOrderId: &id,
 		}.Validate(time.Now())
 
@@ -2,6 +2,7 @@ package types
 
 import (
 	"bytes"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -13,6 +14,16 @@ var (
 	KeyDelimiter = []byte(":")
 	OrderKeyPrefix = []byte("order")
 	LastOrderIDKey = []byte("last_order_id")
+	StopOrderKeyPrefix = []byte("stop_order")
+	ExpiryQueuePrefix = []byte("expiry_queue")
+)
+
+// Fill records storage keys.
+var (
+	FillRecordKeyPrefix       = []byte("fill_record")
+	LastFillRecordIDKey       = []byte("last_fill_record_id")
+	FillRecordOwnerIdxPrefix  = []byte("idx_fill_record_owner")
+	FillRecordMarketIdxPrefix = []byte("idx_fill_record_market")
 )
 
 // GetOrderKey returns storage key for order ID.
@@ -25,3 +36,246 @@ func GetOrderKey(id dnTypes.ID) []byte {
 		KeyDelimiter,
 	)
 }
+
+// GetStopOrderKey returns storage key for stop order ID.
+func GetStopOrderKey(id dnTypes.ID) []byte {
+	return bytes.Join(
+		[][]byte{
+			StopOrderKeyPrefix,
+			sdk.Uint64ToBigEndian(id.UInt64()),
+		},
+		KeyDelimiter,
+	)
+}
+
+// GetExpiryQueueKey returns order TTL expiration queue storage key.
+// Queue is shared by active and stop orders (order IDs are unique).
+func GetExpiryQueueKey(expiresAt time.Time, id dnTypes.ID) []byte {
+	return bytes.Join(
+		[][]byte{
+			ExpiryQueuePrefix,
+			sdk.FormatTimeBytes(expiresAt),
+			sdk.Uint64ToBigEndian(id.UInt64()),
+		},
+		KeyDelimiter,
+	)
+}
+
+// GetPrefixExpiryQueueKey returns order TTL expiration queue prefix key (used for iteration).
+func GetPrefixExpiryQueueKey(expiresAt time.Time) []byte {
+	return bytes.Join(
+		[][]byte{
+			ExpiryQueuePrefix,
+			sdk.FormatTimeBytes(expiresAt),
+		},
+		KeyDelimiter,
+	)
+}
+
+// GetFillRecordKey returns storage key for fill record ID.
+func GetFillRecordKey(id dnTypes.ID) []byte {
+	return bytes.Join(
+		[][]byte{
+			FillRecordKeyPrefix,
+			sdk.Uint64ToBigEndian(id.UInt64()),
+		},
+		KeyDelimiter,
+	)
+}
+
+// GetFillRecordOwnerPrefix returns fill record owner index prefix key (used for iteration).
+func GetFillRecordOwnerPrefix(owner sdk.AccAddress) []byte {
+	return bytes.Join(
+		[][]byte{
+			FillRecordOwnerIdxPrefix,
+			owner.Bytes(),
+			{},
+		},
+		KeyDelimiter,
+	)
+}
+
+// GetFillRecordOwnerKey returns fill record owner index storage key: {prefix}:{owner}:{ID}.
+func GetFillRecordOwnerKey(owner sdk.AccAddress, id dnTypes.ID) []byte {
+	return append(GetFillRecordOwnerPrefix(owner), sdk.Uint64ToBigEndian(id.UInt64())...)
+}
+
+// GetFillRecordMarketPrefix returns fill record market index prefix key (used for iteration).
+func GetFillRecordMarketPrefix(marketID dnTypes.ID) []byte {
+	return bytes.Join(
+		[][]byte{
+			FillRecordMarketIdxPrefix,
+			sdk.Uint64ToBigEndian(marketID.UInt64()),
+			{},
+		},
+		KeyDelimiter,
+	)
+}
+
+// GetFillRecordMarketKey returns fill record market index storage key: {prefix}:{marketID}:{ID}.
+func GetFillRecordMarketKey(marketID dnTypes.ID, id dnTypes.ID) []byte {
+	return append(GetFillRecordMarketPrefix(marketID), sdk.Uint64ToBigEndian(id.UInt64())...)
+}
+
+// Order secondary indexes storage key prefixes.
+// Active and stop orders are indexed separately, index value is the order ID.
+type OrderIndexPrefixes struct {
+	// Owner index: {prefix}:{owner}:{ID}
+	Owner []byte
+	// Owner and market index: {prefix}:{owner}:{marketID}:{ID}
+	OwnerMarket []byte
+	// Market index: {prefix}:{marketID}:{direction}:{price}:{ID}
+	Market []byte
+	// Good-till-block height index: {prefix}:{height}:{ID}
+	Height []byte
+	// Stop price index (stop orders only): {prefix}:{marketID}:{trigger}:{direction}:{stopPrice}:{ID}
+	StopPrice []byte
+}
+
+var (
+	OrderIndexes = OrderIndexPrefixes{
+		Owner:       []byte("idx_order_owner"),
+		OwnerMarket: []byte("idx_order_owner_market"),
+		Market:      []byte("idx_order_market"),
+		Height:      []byte("idx_order_height"),
+	}
+	StopOrderIndexes = OrderIndexPrefixes{
+		Owner:       []byte("idx_stop_order_owner"),
+		OwnerMarket: []byte("idx_stop_order_owner_market"),
+		Market:      []byte("idx_stop_order_market"),
+		Height:      []byte("idx_stop_order_height"),
+		StopPrice:   []byte("idx_stop_order_stop_price"),
+	}
+)
+
+// GetOwnerPrefix returns owner index prefix key (used for iteration).
+func (p OrderIndexPrefixes) GetOwnerPrefix(owner sdk.AccAddress) []byte {
+	return bytes.Join(
+		[][]byte{
+			p.Owner,
+			owner.Bytes(),
+			{},
+		},
+		KeyDelimiter,
+	)
+}
+
+// GetOwnerKey returns owner index storage key.
+func (p OrderIndexPrefixes) GetOwnerKey(owner sdk.AccAddress, id dnTypes.ID) []byte {
+	return append(p.GetOwnerPrefix(owner), sdk.Uint64ToBigEndian(id.UInt64())...)
+}
+
+// GetOwnerMarketPrefix returns owner and market index prefix key (used for iteration).
+func (p OrderIndexPrefixes) GetOwnerMarketPrefix(owner sdk.AccAddress, marketID dnTypes.ID) []byte {
+	return bytes.Join(
+		[][]byte{
+			p.OwnerMarket,
+			owner.Bytes(),
+			sdk.Uint64ToBigEndian(marketID.UInt64()),
+			{},
+		},
+		KeyDelimiter,
+	)
+}
+
+// GetOwnerMarketKey returns owner and market index storage key.
+func (p OrderIndexPrefixes) GetOwnerMarketKey(owner sdk.AccAddress, marketID dnTypes.ID, id dnTypes.ID) []byte {
+	return append(p.GetOwnerMarketPrefix(owner, marketID), sdk.Uint64ToBigEndian(id.UInt64())...)
+}
+
+// GetMarketPrefix returns market index prefix key (used for iteration).
+func (p OrderIndexPrefixes) GetMarketPrefix(marketID dnTypes.ID) []byte {
+	return bytes.Join(
+		[][]byte{
+			p.Market,
+			sdk.Uint64ToBigEndian(marketID.UInt64()),
+			{},
+		},
+		KeyDelimiter,
+	)
+}
+
+// GetMarketDirectionPrefix returns market and direction index prefix key (used for iteration).
+func (p OrderIndexPrefixes) GetMarketDirectionPrefix(marketID dnTypes.ID, direction Direction) []byte {
+	return bytes.Join(
+		[][]byte{
+			p.Market,
+			sdk.Uint64ToBigEndian(marketID.UInt64()),
+			[]byte(direction),
+			{},
+		},
+		KeyDelimiter,
+	)
+}
+
+// GetMarketKey returns market index storage key.
+// Price is stored as a fixed length big endian value, so keys are sorted by price within market and direction.
+func (p OrderIndexPrefixes) GetMarketKey(marketID dnTypes.ID, direction Direction, price sdk.Uint, id dnTypes.ID) []byte {
+	return bytes.Join(
+		[][]byte{
+			p.Market,
+			sdk.Uint64ToBigEndian(marketID.UInt64()),
+			[]byte(direction),
+			uintToBigEndian(price),
+			sdk.Uint64ToBigEndian(id.UInt64()),
+		},
+		KeyDelimiter,
+	)
+}
+
+// GetHeightPrefix returns good-till-block index prefix key for the {height}.
+func (p OrderIndexPrefixes) GetHeightPrefix(height int64) []byte {
+	return bytes.Join(
+		[][]byte{
+			p.Height,
+			sdk.Uint64ToBigEndian(uint64(height)),
+			{},
+		},
+		KeyDelimiter,
+	)
+}
+
+// GetHeightKey returns good-till-block index storage key.
+func (p OrderIndexPrefixes) GetHeightKey(height int64, id dnTypes.ID) []byte {
+	return append(p.GetHeightPrefix(height), sdk.Uint64ToBigEndian(id.UInt64())...)
+}
+
+// GetStopPricePrefix returns stop price index prefix key for the market, trigger source and direction (used for iteration).
+func (p OrderIndexPrefixes) GetStopPricePrefix(marketID dnTypes.ID, trigger StopTrigger, direction Direction) []byte {
+	return bytes.Join(
+		[][]byte{
+			p.StopPrice,
+			sdk.Uint64ToBigEndian(marketID.UInt64()),
+			[]byte(trigger),
+			[]byte(direction),
+			{},
+		},
+		KeyDelimiter,
+	)
+}
+
+// GetStopPriceBoundKey returns stop price index key without order ID (used as iteration range bound).
+func (p OrderIndexPrefixes) GetStopPriceBoundKey(marketID dnTypes.ID, trigger StopTrigger, direction Direction, stopPrice sdk.Uint) []byte {
+	return append(p.GetStopPricePrefix(marketID, trigger, direction), uintToBigEndian(stopPrice)...)
+}
+
+// GetStopPriceKey returns stop price index storage key.
+// Stop price is stored as a fixed length big endian value, so keys are sorted by stop price within market, trigger and direction.
+func (p OrderIndexPrefixes) GetStopPriceKey(marketID dnTypes.ID, trigger StopTrigger, direction Direction, stopPrice sdk.Uint, id dnTypes.ID) []byte {
+	return bytes.Join(
+		[][]byte{
+			p.GetStopPriceBoundKey(marketID, trigger, direction, stopPrice),
+			sdk.Uint64ToBigEndian(id.UInt64()),
+		},
+		KeyDelimiter,
+	)
+}
+
+// uintToBigEndian converts sdk.Uint to the 256 bits big endian byte slice.
+func uintToBigEndian(value sdk.Uint) []byte {
+	valueBz := value.BigInt().Bytes()
+	bz := make([]byte, 32)
+	copy(bz[len(bz)-len(valueBz):], valueBz)
+
+	return bz
+}
@@ -10,8 +10,15 @@ import (
 var (
 	_ sdk.Msg = MsgPostOrder{}
 	_ sdk.Msg = MsgRevokeOrder{}
+	_ sdk.Msg = MsgAmendOrder{}
+	_ sdk.Msg = MsgPostStopOrder{}
+	_ sdk.Msg = MsgBatchOrders{}
+	_ sdk.Msg = MsgRevokeAllOrders{}
 )
 
+// Max number of cancels and posts within a single MsgBatchOrders.
+const MaxBatchOrdersItems = 100
+
 // Client message to post an order object.
 type MsgPostOrder struct {
 	Owner     sdk.AccAddress    `json:"owner" yaml:"owner"`
@@ -20,6 +27,12 @@ type MsgPostOrder struct {
 	Price     sdk.Uint          `json:"price" yaml:"price"`
 	Quantity  sdk.Uint          `json:"quantity" yaml:"quantity"`
 	TtlInSec  uint64            `json:"ttl_in_sec" yaml:"ttl_in_sec"`
+	// Time-in-force policy, empty value is treated as gtc (TTL is not used for ioc/fok/gtb orders)
+	TimeInForce TimeInForce `json:"time_in_force,omitempty" yaml:"time_in_force,omitempty"`
+	// Block height order is auto-canceled after (gtb orders only)
+	GoodTillBlock int64 `json:"good_till_block,omitempty" yaml:"good_till_block,omitempty"`
+	// Self-trade prevention policy, empty value is treated as none
+	SelfTradePrevention SelfTradePrevention `json:"self_trade_prevention,omitempty" yaml:"self_trade_prevention,omitempty"`
 }
 
 // Implements sdk.Msg interface.
@@ -49,8 +62,31 @@ func (msg MsgPostOrder) ValidateBasic() error {
 	if msg.Quantity.IsZero() {
 		return ErrWrongQuantity
 	}
-	if msg.TtlInSec == 0 {
-		return ErrWrongTtl
+
+	timeInForce := NewTimeInForceRaw(msg.TimeInForce.String())
+	if !timeInForce.IsValid() {
+		return sdkErrors.Wrap(ErrWrongTimeInForce, msg.TimeInForce.String())
+	}
+	switch timeInForce {
+	case TimeInForceGTC:
+		if msg.TtlInSec == 0 {
+			return ErrWrongTtl
+		}
+		if msg.GoodTillBlock != 0 {
+			return sdkErrors.Wrap(ErrWrongGoodTillBlock, "should be 0 for non-gtb order")
+		}
+	case TimeInForceGTB:
+		if msg.GoodTillBlock <= 0 {
+			return sdkErrors.Wrap(ErrWrongGoodTillBlock, "should be GT 0")
+		}
+	default:
+		if msg.GoodTillBlock != 0 {
+			return sdkErrors.Wrap(ErrWrongGoodTillBlock, "should be 0 for non-gtb order")
+		}
+	}
+
+	if !NewSelfTradePreventionRaw(msg.SelfTradePrevention.String()).IsValid() {
+		return sdkErrors.Wrap(ErrWrongSelfTradePrevention, msg.SelfTradePrevention.String())
 	}
 
 	return nil
@@ -78,6 +114,24 @@ func NewMsgPost(owner sdk.AccAddress, assetCode dnTypes.AssetCode, direction Dir
 	}
 }
 
+// NewMsgPostWithTimeInForce creates MsgPostOrder message object with time-in-force policy.
+func NewMsgPostWithTimeInForce(
+	owner sdk.AccAddress,
+	assetCode dnTypes.AssetCode,
+	direction Direction,
+	price sdk.Uint,
+	quantity sdk.Uint,
+	ttlInSec uint64,
+	timeInForce TimeInForce,
+	goodTillBlock int64) MsgPostOrder {
+
+	msg := NewMsgPost(owner, assetCode, direction, price, quantity, ttlInSec)
+	msg.TimeInForce = timeInForce
+	msg.GoodTillBlock = goodTillBlock
+
+	return msg
+}
+
 // Client message to revoke an order.
 type MsgRevokeOrder struct {
 	Owner   sdk.AccAddress `json:"owner" yaml:"owner" `
@@ -123,3 +177,294 @@ func NewMsgRevokeOrder(owner sdk.AccAddress, id dnTypes.ID) MsgRevokeOrder {
 		OrderID: id,
 	}
 }
+
+// Client message to amend an order price and / or quantity.
+// Zero Price / Quantity field keeps the current order value.
+type MsgAmendOrder struct {
+	Owner    sdk.AccAddress `json:"owner" yaml:"owner"`
+	OrderID  dnTypes.ID     `json:"order_id" yaml:"order_id" example:"0" format:"string representation for big.Uint" swaggertype:"string"`
+	Price    sdk.Uint       `json:"price" yaml:"price"`
+	Quantity sdk.Uint       `json:"quantity" yaml:"quantity"`
+}
+
+// Implements sdk.Msg interface.
+func (msg MsgAmendOrder) Route() string {
+	return ModuleName
+}
+
+// Implements sdk.Msg interface.
+func (msg MsgAmendOrder) Type() string {
+	return "amend"
+}
+
+// Implements sdk.Msg interface.
+func (msg MsgAmendOrder) ValidateBasic() error {
+	if msg.Owner.Empty() {
+		return ErrWrongOwner
+	}
+	if err := msg.OrderID.Valid(); err != nil {
+		return sdkErrors.Wrap(ErrWrongOrderID, err.Error())
+	}
+	if msg.Price.IsZero() && msg.Quantity.IsZero() {
+		return sdkErrors.Wrap(ErrWrongAmend, "price and quantity are not set")
+	}
+
+	return nil
+}
+
+// Implements sdk.Msg interface.
+func (msg MsgAmendOrder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// Implements sdk.Msg interface.
+func (msg MsgAmendOrder) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// NewMsgAmendOrder creates MsgAmendOrder message object.
+func NewMsgAmendOrder(owner sdk.AccAddress, id dnTypes.ID, price sdk.Uint, quantity sdk.Uint) MsgAmendOrder {
+	return MsgAmendOrder{
+		Owner:    owner,
+		OrderID:  id,
+		Price:    price,
+		Quantity: quantity,
+	}
+}
+
+// Client message to post a stop order object (inactive until the trigger price is crossed).
+type MsgPostStopOrder struct {
+	Owner       sdk.AccAddress    `json:"owner" yaml:"owner"`
+	AssetCode   dnTypes.AssetCode `json:"asset_code" yaml:"asset_code"`
+	Direction   Direction         `json:"direction" yaml:"direction"`
+	Price       sdk.Uint          `json:"price" yaml:"price"`
+	Quantity    sdk.Uint          `json:"quantity" yaml:"quantity"`
+	TtlInSec    uint64            `json:"ttl_in_sec" yaml:"ttl_in_sec"`
+	StopPrice   sdk.Uint          `json:"stop_price" yaml:"stop_price"`
+	StopTrigger StopTrigger       `json:"stop_trigger" yaml:"stop_trigger"`
+}
+
+// Implements sdk.Msg interface.
+func (msg MsgPostStopOrder) Route() string {
+	return ModuleName
+}
+
+// Implements sdk.Msg interface.
+func (msg MsgPostStopOrder) Type() string {
+	return "post_stop"
+}
+
+// Implements sdk.Msg interface.
+func (msg MsgPostStopOrder) ValidateBasic() error {
+	if err := NewMsgPost(msg.Owner, msg.AssetCode, msg.Direction, msg.Price, msg.Quantity, msg.TtlInSec).ValidateBasic(); err != nil {
+		return err
+	}
+	if msg.StopPrice.IsZero() {
+		return ErrWrongStopPrice
+	}
+	if !msg.StopTrigger.IsValid() {
+		return sdkErrors.Wrap(ErrWrongStopTrigger, msg.StopTrigger.String())
+	}
+
+	return nil
+}
+
+// Implements sdk.Msg interface.
+func (msg MsgPostStopOrder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// Implements sdk.Msg interface.
+func (msg MsgPostStopOrder) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// NewMsgPostStopOrder creates MsgPostStopOrder message object.
+func NewMsgPostStopOrder(
+	owner sdk.AccAddress,
+	assetCode dnTypes.AssetCode,
+	direction Direction,
+	price sdk.Uint,
+	quantity sdk.Uint,
+	ttlInSec uint64,
+	stopPrice sdk.Uint,
+	stopTrigger StopTrigger) MsgPostStopOrder {
+
+	return MsgPostStopOrder{
+		Owner:       owner,
+		AssetCode:   assetCode,
+		Direction:   direction,
+		Price:       price,
+		Quantity:    quantity,
+		TtlInSec:    ttlInSec,
+		StopPrice:   stopPrice,
+		StopTrigger: stopTrigger,
+	}
+}
+
+// Batch order post request (MsgPostOrder without owner).
+type BatchOrderPost struct {
+	AssetCode dnTypes.AssetCode `json:"asset_code" yaml:"asset_code"`
+	Direction Direction         `json:"direction" yaml:"direction"`
+	Price     sdk.Uint          `json:"price" yaml:"price"`
+	Quantity  sdk.Uint          `json:"quantity" yaml:"quantity"`
+	TtlInSec  uint64            `json:"ttl_in_sec" yaml:"ttl_in_sec"`
+	// Time-in-force policy, empty value is treated as gtc (TTL is not used for ioc/fok/gtb orders)
+	TimeInForce TimeInForce `json:"time_in_force,omitempty" yaml:"time_in_force,omitempty"`
+	// Block height order is auto-canceled after (gtb orders only)
+	GoodTillBlock int64 `json:"good_till_block,omitempty" yaml:"good_till_block,omitempty"`
+	// Self-trade prevention policy, empty value is treated as none
+	SelfTradePrevention SelfTradePrevention `json:"self_trade_prevention,omitempty" yaml:"self_trade_prevention,omitempty"`
+}
+
+// ToMsgPost converts batch post request to MsgPostOrder for the owner.
+func (p BatchOrderPost) ToMsgPost(owner sdk.AccAddress) MsgPostOrder {
+	return MsgPostOrder{
+		Owner:               owner,
+		AssetCode:           p.AssetCode,
+		Direction:           p.Direction,
+		Price:               p.Price,
+		Quantity:            p.Quantity,
+		TtlInSec:            p.TtlInSec,
+		TimeInForce:         p.TimeInForce,
+		GoodTillBlock:       p.GoodTillBlock,
+		SelfTradePrevention: p.SelfTradePrevention,
+	}
+}
+
+// Client message to revoke and post orders atomically (cancels are processed before posts).
+type MsgBatchOrders struct {
+	Owner sdk.AccAddress `json:"owner" yaml:"owner"`
+	// Order IDs to revoke
+	Cancels []dnTypes.ID `json:"cancels" yaml:"cancels" swaggertype:"array,string"`
+	// Orders to post
+	Posts []BatchOrderPost `json:"posts" yaml:"posts"`
+}
+
+// Implements sdk.Msg interface.
+func (msg MsgBatchOrders) Route() string {
+	return ModuleName
+}
+
+// Implements sdk.Msg interface.
+func (msg MsgBatchOrders) Type() string {
+	return "batch"
+}
+
+// Implements sdk.Msg interface.
+func (msg MsgBatchOrders) ValidateBasic() error {
+	if msg.Owner.Empty() {
+		return ErrWrongOwner
+	}
+
+	itemsCnt := len(msg.Cancels) + len(msg.Posts)
+	if itemsCnt == 0 {
+		return sdkErrors.Wrap(ErrWrongBatch, "empty")
+	}
+	if itemsCnt > MaxBatchOrdersItems {
+		return sdkErrors.Wrapf(ErrWrongBatch, "cancels and posts count should be LTE %d", MaxBatchOrdersItems)
+	}
+
+	cancelIDs := make(map[string]bool, len(msg.Cancels))
+	for i, id := range msg.Cancels {
+		if err := id.Valid(); err != nil {
+			return sdkErrors.Wrapf(ErrWrongOrderID, "cancels[%d]: %v", i, err)
+		}
+		if cancelIDs[id.String()] {
+			return sdkErrors.Wrapf(ErrWrongBatch, "cancels[%d]: duplicated order ID %s", i, id)
+		}
+		cancelIDs[id.String()] = true
+	}
+
+	for i, post := range msg.Posts {
+		if err := post.ToMsgPost(msg.Owner).ValidateBasic(); err != nil {
+			return sdkErrors.Wrapf(err, "posts[%d]", i)
+		}
+	}
+
+	return nil
+}
+
+// Implements sdk.Msg interface.
+func (msg MsgBatchOrders) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// Implements sdk.Msg interface.
+func (msg MsgBatchOrders) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// NewMsgBatchOrders creates MsgBatchOrders message object.
+func NewMsgBatchOrders(owner sdk.AccAddress, cancels []dnTypes.ID, posts []BatchOrderPost) MsgBatchOrders {
+	return MsgBatchOrders{
+		Owner:   owner,
+		Cancels: cancels,
+		Posts:   posts,
+	}
+}
+
+// Client message to revoke all open (active and stop) orders of the owner with optional filters.
+type MsgRevokeAllOrders struct {
+	Owner sdk.AccAddress `json:"owner" yaml:"owner"`
+	// Market ID filter (optional, all markets if empty)
+	MarketID string `json:"market_id,omitempty" yaml:"market_id,omitempty" example:"0"`
+	// Order type filter (optional, bid and ask if empty)
+	Direction Direction `json:"direction,omitempty" yaml:"direction,omitempty" example:"bid"`
+}
+
+// MarketIDFilter check if MarketID filter is enabled.
+func (msg MsgRevokeAllOrders) MarketIDFilter() bool {
+	return msg.MarketID != ""
+}
+
+// DirectionFilter check if Direction filter is enabled.
+func (msg MsgRevokeAllOrders) DirectionFilter() bool {
+	return msg.Direction != ""
+}
+
+// Implements sdk.Msg interface.
+func (msg MsgRevokeAllOrders) Route() string {
+	return ModuleName
+}
+
+// Implements sdk.Msg interface.
+func (msg MsgRevokeAllOrders) Type() string {
+	return "cancel_all"
+}
+
+// Implements sdk.Msg interface.
+func (msg MsgRevokeAllOrders) ValidateBasic() error {
+	if msg.Owner.Empty() {
+		return ErrWrongOwner
+	}
+	if msg.MarketIDFilter() {
+		if _, err := dnTypes.NewIDFromString(msg.MarketID); err != nil {
+			return sdkErrors.Wrap(ErrWrongMarketID, err.Error())
+		}
+	}
+	if msg.DirectionFilter() && !msg.Direction.IsValid() {
+		return ErrWrongDirection
+	}
+
+	return nil
+}
+
+// Implements sdk.Msg interface.
+func (msg MsgRevokeAllOrders) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// Implements sdk.Msg interface.
+func (msg MsgRevokeAllOrders) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// NewMsgRevokeAllOrders creates MsgRevokeAllOrders message object.
+func NewMsgRevokeAllOrders(owner sdk.AccAddress, marketID string, direction Direction) MsgRevokeAllOrders {
+	return MsgRevokeAllOrders{
+		Owner:     owner,
+		MarketID:  marketID,
+		Direction: direction,
+	}
+}
@@ -62,3 +62,166 @@ func TestOrders_RevokeOrderMsg_Invalid(t *testing.T) {
 	// orderID
 	require.Error(t, NewMsgRevokeOrder(ownerAddr, dnTypes.ID{}).ValidateBasic())
 }
+
+func TestOrders_AmendOrderMsg_Valid(t *testing.T) {
+	ownerAddr := sdk.AccAddress("wallet13jyjuz3kkdvqw8u4qfkwd94emdl3vx394kn07h")
+	orderID := dnTypes.NewIDFromUint64(0)
+
+	require.NoError(t, NewMsgAmendOrder(ownerAddr, orderID, sdk.OneUint(), sdk.OneUint()).ValidateBasic())
+	require.NoError(t, NewMsgAmendOrder(ownerAddr, orderID, sdk.ZeroUint(), sdk.OneUint()).ValidateBasic())
+	require.NoError(t, NewMsgAmendOrder(ownerAddr, orderID, sdk.OneUint(), sdk.ZeroUint()).ValidateBasic())
+
+	// owner
+	require.Error(t, NewMsgAmendOrder(sdk.AccAddress{}, orderID, sdk.OneUint(), sdk.OneUint()).ValidateBasic())
+	// orderID
+	require.Error(t, NewMsgAmendOrder(ownerAddr, dnTypes.ID{}, sdk.OneUint(), sdk.OneUint()).ValidateBasic())
+	// nothing to amend
+	require.Error(t, NewMsgAmendOrder(ownerAddr, orderID, sdk.ZeroUint(), sdk.ZeroUint()).ValidateBasic())
+}
+
+func TestOrders_PostOrderMsg_TimeInForce(t *testing.T) {
+	ownerAddr := sdk.AccAddress("wallet13jyjuz3kkdvqw8u4qfkwd94emdl3vx394kn07h")
+	assetCode := dnTypes.AssetCode("btc_xfi")
+	price := sdk.OneUint()
+	quantity := sdk.OneUint()
+
+	// ok
+	require.NoError(t, NewMsgPostWithTimeInForce(ownerAddr, assetCode, Bid, price, quantity, 60, TimeInForceGTC, 0).ValidateBasic())
+	require.NoError(t, NewMsgPostWithTimeInForce(ownerAddr, assetCode, Bid, price, quantity, 0, TimeInForceIOC, 0).ValidateBasic())
+	require.NoError(t, NewMsgPostWithTimeInForce(ownerAddr, assetCode, Bid, price, quantity, 0, TimeInForceFOK, 0).ValidateBasic())
+	require.NoError(t, NewMsgPostWithTimeInForce(ownerAddr, assetCode, Bid, price, quantity, 0, TimeInForceGTB, 100).ValidateBasic())
+
+	// unknown policy
+	{
+		err := NewMsgPostWithTimeInForce(ownerAddr, assetCode, Bid, price, quantity, 60, TimeInForce("foo"), 0).ValidateBasic()
+		require.True(t, ErrWrongTimeInForce.Is(err))
+	}
+
+	// gtc without ttl
+	{
+		err := NewMsgPostWithTimeInForce(ownerAddr, assetCode, Bid, price, quantity, 0, TimeInForceGTC, 0).ValidateBasic()
+		require.True(t, ErrWrongTtl.Is(err))
+	}
+
+	// gtb without height
+	{
+		err := NewMsgPostWithTimeInForce(ownerAddr, assetCode, Bid, price, quantity, 0, TimeInForceGTB, 0).ValidateBasic()
+		require.True(t, ErrWrongGoodTillBlock.Is(err))
+	}
+
+	// height for non-gtb order
+	{
+		err := NewMsgPostWithTimeInForce(ownerAddr, assetCode, Bid, price, quantity, 0, TimeInForceIOC, 100).ValidateBasic()
+		require.True(t, ErrWrongGoodTillBlock.Is(err))
+	}
+
+	// self-trade prevention policy
+	{
+		msg := NewMsgPostWithTimeInForce(ownerAddr, assetCode, Bid, price, quantity, 60, TimeInForceGTC, 0)
+		msg.SelfTradePrevention = SelfTradePreventionCancelOldest
+		require.NoError(t, msg.ValidateBasic())
+
+		msg.SelfTradePrevention = SelfTradePrevention("foo")
+		require.True(t, ErrWrongSelfTradePrevention.Is(msg.ValidateBasic()))
+	}
+}
+
+func TestOrders_PostStopOrderMsg(t *testing.T) {
+	ownerAddr := sdk.AccAddress("wallet13jyjuz3kkdvqw8u4qfkwd94emdl3vx394kn07h")
+	assetCode := dnTypes.AssetCode("btc_xfi")
+	price := sdk.OneUint()
+	quantity := sdk.OneUint()
+
+	// ok
+	require.NoError(t, NewMsgPostStopOrder(ownerAddr, assetCode, Bid, price, quantity, 60, sdk.OneUint(), StopTriggerClearance).ValidateBasic())
+	require.NoError(t, NewMsgPostStopOrder(ownerAddr, assetCode, Ask, price, quantity, 60, sdk.OneUint(), StopTriggerOracle).ValidateBasic())
+
+	// order params
+	require.Error(t, NewMsgPostStopOrder(ownerAddr, assetCode, Bid, sdk.ZeroUint(), quantity, 60, sdk.OneUint(), StopTriggerClearance).ValidateBasic())
+	require.Error(t, NewMsgPostStopOrder(ownerAddr, assetCode, Bid, price, quantity, 0, sdk.OneUint(), StopTriggerClearance).ValidateBasic())
+
+	// stop price
+	{
+		err := NewMsgPostStopOrder(ownerAddr, assetCode, Bid, price, quantity, 60, sdk.ZeroUint(), StopTriggerClearance).ValidateBasic()
+		require.True(t, ErrWrongStopPrice.Is(err))
+	}
+
+	// stop trigger
+	{
+		err := NewMsgPostStopOrder(ownerAddr, assetCode, Bid, price, quantity, 60, sdk.OneUint(), StopTrigger("foo")).ValidateBasic()
+		require.True(t, ErrWrongStopTrigger.Is(err))
+	}
+}
+
+func TestOrders_BatchOrdersMsg(t *testing.T) {
+	ownerAddr := sdk.AccAddress("wallet13jyjuz3kkdvqw8u4qfkwd94emdl3vx394kn07h")
+	post := BatchOrderPost{
+		AssetCode: dnTypes.AssetCode("btc_xfi"),
+		Direction: Bid,
+		Price:     sdk.OneUint(),
+		Quantity:  sdk.OneUint(),
+		TtlInSec:  60,
+	}
+	cancels := []dnTypes.ID{dnTypes.NewIDFromUint64(0), dnTypes.NewIDFromUint64(1)}
+
+	// ok
+	require.NoError(t, NewMsgBatchOrders(ownerAddr, cancels, []BatchOrderPost{post}).ValidateBasic())
+	require.NoError(t, NewMsgBatchOrders(ownerAddr, cancels, nil).ValidateBasic())
+	require.NoError(t, NewMsgBatchOrders(ownerAddr, nil, []BatchOrderPost{post, post}).ValidateBasic())
+
+	// owner
+	require.True(t, ErrWrongOwner.Is(NewMsgBatchOrders(sdk.AccAddress{}, cancels, nil).ValidateBasic()))
+
+	// empty batch
+	require.True(t, ErrWrongBatch.Is(NewMsgBatchOrders(ownerAddr, nil, nil).ValidateBasic()))
+
+	// too many items
+	{
+		posts := make([]BatchOrderPost, MaxBatchOrdersItems)
+		for i := range posts {
+			posts[i] = post
+		}
+		require.NoError(t, NewMsgBatchOrders(ownerAddr, nil, posts).ValidateBasic())
+		require.True(t, ErrWrongBatch.Is(NewMsgBatchOrders(ownerAddr, cancels, posts).ValidateBasic()))
+	}
+
+	// cancels
+	{
+		err := NewMsgBatchOrders(ownerAddr, []dnTypes.ID{cancels[0], cancels[1], cancels[0]}, nil).ValidateBasic()
+		require.True(t, ErrWrongBatch.Is(err))
+
+		err = NewMsgBatchOrders(ownerAddr, []dnTypes.ID{{}}, nil).ValidateBasic()
+		require.True(t, ErrWrongOrderID.Is(err))
+	}
+
+	// posts
+	{
+		invalidPost := post
+		invalidPost.Price = sdk.ZeroUint()
+		require.Error(t, NewMsgBatchOrders(ownerAddr, cancels, []BatchOrderPost{post, invalidPost}).ValidateBasic())
+
+		invalidPost = post
+		invalidPost.TimeInForce = TimeInForce("foo")
+		require.True(t, ErrWrongTimeInForce.Is(NewMsgBatchOrders(ownerAddr, nil, []BatchOrderPost{invalidPost}).ValidateBasic()))
+	}
+}
+
+func TestOrders_RevokeAllOrdersMsg(t *testing.T) {
+	ownerAddr := sdk.AccAddress("wallet13jyjuz3kkdvqw8u4qfkwd94emdl3vx394kn07h")
+
+	// ok
+	require.NoError(t, NewMsgRevokeAllOrders(ownerAddr, "", "").ValidateBasic())
+	require.NoError(t, NewMsgRevokeAllOrders(ownerAddr, "0", "").ValidateBasic())
+	require.NoError(t, NewMsgRevokeAllOrders(ownerAddr, "", Ask).ValidateBasic())
+	require.NoError(t, NewMsgRevokeAllOrders(ownerAddr, "1", Bid).ValidateBasic())
+
+	// owner
+	require.True(t, ErrWrongOwner.Is(NewMsgRevokeAllOrders(sdk.AccAddress{}, "", "").ValidateBasic()))
+
+	// market ID
+	require.True(t, ErrWrongMarketID.Is(NewMsgRevokeAllOrders(ownerAddr, "abc", "").ValidateBasic()))
+	require.True(t, ErrWrongMarketID.Is(NewMsgRevokeAllOrders(ownerAddr, "-1", "").ValidateBasic()))
+
+	// direction
+	require.True(t, ErrWrongDirection.Is(NewMsgRevokeAllOrders(ownerAddr, "", Direction("foo")).ValidateBasic()))
+}
@@ -3,6 +3,8 @@ package types
 import (
 	"bytes"
 	"fmt"
+	"reflect"
+	"strconv"
 	"strings"
 	"time"
 
@@ -34,50 +36,98 @@ type Order struct {
 	CreatedAt time.Time `json:"created_at" yaml:"created_at" format:"RFC 3339" example:"2020-03-27T13:45:15.293426Z"`
 	// Updated timestamp
 	UpdatedAt time.Time `json:"updated_at" yaml:"updated_at" format:"RFC 3339" example:"2020-03-27T13:45:15.293426Z"`
+	// Matching priority (order IDs sequence number, lower value is matched first)
+	// Equals to ID on creation, changed to a new sequence number on amend that loses queue priority
+	PriorityID dnTypes.ID `json:"priority_id" yaml:"priority_id" example:"0" format:"string representation for big.Uint" swaggertype:"string"`
+	// Time-in-force policy (gtc/ioc/fok/gtb), empty value is treated as gtc
+	TimeInForce TimeInForce `json:"time_in_force" yaml:"time_in_force" swaggertype:"string" example:"gtc"`
+	// Block height order is auto-canceled after (good-till-block orders only)
+	GoodTillBlock int64 `json:"good_till_block" yaml:"good_till_block" example:"100"`
+	// Stop order trigger price (stop orders only)
+	StopPrice sdk.Uint `json:"stop_price" yaml:"stop_price" swaggertype:"string" example:"100"`
+	// Stop order trigger price source (clearance/oracle), empty for regular orders
+	StopTrigger StopTrigger `json:"stop_trigger" yaml:"stop_trigger" swaggertype:"string" example:"oracle"`
+	// Block height stop order was triggered at (order joins the next block matching as a taker), zero if not triggered
+	TriggeredAt int64 `json:"triggered_at" yaml:"triggered_at" example:"100"`
+	// Self-trade prevention policy (none/cancel_newest/cancel_oldest/decrement_both), empty value is treated as none
+	SelfTradePrevention SelfTradePrevention `json:"self_trade_prevention" yaml:"self_trade_prevention" swaggertype:"string" example:"cancel_newest"`
+	// Anti-spam deposit locked with the order, empty if deposit is disabled or already refunded (order was filled)
+	Deposit sdk.Coins `json:"deposit" yaml:"deposit" swaggertype:"string" example:"100xfi"`
 }
 
-// Valid checks that Order is valid (used for genesis ops).
+// Valid checks that Order is valid.
 func (o Order) Valid() error {
-	if err := o.ID.Valid(); err != nil {
-		return fmt.Errorf("id: %w", err)
-	}
-	if o.Owner.Empty() {
-		return fmt.Errorf("owner: empty")
-	}
-	if err := sdk.VerifyAddressFormat(o.Owner); err != nil {
-		return fmt.Errorf("owner address format is wrong: %w", err)
+	if err := NewStoredOrder(o).Valid(); err != nil {
+		return err
 	}
 	if err := o.Market.Valid(); err != nil {
 		return fmt.Errorf("market: %w", err)
 	}
-	if !o.Direction.IsValid() {
-		return fmt.Errorf("direction: invalid")
-	}
-	if o.Price.IsZero() {
-		return fmt.Errorf("price: is zero")
-	}
-	if o.Quantity.IsZero() {
-		return fmt.Errorf("quantity: is zero")
-	}
-	if o.CreatedAt.After(o.UpdatedAt) {
-		return fmt.Errorf("wrong create and update dates: create date later than update date")
+
+	return nil
+}
+
+// GetPriorityID returns order matching priority (ID is used for orders without priority set).
+func (o Order) GetPriorityID() dnTypes.ID {
+	if o.PriorityID.Valid() != nil {
+		return o.ID
 	}
-	if o.CreatedAt.IsZero() {
-		return fmt.Errorf("created_at: is zero")
+
+	return o.PriorityID
+}
+
+// GetTimeInForce returns order time-in-force policy (GTC is used for orders without policy set).
+func (o Order) GetTimeInForce() TimeInForce {
+	return NewTimeInForceRaw(o.TimeInForce.String())
+}
+
+// GetSelfTradePrevention returns order self-trade prevention policy (none is used for orders without policy set).
+func (o Order) GetSelfTradePrevention() SelfTradePrevention {
+	return NewSelfTradePreventionRaw(o.SelfTradePrevention.String())
+}
+
+// IsStop checks if order is a stop order (order stays inactive until the trigger price is crossed).
+func (o Order) IsStop() bool {
+	return o.StopTrigger != ""
+}
+
+// GetStopPrice returns stop order trigger price (zero for regular orders).
+func (o Order) GetStopPrice() sdk.Uint {
+	if reflect.DeepEqual(o.StopPrice, sdk.Uint{}) {
+		return sdk.ZeroUint()
 	}
-	if o.CreatedAt.After(time.Now()) {
-		return fmt.Errorf("created_at: is future date")
+
+	return o.StopPrice
+}
+
+// IsStopTriggered checks if stop order trigger price is crossed by the price.
+// Bid (buy stop) is triggered when price goes up to the stop price, ask (sell stop) - when price goes down.
+func (o Order) IsStopTriggered(price sdk.Uint) bool {
+	if !o.IsStop() || price.IsZero() {
+		return false
 	}
-	if o.UpdatedAt.IsZero() {
-		return fmt.Errorf("updated_at: is zero")
+
+	switch o.Direction {
+	case Bid:
+		return price.GTE(o.GetStopPrice())
+	case Ask:
+		return price.LTE(o.GetStopPrice())
 	}
-	if o.UpdatedAt.After(time.Now()) {
-		return fmt.Errorf("updated_at: is future date")
+
+	return false
+}
+
+// IsMaker checks if order was resting in the book before the matching block (order is a taker otherwise).
+// Triggered stop order is a taker in the block following the trigger one as it takes liquidity on activation.
+func (o Order) IsMaker(blockTime time.Time, blockHeight int64) bool {
+	if o.TriggeredAt > 0 && blockHeight <= o.TriggeredAt+1 {
+		return false
 	}
-	return nil
+
+	return o.CreatedAt.Before(blockTime)
 }
 
-// ValidatePriceQuantity compares price and quantity to min currency values.
+// ValidatePriceQuantity compares price and quantity to min currency values and market limits.
 func (o Order) ValidatePriceQuantity() error {
 	minQuotePrice := o.Market.QuoteCurrency.MinDecimal()
 	quotePrice := o.Market.QuoteCurrency.UintToDec(o.Price)
@@ -91,6 +141,9 @@ func (o Order) ValidatePriceQuantity() error {
 	if baseQuantity.LT(minBaseQuantity) {
 		return sdkErrors.Wrapf(ErrWrongQuantity, "should be GTE than %s", minBaseQuantity.String())
 	}
+	if err := o.Market.CheckPriceQuantity(o.Price, o.Quantity); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -137,6 +190,22 @@ func (o Order) String() string {
 	b.WriteString(fmt.Sprintf("  Ttl:       %s\n", o.Ttl.String()))
 	b.WriteString(fmt.Sprintf("  CreatedAt: %s\n", o.CreatedAt.String()))
 	b.WriteString(fmt.Sprintf("  UpdatedAt: %s\n", o.UpdatedAt.String()))
+	b.WriteString(fmt.Sprintf("  Priority:  %s\n", o.GetPriorityID().String()))
+	b.WriteString(fmt.Sprintf("  TIF:       %s\n", o.GetTimeInForce().String()))
+	if o.GetTimeInForce() == TimeInForceGTB {
+		b.WriteString(fmt.Sprintf("  TillBlock: %d\n", o.GoodTillBlock))
+	}
+	b.WriteString(fmt.Sprintf("  STP:       %s\n", o.GetSelfTradePrevention().String()))
+	if !o.Deposit.Empty() {
+		b.WriteString(fmt.Sprintf("  Deposit:   %s\n", o.Deposit.String()))
+	}
+	if o.IsStop() {
+		b.WriteString(fmt.Sprintf("  StopPrice: %s\n", o.GetStopPrice().String()))
+		b.WriteString(fmt.Sprintf("  Trigger:   %s\n", o.StopTrigger.String()))
+		if o.TriggeredAt > 0 {
+			b.WriteString(fmt.Sprintf("  Triggered: %d\n", o.TriggeredAt))
+		}
+	}
 	b.WriteString(o.Market.String())
 
 	return b.String()
@@ -153,6 +222,13 @@ func (o Order) TableHeaders() []string {
 		"O.TTL",
 		"O.CreatedAt",
 		"O.UpdatedAt",
+		"O.PriorityID",
+		"O.TimeInForce",
+		"O.GoodTillBlock",
+		"O.StopPrice",
+		"O.StopTrigger",
+		"O.SelfTradePrevention",
+		"O.Deposit",
 	}
 
 	return append(h, o.Market.TableHeaders()...)
@@ -174,6 +250,13 @@ func (o Order) TableValues() []string {
 	v = append(v, o.Ttl.String())
 	v = append(v, o.CreatedAt.String())
 	v = append(v, o.UpdatedAt.String())
+	v = append(v, o.GetPriorityID().String())
+	v = append(v, o.GetTimeInForce().String())
+	v = append(v, strconv.FormatInt(o.GoodTillBlock, 10))
+	v = append(v, o.GetStopPrice().String())
+	v = append(v, o.StopTrigger.String())
+	v = append(v, o.GetSelfTradePrevention().String())
+	v = append(v, o.Deposit.String())
 
 	return append(v, o.Market.TableValues()...)
 }
@@ -190,15 +273,16 @@ func NewOrder(
 	ttlInSec uint64) Order {
 
 	return Order{
-		ID:        id,
-		Owner:     owner,
-		Market:    market,
-		Direction: direction,
-		Price:     price,
-		Quantity:  quantity,
-		Ttl:       time.Duration(ttlInSec) * time.Second,
-		CreatedAt: ctx.BlockTime(),
-		UpdatedAt: ctx.BlockTime(),
+		ID:         id,
+		Owner:      owner,
+		Market:     market,
+		Direction:  direction,
+		Price:      price,
+		Quantity:   quantity,
+		Ttl:        time.Duration(ttlInSec) * time.Second,
+		CreatedAt:  ctx.BlockTime(),
+		UpdatedAt:  ctx.BlockTime(),
+		PriorityID: id,
 	}
 }
 
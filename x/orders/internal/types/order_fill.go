@@ -45,6 +45,14 @@ func (f OrderFill) FillCoin() (retCoin sdk.Coin, retErr error) {
 	return
 }
 
+// FeeCoin returns fee Coin taken from the fill coin (transferred to the fee collector instead of the Account).
+// Fee amount is truncated, so the fee never exceeds the fill coin amount for rates LT 1.0.
+func (f OrderFill) FeeCoin(fillCoin sdk.Coin, feeRate sdk.Dec) sdk.Coin {
+	feeAmount := fillCoin.Amount.ToDec().Mul(feeRate).TruncateInt()
+
+	return sdk.NewCoin(fillCoin.Denom, feeAmount)
+}
+
 // RefundCoin returns Coin that should be refunded (transferred from Bank to Account).
 // Coin denom and quantity is Market and Order type specific.
 //   (doRefund: true, retCoin: not nil) - refund should be done and a proper refund coin was generated;
@@ -71,6 +79,46 @@ func (f OrderFill) RefundCoin() (doRefund bool, retCoin *sdk.Coin, retErr error)
 	return
 }
 
+// SpentCoin returns order lock Coin part that is spent by the fill (transferred from Module to Bank).
+// Coin is a lock coin difference for the order quantity before and after the fill, so the lock coin
+// of the order leftover always matches funds left in the module account.
+// Leftover which lock coin can't be computed (quote quantity is too small) is treated as a zero lock:
+//   whole lock coin is spent and dustCoin (lock coin part exceeding the filled quantity lock) should be
+//   returned to the owner, order leftover can't be kept active (dustCoin is not nil).
+func (f OrderFill) SpentCoin() (retCoin sdk.Coin, dustCoin *sdk.Coin, retErr error) {
+	orderBefore, orderAfter := f.Order, f.Order
+	orderBefore.Quantity = f.QuantityFilled.Add(f.QuantityUnfilled)
+	orderAfter.Quantity = f.QuantityUnfilled
+
+	coinBefore, err := orderBefore.LockCoin()
+	if err != nil {
+		retErr = err
+		return
+	}
+	if orderAfter.Quantity.IsZero() {
+		retCoin = coinBefore
+		return
+	}
+	coinAfter, err := orderAfter.LockCoin()
+	if err != nil {
+		retCoin = coinBefore
+
+		coin := sdk.NewCoin(coinBefore.Denom, sdk.ZeroInt())
+		orderFilled := f.Order
+		orderFilled.Quantity = f.QuantityFilled
+		if coinFilled, err := orderFilled.LockCoin(); err == nil && coinBefore.IsGTE(coinFilled) {
+			coin = coinBefore.Sub(coinFilled)
+		}
+		dustCoin = &coin
+
+		return
+	}
+
+	retCoin = coinBefore.Sub(coinAfter)
+
+	return
+}
+
 // Strings returns multi-line text object representation.
 func (f OrderFill) String() string {
 	b := strings.Builder{}
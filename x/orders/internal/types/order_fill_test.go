@@ -117,3 +117,73 @@ func TestOrders_OrderFill_RefundCoin(t *testing.T) {
 		require.Nil(t, coin)
 	}
 }
+
+func TestOrders_OrderFill_SpentCoin(t *testing.T) {
+	fill := newMockOrderFill()
+
+	// partial fill: lock coin difference
+	for _, direction := range []Direction{Bid, Ask} {
+		partialFill := fill
+		partialFill.Order.Direction = direction
+		partialFill.Order.Quantity = fill.QuantityFilled.Add(fill.QuantityUnfilled)
+
+		coin, dustCoin, err := partialFill.SpentCoin()
+		require.NoError(t, err)
+		require.Nil(t, dustCoin)
+
+		lockCoinBefore, err := partialFill.Order.LockCoin()
+		require.NoError(t, err)
+		leftoverOrder := partialFill.Order
+		leftoverOrder.Quantity = partialFill.QuantityUnfilled
+		lockCoinAfter, err := leftoverOrder.LockCoin()
+		require.NoError(t, err)
+
+		require.True(t, coin.IsEqual(lockCoinBefore.Sub(lockCoinAfter)))
+	}
+
+	// full fill: the whole lock coin
+	for _, direction := range []Direction{Bid, Ask} {
+		fullFill := fill
+		fullFill.Order.Direction = direction
+		fullFill.Order.Quantity = fill.QuantityFilled
+		fullFill.QuantityUnfilled = sdk.ZeroUint()
+
+		coin, dustCoin, err := fullFill.SpentCoin()
+		require.NoError(t, err)
+		require.Nil(t, dustCoin)
+
+		lockCoin, err := fullFill.Order.LockCoin()
+		require.NoError(t, err)
+		require.True(t, coin.IsEqual(lockCoin))
+	}
+
+	// partial bid fill: leftover lock coin is too small, leftover is treated as a zero lock
+	{
+		dustFill := fill
+		dustFill.Order.Market.BaseCurrency.Decimals = 18
+		dustFill.Order.Market.QuoteCurrency.Decimals = 2
+		dustFill.Order.Price = sdk.NewUint(100)
+		dustFill.QuantityFilled = sdk.NewUintFromString("999000000000000000")
+		dustFill.QuantityUnfilled = sdk.NewUintFromString("1000000000000000")
+		dustFill.Order.Quantity = dustFill.QuantityFilled.Add(dustFill.QuantityUnfilled)
+
+		leftoverOrder := dustFill.Order
+		leftoverOrder.Quantity = dustFill.QuantityUnfilled
+		_, err := leftoverOrder.LockCoin()
+		require.Error(t, err)
+
+		coin, dustCoin, err := dustFill.SpentCoin()
+		require.NoError(t, err)
+		require.Equal(t, "100xfi", coin.String())
+		require.NotNil(t, dustCoin)
+		require.Equal(t, "1xfi", dustCoin.String())
+	}
+
+	// unsupported type
+	{
+		failFill := fill
+		failFill.Order.Direction = ""
+		_, _, err := failFill.SpentCoin()
+		require.Error(t, err)
+	}
+}
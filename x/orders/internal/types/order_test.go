@@ -40,6 +40,82 @@ func NewMockOrder() Order {
 	}
 }
 
+func TestOrders_Order_Valid(t *testing.T) {
+	// ok
+	{
+		order := NewMockOrder()
+		require.NoError(t, order.Valid())
+		require.NoError(t, NewStoredOrder(order).Valid())
+	}
+
+	// empty market BaseCurrency Denom
+	{
+		order := NewMockOrder()
+		order.Market.BaseCurrency.Denom = ""
+		err := order.Valid()
+
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "market")
+		require.Contains(t, err.Error(), "denom")
+		require.Contains(t, err.Error(), "base")
+		require.Contains(t, err.Error(), "empty")
+	}
+
+	// wrong market BaseCurrency Denom
+	{
+		order := NewMockOrder()
+		order.Market.BaseCurrency.Denom = "wrong_denom"
+		err := order.Valid()
+
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "market")
+		require.Contains(t, err.Error(), "denom")
+		require.Contains(t, err.Error(), "base_currency")
+		require.Contains(t, err.Error(), "invalid")
+	}
+
+	// empty market QuoteCurrency Denom
+	{
+		order := NewMockOrder()
+		order.Market.QuoteCurrency.Denom = ""
+		err := order.Valid()
+
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "market")
+		require.Contains(t, err.Error(), "denom")
+		require.Contains(t, err.Error(), "quote")
+		require.Contains(t, err.Error(), "empty")
+	}
+
+	// wrong market QuoteCurrency Denom
+	{
+		order := NewMockOrder()
+		order.Market.QuoteCurrency.Denom = "wrong_denom"
+		err := order.Valid()
+
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "market")
+		require.Contains(t, err.Error(), "denom")
+		require.Contains(t, err.Error(), "quote_currency")
+		require.Contains(t, err.Error(), "invalid")
+	}
+}
+
+func TestOrders_StoredOrder(t *testing.T) {
+	order := NewMockOrder()
+	order.PriorityID = dnTypes.NewIDFromUint64(1)
+	order.StopPrice, order.StopTrigger = sdk.NewUint(10), StopTriggerClearance
+	order.Deposit = sdk.NewCoins(sdk.NewCoin("xfi", sdk.OneInt()))
+
+	storedOrder := NewStoredOrder(order)
+	require.True(t, storedOrder.MarketID.Equal(order.Market.ID))
+	require.Equal(t, order, storedOrder.ToOrder(order.Market))
+
+	// invalid market ID
+	storedOrder.MarketID = dnTypes.ID{}
+	require.Error(t, storedOrder.Valid())
+}
+
 func TestOrders_Order_ValidatePriceQuantity(t *testing.T) {
 	orderOk := NewMockOrder()
 
@@ -59,6 +135,36 @@ func TestOrders_Order_ValidatePriceQuantity(t *testing.T) {
 		orderFail.Quantity = sdk.ZeroUint()
 		require.Error(t, orderFail.ValidatePriceQuantity())
 	}
+
+	// ok: market limits
+	{
+		orderLimits := orderOk
+		orderLimits.Market.TickSize = sdk.NewUintFromString("1000000000000000")
+		orderLimits.Market.LotSize = sdk.NewUint(1000)
+		orderLimits.Market.MinNotional = sdk.NewUintFromString("1000000000000000000")
+		require.NoError(t, orderLimits.ValidatePriceQuantity())
+	}
+
+	// fail: tick size
+	{
+		orderFail := orderOk
+		orderFail.Market.TickSize = sdk.NewUintFromString("300000000000000000")
+		require.True(t, markets.ErrWrongTickSize.Is(orderFail.ValidatePriceQuantity()))
+	}
+
+	// fail: lot size
+	{
+		orderFail := orderOk
+		orderFail.Market.LotSize = sdk.NewUint(300)
+		require.True(t, markets.ErrWrongLotSize.Is(orderFail.ValidatePriceQuantity()))
+	}
+
+	// fail: min notional
+	{
+		orderFail := orderOk
+		orderFail.Market.MinNotional = sdk.NewUintFromString("1000000000000000001")
+		require.True(t, markets.ErrMinNotional.Is(orderFail.ValidatePriceQuantity()))
+	}
 }
 
 func TestOrders_Order_LockCoin(t *testing.T) {
@@ -108,3 +214,38 @@ func TestOrders_Order_LockCoin(t *testing.T) {
 		require.Error(t, err)
 	}
 }
+
+func TestOrders_Order_IsStopTriggered(t *testing.T) {
+	stopPrice := sdk.NewUint(100)
+
+	// non-stop order
+	{
+		order := NewMockOrder()
+		require.False(t, order.IsStop())
+		require.False(t, order.IsStopTriggered(stopPrice))
+	}
+
+	// bid: triggered when price goes up to the stop price
+	{
+		order := NewMockOrder()
+		order.StopPrice, order.StopTrigger = stopPrice, StopTriggerClearance
+		require.True(t, order.IsStop())
+
+		require.False(t, order.IsStopTriggered(sdk.ZeroUint()))
+		require.False(t, order.IsStopTriggered(sdk.NewUint(99)))
+		require.True(t, order.IsStopTriggered(sdk.NewUint(100)))
+		require.True(t, order.IsStopTriggered(sdk.NewUint(101)))
+	}
+
+	// ask: triggered when price goes down to the stop price
+	{
+		order := NewMockOrder()
+		order.Direction = Ask
+		order.StopPrice, order.StopTrigger = stopPrice, StopTriggerOracle
+
+		require.False(t, order.IsStopTriggered(sdk.ZeroUint()))
+		require.True(t, order.IsStopTriggered(sdk.NewUint(99)))
+		require.True(t, order.IsStopTriggered(sdk.NewUint(100)))
+		require.False(t, order.IsStopTriggered(sdk.NewUint(101)))
+	}
+}
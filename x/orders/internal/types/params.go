@@ -0,0 +1,152 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+// Default parameters values.
+const (
+	// open orders are not limited
+	DefMaxOpenOrders = 0
+	// fill records are kept for 30 days
+	DefFillRecordRetention = 30 * 24 * time.Hour
+	// max number of fill records pruned per block
+	DefFillRecordPruneLimit = 1000
+)
+
+// Parameter store keys.
+var (
+	ParamStoreKeyMaxOpenOrders        = []byte("maxopenorders")
+	ParamStoreKeyOrderDeposit         = []byte("orderdeposit")
+	ParamStoreKeyFillRecordRetention  = []byte("fillrecordretention")
+	ParamStoreKeyFillRecordPruneLimit = []byte("fillrecordprunelimit")
+)
+
+// Params defines keeper params.
+type Params struct {
+	// Max number of active and stop orders per owner per market (0 - not limited)
+	MaxOpenOrders uint64 `json:"max_open_orders" yaml:"max_open_orders"`
+	// Anti-spam deposit locked with every order, refunded on the first fill and burned if order is canceled without being filled (empty - disabled)
+	OrderDeposit sdk.Coins `json:"order_deposit" yaml:"order_deposit"`
+	// Fill records older than the retention period are pruned (0 - fill records are not pruned)
+	FillRecordRetention time.Duration `json:"fill_record_retention" yaml:"fill_record_retention"`
+	// Max number of fill records pruned per block (leftovers are pruned within the next blocks)
+	FillRecordPruneLimit uint32 `json:"fill_record_prune_limit" yaml:"fill_record_prune_limit"`
+}
+
+// Implements subspace.ParamSet interface.
+func (p *Params) ParamSetPairs() params.ParamSetPairs {
+	return params.ParamSetPairs{
+		{Key: ParamStoreKeyMaxOpenOrders, Value: &p.MaxOpenOrders, ValidatorFn: validateMaxOpenOrders},
+		{Key: ParamStoreKeyOrderDeposit, Value: &p.OrderDeposit, ValidatorFn: validateOrderDeposit},
+		{Key: ParamStoreKeyFillRecordRetention, Value: &p.FillRecordRetention, ValidatorFn: validateFillRecordRetention},
+		{Key: ParamStoreKeyFillRecordPruneLimit, Value: &p.FillRecordPruneLimit, ValidatorFn: validateFillRecordPruneLimit},
+	}
+}
+
+// Validate validates params.
+func (p Params) Validate() error {
+	if err := validateMaxOpenOrders(p.MaxOpenOrders); err != nil {
+		return err
+	}
+
+	if err := validateOrderDeposit(p.OrderDeposit); err != nil {
+		return err
+	}
+
+	if err := validateFillRecordRetention(p.FillRecordRetention); err != nil {
+		return err
+	}
+
+	if err := validateFillRecordPruneLimit(p.FillRecordPruneLimit); err != nil {
+		return err
+	}
+	if p.FillRecordRetention > 0 && p.FillRecordPruneLimit == 0 {
+		return fmt.Errorf("fill_record_prune_limit: should be GT 0 if fill records are pruned")
+	}
+
+	return nil
+}
+
+func (p Params) String() string {
+	return fmt.Sprintf("Params:\n"+
+		"MaxOpenOrders: %d\n"+
+		"OrderDeposit: %s\n"+
+		"FillRecordRetention: %s\n"+
+		"FillRecordPruneLimit: %d",
+		p.MaxOpenOrders,
+		p.OrderDeposit,
+		p.FillRecordRetention,
+		p.FillRecordPruneLimit,
+	)
+}
+
+// NewParams creates a new module Params.
+func NewParams(maxOpenOrders uint64, orderDeposit sdk.Coins, fillRecordRetention time.Duration, fillRecordPruneLimit uint32) Params {
+	return Params{
+		MaxOpenOrders:        maxOpenOrders,
+		OrderDeposit:         orderDeposit,
+		FillRecordRetention:  fillRecordRetention,
+		FillRecordPruneLimit: fillRecordPruneLimit,
+	}
+}
+
+// DefaultParams returns default module Params.
+func DefaultParams() Params {
+	return NewParams(DefMaxOpenOrders, sdk.NewCoins(), DefFillRecordRetention, DefFillRecordPruneLimit)
+}
+
+// ParamKeyTable returns Key declaration for parameters storage.
+func ParamKeyTable() params.KeyTable {
+	return params.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// validateMaxOpenOrders validates MaxOpenOrders param value.
+func validateMaxOpenOrders(value interface{}) error {
+	if _, ok := value.(uint64); !ok {
+		return fmt.Errorf("max_open_orders: invalid parameter type: %T", value)
+	}
+
+	return nil
+}
+
+// validateOrderDeposit validates OrderDeposit param value.
+func validateOrderDeposit(value interface{}) error {
+	deposit, ok := value.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("order_deposit: invalid parameter type: %T", value)
+	}
+
+	if !deposit.IsValid() {
+		return fmt.Errorf("order_deposit: invalid coins: %s", deposit)
+	}
+
+	return nil
+}
+
+// validateFillRecordRetention validates FillRecordRetention param value.
+func validateFillRecordRetention(value interface{}) error {
+	retention, ok := value.(time.Duration)
+	if !ok {
+		return fmt.Errorf("fill_record_retention: invalid parameter type: %T", value)
+	}
+
+	if retention < 0 {
+		return fmt.Errorf("fill_record_retention: should be GTE 0")
+	}
+
+	return nil
+}
+
+// validateFillRecordPruneLimit validates FillRecordPruneLimit param value.
+func validateFillRecordPruneLimit(value interface{}) error {
+	if _, ok := value.(uint32); !ok {
+		return fmt.Errorf("fill_record_prune_limit: invalid parameter type: %T", value)
+	}
+
+	return nil
+}
@@ -10,6 +10,12 @@ const (
 	PermOrderPost perms.Permission = ModuleName + "PermOrderPost"
 	// Revoke order
 	PermOrderRevoke perms.Permission = ModuleName + "PermOrderRevoke"
+	// Amend order
+	PermOrderAmend perms.Permission = ModuleName + "PermOrderAmend"
+	// Reduce order quantity (self-trade prevention)
+	PermOrderReduce perms.Permission = ModuleName + "PermOrderReduce"
+	// Trigger (activate) stop order
+	PermOrderTrigger perms.Permission = ModuleName + "PermOrderTrigger"
 	// Init genesis
 	PermInit perms.Permission = ModuleName + "PermInit"
 	// Read order / orders
@@ -20,10 +26,14 @@ const (
 	PermOrderUnlock perms.Permission = ModuleName + "PermOrderUnlock"
 	// Execute order fills
 	PermExecFill perms.Permission = ModuleName + "PermExecFill"
+	// Read module params
+	PermParamsRead perms.Permission = ModuleName + "PermParamsRead"
+	// Update module params
+	PermParamsWrite perms.Permission = ModuleName + "PermParamsWrite"
 )
 
 var (
-	AvailablePermissions = perms.Permissions{PermOrderPost, PermOrderRevoke, PermInit, PermRead, PermOrderLock, PermOrderUnlock, PermExecFill}
+	AvailablePermissions = perms.Permissions{PermOrderPost, PermOrderRevoke, PermOrderAmend, PermOrderReduce, PermOrderTrigger, PermInit, PermRead, PermOrderLock, PermOrderUnlock, PermExecFill, PermParamsRead, PermParamsWrite}
 )
 
 func NewModulePerms() perms.ModulePermissions {
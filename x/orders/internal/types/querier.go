@@ -7,8 +7,10 @@ import (
 )
 
 const (
-	QueryList  = "list"
-	QueryOrder = "order"
+	QueryList     = "list"
+	QueryOrder    = "order"
+	QueryStopList = "stop_list"
+	QueryFills    = "fills"
 )
 
 // Client request for order.
@@ -44,3 +46,25 @@ func (r OrdersReq) DirectionFilter() bool {
 func (r OrdersReq) MarketIDFilter() bool {
 	return r.MarketID != ""
 }
+
+// Client request for fill records.
+type FillRecordsReq struct {
+	// Page number
+	Page sdk.Uint `json:"page" yaml:"page"`
+	// Items per page
+	Limit sdk.Uint `json:"limit" yaml:"limit"`
+	// Owner filter
+	Owner sdk.AccAddress `json:"owner" yaml:"owner"`
+	// MarketID filter
+	MarketID string `json:"market_id" yaml:"market_id"`
+}
+
+// OwnerFilter check if Owner filter is enabled.
+func (r FillRecordsReq) OwnerFilter() bool {
+	return !r.Owner.Empty()
+}
+
+// MarketIDFilter check if MarketID filter is enabled.
+func (r FillRecordsReq) MarketIDFilter() bool {
+	return r.MarketID != ""
+}
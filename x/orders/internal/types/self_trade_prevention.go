@@ -0,0 +1,40 @@
+package types
+
+// Enum type to define order self-trade prevention (STP) policy.
+// Policy is applied by the matcher if a newer order crosses an older opposite order of the same owner.
+type SelfTradePrevention string
+
+const (
+	// No prevention: owner orders can be matched against each other
+	SelfTradePreventionNone SelfTradePrevention = "none"
+	// Cancel newest: the newer order is canceled
+	SelfTradePreventionCancelNewest SelfTradePrevention = "cancel_newest"
+	// Cancel oldest: the older crossed order is canceled
+	SelfTradePreventionCancelOldest SelfTradePrevention = "cancel_oldest"
+	// Decrement both: both orders quantities are decremented by the smaller one (order with zero quantity is canceled)
+	SelfTradePreventionDecrementBoth SelfTradePrevention = "decrement_both"
+)
+
+// IsValid validates enum.
+func (p SelfTradePrevention) IsValid() bool {
+	switch p {
+	case SelfTradePreventionNone, SelfTradePreventionCancelNewest, SelfTradePreventionCancelOldest, SelfTradePreventionDecrementBoth:
+		return true
+	}
+
+	return false
+}
+
+// String returns string enum representation.
+func (p SelfTradePrevention) String() string {
+	return string(p)
+}
+
+// NewSelfTradePreventionRaw creates a new SelfTradePrevention object without checks (empty value is converted to none).
+func NewSelfTradePreventionRaw(str string) SelfTradePrevention {
+	if str == "" {
+		return SelfTradePreventionNone
+	}
+
+	return SelfTradePrevention(str)
+}
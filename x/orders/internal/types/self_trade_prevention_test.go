@@ -0,0 +1,26 @@
+// +build unit
+
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrders_SelfTradePrevention_Validity(t *testing.T) {
+	// valid
+	require.True(t, SelfTradePreventionNone.IsValid())
+	require.True(t, SelfTradePreventionCancelNewest.IsValid())
+	require.True(t, SelfTradePreventionCancelOldest.IsValid())
+	require.True(t, SelfTradePreventionDecrementBoth.IsValid())
+
+	// invalid
+	require.False(t, SelfTradePrevention("").IsValid())
+	require.False(t, SelfTradePrevention("cancel_both").IsValid())
+
+	// raw
+	require.Equal(t, SelfTradePreventionNone, NewSelfTradePreventionRaw(""))
+	require.Equal(t, SelfTradePreventionCancelOldest, NewSelfTradePreventionRaw("cancel_oldest"))
+	require.Equal(t, SelfTradePrevention("abc"), NewSelfTradePreventionRaw("abc"))
+}
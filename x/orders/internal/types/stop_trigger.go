@@ -0,0 +1,30 @@
+package types
+
+// Enum type to define stop order trigger price source.
+type StopTrigger string
+
+const (
+	// Market last clearance price (orderbook history item)
+	StopTriggerClearance StopTrigger = "clearance"
+	// Oracle current price for the market asset code
+	StopTriggerOracle StopTrigger = "oracle"
+)
+
+// IsValid validates enum.
+func (t StopTrigger) IsValid() bool {
+	if t == StopTriggerClearance || t == StopTriggerOracle {
+		return true
+	}
+
+	return false
+}
+
+// String returns string enum representation.
+func (t StopTrigger) String() string {
+	return string(t)
+}
+
+// NewStopTriggerRaw creates a new StopTrigger object without checks.
+func NewStopTriggerRaw(str string) StopTrigger {
+	return StopTrigger(str)
+}
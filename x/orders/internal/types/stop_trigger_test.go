@@ -0,0 +1,19 @@
+// +build unit
+
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrders_StopTrigger_Validity(t *testing.T) {
+	// ok
+	require.True(t, StopTriggerClearance.IsValid())
+	require.True(t, StopTriggerOracle.IsValid())
+
+	// fail
+	require.False(t, StopTrigger("").IsValid())
+	require.False(t, StopTrigger("foo").IsValid())
+}
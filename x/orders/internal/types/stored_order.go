@@ -0,0 +1,173 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	dnTypes "github.com/dfinance/dnode/helpers/types"
+	"github.com/dfinance/dnode/x/markets"
+)
+
+// StoredOrder is an Order storage (and genesis) representation.
+// Market is referenced by ID, MarketExtended is resolved on read, so currency info is always up to date.
+type StoredOrder struct {
+	// Order unique ID
+	ID dnTypes.ID `json:"id" yaml:"id" example:"0" format:"string representation for big.Uint" swaggertype:"string"`
+	// Order owner account address
+	Owner sdk.AccAddress `json:"owner" yaml:"owner" swaggertype:"string" format:"bech32" example:"wallet13jyjuz3kkdvqw8u4qfkwd94emdl3vx394kn07h"`
+	// Market ID order belong to
+	MarketID dnTypes.ID `json:"market_id" yaml:"market_id" example:"0" format:"string representation for big.Uint" swaggertype:"string"`
+	// Order type (bid/ask)
+	Direction Direction `json:"direction" yaml:"direction" swaggertype:"string" example:"bid"`
+	// Order target price (in quote asset denom)
+	Price sdk.Uint `json:"price" yaml:"price" swaggertype:"string" example:"100"`
+	// Order target quantity
+	Quantity sdk.Uint `json:"quantity" yaml:"quantity" swaggertype:"string" example:"50"`
+	// TimeToLive order auto-cancel period
+	Ttl time.Duration `json:"ttl_dur" yaml:"ttl_dur" swaggertype:"integer" example:"60"`
+	// Created timestamp
+	CreatedAt time.Time `json:"created_at" yaml:"created_at" format:"RFC 3339" example:"2020-03-27T13:45:15.293426Z"`
+	// Updated timestamp
+	UpdatedAt time.Time `json:"updated_at" yaml:"updated_at" format:"RFC 3339" example:"2020-03-27T13:45:15.293426Z"`
+	// Matching priority (order IDs sequence number, lower value is matched first)
+	PriorityID dnTypes.ID `json:"priority_id" yaml:"priority_id" example:"0" format:"string representation for big.Uint" swaggertype:"string"`
+	// Time-in-force policy (gtc/ioc/fok/gtb), empty value is treated as gtc
+	TimeInForce TimeInForce `json:"time_in_force" yaml:"time_in_force" swaggertype:"string" example:"gtc"`
+	// Block height order is auto-canceled after (good-till-block orders only)
+	GoodTillBlock int64 `json:"good_till_block" yaml:"good_till_block" example:"100"`
+	// Stop order trigger price (stop orders only)
+	StopPrice sdk.Uint `json:"stop_price" yaml:"stop_price" swaggertype:"string" example:"100"`
+	// Stop order trigger price source (clearance/oracle), empty for regular orders
+	StopTrigger StopTrigger `json:"stop_trigger" yaml:"stop_trigger" swaggertype:"string" example:"oracle"`
+	// Block height stop order was triggered at (order joins the next block matching as a taker), zero if not triggered
+	TriggeredAt int64 `json:"triggered_at" yaml:"triggered_at" example:"100"`
+	// Self-trade prevention policy (none/cancel_newest/cancel_oldest/decrement_both), empty value is treated as none
+	SelfTradePrevention SelfTradePrevention `json:"self_trade_prevention" yaml:"self_trade_prevention" swaggertype:"string" example:"cancel_newest"`
+	// Anti-spam deposit locked with the order, empty if deposit is disabled or already refunded (order was filled)
+	Deposit sdk.Coins `json:"deposit" yaml:"deposit" swaggertype:"string" example:"100xfi"`
+}
+
+// Valid checks that StoredOrder is valid (used for genesis ops).
+func (o StoredOrder) Valid() error {
+	if err := o.ID.Valid(); err != nil {
+		return fmt.Errorf("id: %w", err)
+	}
+	if o.Owner.Empty() {
+		return fmt.Errorf("owner: empty")
+	}
+	if err := sdk.VerifyAddressFormat(o.Owner); err != nil {
+		return fmt.Errorf("owner address format is wrong: %w", err)
+	}
+	if err := o.MarketID.Valid(); err != nil {
+		return fmt.Errorf("market_id: %w", err)
+	}
+
+	order := o.ToOrder(markets.MarketExtended{ID: o.MarketID})
+	if !order.Direction.IsValid() {
+		return fmt.Errorf("direction: invalid")
+	}
+	if order.Price.IsZero() {
+		return fmt.Errorf("price: is zero")
+	}
+	if order.Quantity.IsZero() {
+		return fmt.Errorf("quantity: is zero")
+	}
+	if !order.GetTimeInForce().IsValid() {
+		return fmt.Errorf("time_in_force: invalid")
+	}
+	if order.GetTimeInForce() == TimeInForceGTB && order.GoodTillBlock <= 0 {
+		return fmt.Errorf("good_till_block: should be GT 0")
+	}
+	if !order.GetSelfTradePrevention().IsValid() {
+		return fmt.Errorf("self_trade_prevention: invalid")
+	}
+	if !order.Deposit.IsValid() {
+		return fmt.Errorf("deposit: invalid coins")
+	}
+	if order.IsStop() {
+		if !order.StopTrigger.IsValid() {
+			return fmt.Errorf("stop_trigger: invalid")
+		}
+		if order.GetStopPrice().IsZero() {
+			return fmt.Errorf("stop_price: is zero")
+		}
+	}
+	if order.CreatedAt.After(order.UpdatedAt) {
+		return fmt.Errorf("wrong create and update dates: create date later than update date")
+	}
+	if order.CreatedAt.IsZero() {
+		return fmt.Errorf("created_at: is zero")
+	}
+	if order.CreatedAt.After(time.Now()) {
+		return fmt.Errorf("created_at: is future date")
+	}
+	if order.UpdatedAt.IsZero() {
+		return fmt.Errorf("updated_at: is zero")
+	}
+	if order.UpdatedAt.After(time.Now()) {
+		return fmt.Errorf("updated_at: is future date")
+	}
+
+	return nil
+}
+
+// ToOrder converts StoredOrder to Order with the resolved market.
+func (o StoredOrder) ToOrder(market markets.MarketExtended) Order {
+	return Order{
+		ID:                  o.ID,
+		Owner:               o.Owner,
+		Market:              market,
+		Direction:           o.Direction,
+		Price:               o.Price,
+		Quantity:            o.Quantity,
+		Ttl:                 o.Ttl,
+		CreatedAt:           o.CreatedAt,
+		UpdatedAt:           o.UpdatedAt,
+		PriorityID:          o.PriorityID,
+		TimeInForce:         o.TimeInForce,
+		GoodTillBlock:       o.GoodTillBlock,
+		StopPrice:           o.StopPrice,
+		StopTrigger:         o.StopTrigger,
+		TriggeredAt:         o.TriggeredAt,
+		SelfTradePrevention: o.SelfTradePrevention,
+		Deposit:             o.Deposit,
+	}
+}
+
+// NewStoredOrder creates a new StoredOrder object from Order.
+func NewStoredOrder(order Order) StoredOrder {
+	return StoredOrder{
+		ID:                  order.ID,
+		Owner:               order.Owner,
+		MarketID:            order.Market.ID,
+		Direction:           order.Direction,
+		Price:               order.Price,
+		Quantity:            order.Quantity,
+		Ttl:                 order.Ttl,
+		CreatedAt:           order.CreatedAt,
+		UpdatedAt:           order.UpdatedAt,
+		PriorityID:          order.PriorityID,
+		TimeInForce:         order.TimeInForce,
+		GoodTillBlock:       order.GoodTillBlock,
+		StopPrice:           order.StopPrice,
+		StopTrigger:         order.StopTrigger,
+		TriggeredAt:         order.TriggeredAt,
+		SelfTradePrevention: order.SelfTradePrevention,
+		Deposit:             order.Deposit,
+	}
+}
+
+// StoredOrder slice type.
+type StoredOrders []StoredOrder
+
+// NewStoredOrders creates a new StoredOrders object from Orders.
+func NewStoredOrders(orders Orders) StoredOrders {
+	storedOrders := make(StoredOrders, 0, len(orders))
+	for _, order := range orders {
+		storedOrders = append(storedOrders, NewStoredOrder(order))
+	}
+
+	return storedOrders
+}
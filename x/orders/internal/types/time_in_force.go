@@ -0,0 +1,44 @@
+package types
+
+// Enum type to define order time-in-force policy.
+type TimeInForce string
+
+const (
+	// Good-till-canceled: order lives until filled, revoked or canceled by TTL
+	TimeInForceGTC TimeInForce = "gtc"
+	// Immediate-or-cancel: order is matched within the current block, unfilled leftover is revoked
+	TimeInForceIOC TimeInForce = "ioc"
+	// Fill-or-kill: order is matched within the current block only if it can be fully filled, otherwise revoked
+	TimeInForceFOK TimeInForce = "fok"
+	// Good-till-block: order lives until filled, revoked or the specified block height is passed
+	TimeInForceGTB TimeInForce = "gtb"
+)
+
+// IsValid validates enum.
+func (t TimeInForce) IsValid() bool {
+	switch t {
+	case TimeInForceGTC, TimeInForceIOC, TimeInForceFOK, TimeInForceGTB:
+		return true
+	}
+
+	return false
+}
+
+// IsImmediate checks if order should be matched (and revoked) within the current block.
+func (t TimeInForce) IsImmediate() bool {
+	return t == TimeInForceIOC || t == TimeInForceFOK
+}
+
+// String returns string enum representation.
+func (t TimeInForce) String() string {
+	return string(t)
+}
+
+// NewTimeInForceRaw creates a new TimeInForce object without checks (empty value is converted to GTC).
+func NewTimeInForceRaw(str string) TimeInForce {
+	if str == "" {
+		return TimeInForceGTC
+	}
+
+	return TimeInForce(str)
+}
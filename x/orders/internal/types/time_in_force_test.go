@@ -0,0 +1,28 @@
+// +build unit
+
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrders_TimeInForce_Validity(t *testing.T) {
+	// ok
+	require.True(t, TimeInForceGTC.IsValid())
+	require.True(t, TimeInForceIOC.IsValid())
+	require.True(t, TimeInForceFOK.IsValid())
+	require.True(t, TimeInForceGTB.IsValid())
+	require.Equal(t, TimeInForceGTC, NewTimeInForceRaw(""))
+
+	// fail
+	require.False(t, TimeInForce("").IsValid())
+	require.False(t, TimeInForce("foo").IsValid())
+
+	// immediate
+	require.True(t, TimeInForceIOC.IsImmediate())
+	require.True(t, TimeInForceFOK.IsImmediate())
+	require.False(t, TimeInForceGTC.IsImmediate())
+	require.False(t, TimeInForceGTB.IsImmediate())
+}
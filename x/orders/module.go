@@ -85,7 +85,9 @@ func (am AppModule) Name() string {
 }
 
 // RegisterInvariants registers module invariants.
-func (am AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	RegisterInvariants(ir, am.keeper)
+}
 
 // Route returns module messages route.
 func (am AppModule) Route() string {
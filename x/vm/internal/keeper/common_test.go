@@ -20,8 +20,10 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	authTypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
 	"github.com/cosmos/cosmos-sdk/x/gov"
 	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/supply"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
 	cfg "github.com/tendermint/tendermint/config"
@@ -153,6 +155,8 @@ type testInput struct {
 	ctx sdk.Context
 
 	ak vmauth.Keeper
+	bk bank.Keeper
+	sk supply.Keeper
 	pk params.Keeper
 	vk Keeper
 	ok oracle.Keeper
@@ -160,6 +164,7 @@ type testInput struct {
 
 	keyMain    *sdk.KVStoreKey
 	keyAccount *sdk.KVStoreKey
+	keySupply  *sdk.KVStoreKey
 	keyOracle  *sdk.KVStoreKey
 	keyCCS     *sdk.KVStoreKey
 	keyParams  *sdk.KVStoreKey
@@ -201,6 +206,7 @@ func newTestInput(launchMock bool) testInput {
 		cdc:        codec.New(),
 		keyParams:  sdk.NewKVStoreKey(params.StoreKey),
 		keyAccount: sdk.NewKVStoreKey(authTypes.StoreKey),
+		keySupply:  sdk.NewKVStoreKey(supply.StoreKey),
 		keyOracle:  sdk.NewKVStoreKey(oracle.StoreKey),
 		keyCCS:     sdk.NewKVStoreKey(ccstorage.StoreKey),
 		tkeyParams: sdk.NewTransientStoreKey(params.TStoreKey),
@@ -217,6 +223,7 @@ func newTestInput(launchMock bool) testInput {
 	db := dbm.NewMemDB()
 	mstore := store.NewCommitMultiStore(db)
 	mstore.MountStoreWithDB(input.keyAccount, sdk.StoreTypeIAVL, db)
+	mstore.MountStoreWithDB(input.keySupply, sdk.StoreTypeIAVL, db)
 	mstore.MountStoreWithDB(input.keyParams, sdk.StoreTypeIAVL, db)
 	mstore.MountStoreWithDB(input.keyOracle, sdk.StoreTypeIAVL, db)
 	mstore.MountStoreWithDB(input.keyCCS, sdk.StoreTypeIAVL, db)
@@ -294,11 +301,14 @@ func newTestInput(launchMock bool) testInput {
 		vmauth.RequestCCStoragePerms(),
 	)
 	input.ak = vmauth.NewKeeper(input.cdc, input.keyAccount, input.pk.Subspace(auth.DefaultParamspace), input.cs, auth.ProtoBaseAccount)
+	input.bk = bank.NewBaseKeeper(input.ak, input.pk.Subspace(bank.DefaultParamspace), tests.ModuleAccountAddrs())
+	input.sk = supply.NewKeeper(input.cdc, input.keySupply, input.ak, input.bk, tests.MAccPerms)
 	input.ok = oracle.NewKeeper(
 		input.cdc,
 		input.keyOracle,
 		input.pk.Subspace(oracle.DefaultParamspace),
 		input.vk,
+		input.sk,
 		func() (moduleName string, modulePerms perms.Permissions) {
 			// custom requester as some test require oracle module setup
 			moduleName = types.ModuleName
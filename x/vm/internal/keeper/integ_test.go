@@ -412,6 +412,8 @@ func TestVMKeeper_ScriptOracle(t *testing.T) {
 		PostPrice: oracle.PostPriceParams{
 			ReceivedAtDiffInS: 3600,
 		},
+		Aggregation: oracle.DefaultAggregationParams(),
+		Rewards:     oracle.DefaultRewardParams(),
 	}
 
 	input.ok.SetParams(input.ctx, okInitParams)